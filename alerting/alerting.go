@@ -0,0 +1,382 @@
+// Package alerting evaluates alert rules on the metrics of models
+// and notifies receivers when alerts start or stop firing.
+package alerting
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/go-kit/log"
+	"github.com/go-kit/log/level"
+
+	"github.com/connylabs/model-tracking/drift"
+	"github.com/connylabs/model-tracking/evaluation"
+	"github.com/connylabs/model-tracking/store"
+)
+
+// Metric is a metric of a model that an alert rule can be defined on.
+type Metric string
+
+const (
+	// Accuracy is the accuracy of the labelled results in the window.
+	Accuracy Metric = "accuracy"
+	// PSI is the population stability index of the results in the window
+	// with respect to the results in the preceding window.
+	PSI Metric = "psi"
+	// KL is the Kullback-Leibler divergence of the results in the window
+	// from the results in the preceding window.
+	KL Metric = "kl"
+	// KS is the statistic of the two-sample Kolmogorov-Smirnov test comparing
+	// the results in the window with the results in the preceding window.
+	KS Metric = "ks"
+)
+
+// Valid returns an error if the metric is unknown.
+func (m Metric) Valid() error {
+	switch m {
+	case Accuracy, PSI, KL, KS:
+		return nil
+	}
+	return fmt.Errorf("unknown metric %q", m)
+}
+
+// Drift returns true if the metric measures drift.
+func (m Metric) Drift() bool {
+	return m == PSI || m == KL || m == KS
+}
+
+// Operator compares the value of a metric with the threshold of an alert rule.
+type Operator string
+
+const (
+	// LessThan fires if the value is less than the threshold.
+	LessThan Operator = "<"
+	// LessThanOrEqual fires if the value is less than or equal to the threshold.
+	LessThanOrEqual Operator = "<="
+	// GreaterThan fires if the value is greater than the threshold.
+	GreaterThan Operator = ">"
+	// GreaterThanOrEqual fires if the value is greater than or equal to the threshold.
+	GreaterThanOrEqual Operator = ">="
+)
+
+// Valid returns an error if the operator is unknown.
+func (o Operator) Valid() error {
+	switch o {
+	case LessThan, LessThanOrEqual, GreaterThan, GreaterThanOrEqual:
+		return nil
+	}
+	return fmt.Errorf("unknown operator %q", o)
+}
+
+// Compare returns true if the value compares to the threshold according to the operator.
+func (o Operator) Compare(value, threshold float64) bool {
+	switch o {
+	case LessThan:
+		return value < threshold
+	case LessThanOrEqual:
+		return value <= threshold
+	case GreaterThan:
+		return value > threshold
+	case GreaterThanOrEqual:
+		return value >= threshold
+	}
+	return false
+}
+
+// Status is the status of an alert.
+type Status string
+
+const (
+	// Firing alerts have a metric that violates the threshold of their rule.
+	Firing Status = "firing"
+	// Resolved alerts were firing until the most recent evaluation.
+	Resolved Status = "resolved"
+)
+
+// Notification describes the state of an alert as of an evaluation.
+type Notification struct {
+	Status       Status     `json:"status"`
+	Rule         string     `json:"rule"`
+	Organization string     `json:"organization"`
+	Model        string     `json:"model"`
+	Stage        string     `json:"stage"`
+	Version      string     `json:"version"`
+	Metric       Metric     `json:"metric"`
+	Field        string     `json:"field,omitempty"`
+	Operator     Operator   `json:"operator"`
+	Threshold    float64    `json:"threshold"`
+	Value        float64    `json:"value"`
+	StartsAt     time.Time  `json:"startsAt"`
+	EndsAt       *time.Time `json:"endsAt,omitempty"`
+	// Changed is true if the status of the alert changed in the evaluation.
+	Changed bool `json:"-"`
+}
+
+// Summary returns a human-readable description of the notification.
+func (n Notification) Summary() string {
+	metric := string(n.Metric)
+	if n.Field != "" {
+		metric = fmt.Sprintf("%s of %s", metric, n.Field)
+	}
+	return fmt.Sprintf("%s of model %s/%s version %s in stage %s is %g (%s %g)", metric, n.Organization, n.Model, n.Version, n.Stage, n.Value, n.Operator, n.Threshold)
+}
+
+// A Receiver is notified of firing and resolved alerts.
+type Receiver interface {
+	// Notify is called after every evaluation with all firing alerts
+	// and all alerts that were resolved in the evaluation.
+	Notify(context.Context, []Notification) error
+}
+
+// errNoData is returned when a metric cannot be computed because
+// there are not enough results in the window.
+var errNoData = errors.New("not enough data")
+
+// Evaluator periodically evaluates the alert rules of all models.
+type Evaluator struct {
+	store     store.ModelTracking
+	receivers []Receiver
+	interval  time.Duration
+	logger    log.Logger
+	now       func() time.Time
+}
+
+// NewEvaluator creates a new evaluator that evaluates all alert rules
+// in the store at the given interval and notifies the given receivers.
+func NewEvaluator(store store.ModelTracking, receivers []Receiver, interval time.Duration, logger log.Logger) *Evaluator {
+	if logger == nil {
+		logger = log.NewNopLogger()
+	}
+	return &Evaluator{
+		store:     store,
+		receivers: receivers,
+		interval:  interval,
+		logger:    logger,
+		now:       time.Now,
+	}
+}
+
+// Run evaluates the alert rules until the context is canceled.
+func (e *Evaluator) Run(ctx context.Context) error {
+	t := time.NewTicker(e.interval)
+	defer t.Stop()
+	for {
+		if err := e.Evaluate(ctx); err != nil {
+			level.Error(e.logger).Log("msg", "failed to evaluate alert rules", "err", err.Error())
+		}
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-t.C:
+		}
+	}
+}
+
+// Evaluate evaluates all alert rules once, notifies the receivers
+// and records the state of the rules.
+// Rules whose metric cannot be computed keep their previous state.
+// If any receiver cannot be notified, alerts that started or stopped firing
+// keep their previous state, so that the change is notified again in the next evaluation.
+// Evaluate does nothing if the rules are being evaluated by another replica.
+func (e *Evaluator) Evaluate(ctx context.Context) error {
+	unlock, ok, err := e.store.Alerts().Lock(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to lock alert rules: %w", err)
+	}
+	if !ok {
+		level.Debug(e.logger).Log("msg", "alert rules are being evaluated by another replica")
+		return nil
+	}
+	defer unlock() //nolint:errcheck
+
+	alerts, err := e.store.Alerts().List(ctx)
+	if err != nil {
+		return err
+	}
+
+	now := e.now()
+	var ns []Notification
+	var ss []state
+	for _, a := range alerts {
+		n, s, err := e.evaluate(ctx, a, now)
+		if err != nil {
+			if !errors.Is(err, errNoData) {
+				level.Warn(e.logger).Log("msg", "failed to evaluate alert rule", "organization", a.Organization.Name, "model", a.Model.Name, "rule", a.Rule.Name, "err", err.Error())
+			}
+			continue
+		}
+		if n != nil {
+			ns = append(ns, *n)
+		}
+		ss = append(ss, s)
+	}
+
+	notified := true
+	for _, r := range e.receivers {
+		if err := e.notify(ctx, r, ns); err != nil {
+			level.Error(e.logger).Log("msg", "failed to notify receiver", "err", err.Error())
+			notified = false
+		}
+	}
+
+	for _, s := range ss {
+		if s.changed && !notified {
+			continue
+		}
+		if err := e.store.Alerts().SetState(ctx, s.id, s.firing, s.activeSince, &s.value); err != nil {
+			level.Error(e.logger).Log("msg", "failed to record state of alert rule", "rule", s.id, "err", err.Error())
+		}
+	}
+	return nil
+}
+
+// notify notifies a receiver, giving up after the evaluation interval
+// so that a slow receiver cannot hold up the next evaluation.
+func (e *Evaluator) notify(ctx context.Context, r Receiver, ns []Notification) error {
+	ctx, cancel := context.WithTimeout(ctx, e.interval)
+	defer cancel()
+	return r.Notify(ctx, ns)
+}
+
+// state is the outcome of the evaluation of an alert rule.
+type state struct {
+	id          int
+	firing      bool
+	activeSince *time.Time
+	value       float64
+	// changed is true if the alert started or stopped firing.
+	changed bool
+}
+
+// evaluate evaluates a single alert rule and returns its new state,
+// as well as a notification if the alert is firing or was resolved.
+func (e *Evaluator) evaluate(ctx context.Context, a *store.Alert, now time.Time) (*Notification, state, error) {
+	r := a.Rule
+	st, err := e.store.Stages(a.Organization.Name, a.Model.Name).Get(ctx, r.Stage)
+	if err != nil {
+		return nil, state{}, fmt.Errorf("failed to get stage: %w", err)
+	}
+	v, err := e.store.Versions(a.Organization.Name, a.Model.Name).GetByID(ctx, int(st.Version))
+	if err != nil {
+		return nil, state{}, fmt.Errorf("failed to get version: %w", err)
+	}
+
+	var field string
+	if r.Field != nil {
+		field = *r.Field
+	}
+	window := time.Duration(r.WindowSeconds) * time.Second
+	value, err := e.value(ctx, a, v.Name, Metric(r.Metric), field, window, now)
+	if err != nil {
+		return nil, state{}, err
+	}
+
+	firing := Operator(r.Operator).Compare(value, r.Threshold)
+	n := &Notification{
+		Status:       Firing,
+		Rule:         r.Name,
+		Organization: a.Organization.Name,
+		Model:        a.Model.Name,
+		Stage:        r.Stage,
+		Version:      v.Name,
+		Metric:       Metric(r.Metric),
+		Field:        field,
+		Operator:     Operator(r.Operator),
+		Threshold:    r.Threshold,
+		Value:        value,
+		Changed:      firing != r.Firing,
+	}
+	activeSince := r.ActiveSince
+	switch {
+	case firing && !r.Firing:
+		activeSince = &now
+	case !firing && r.Firing:
+		n.Status = Resolved
+		n.EndsAt = &now
+	case !firing:
+		n = nil
+	}
+	if n != nil && activeSince != nil {
+		n.StartsAt = *activeSince
+	}
+	if !firing {
+		activeSince = nil
+	}
+
+	return n, state{id: int(r.ID), firing: firing, activeSince: activeSince, value: value, changed: firing != r.Firing}, nil
+}
+
+// value computes the metric of an alert rule for the given version.
+// Accuracy is computed from the results in the window ending now;
+// drift is computed between the results in the window ending now
+// and the results in the preceding window of the same length,
+// each sampled to at most drift.MaxSamples results.
+// If the rule has no field, the drift of the field that drifted most is used.
+func (e *Evaluator) value(ctx context.Context, a *store.Alert, version string, metric Metric, field string, window time.Duration, now time.Time) (float64, error) {
+	start := now.Add(-window)
+	if metric == Accuracy {
+		// Aggregate the results in the store rather than loading all of them.
+		st, err := e.store.Statistics().Get(ctx, a.Organization.Name, a.Model.Name, version, &start, &now, nil)
+		if err != nil {
+			return 0, err
+		}
+		accuracy, ok := evaluation.Summary{Count: int(st.Results), Labelled: int(st.Labelled), Correct: int(st.Correct)}.Accuracy()
+		if !ok {
+			return 0, errNoData
+		}
+		return accuracy, nil
+	}
+
+	results := e.store.Results(a.Organization.Name, a.Model.Name, version)
+	cs, err := results.Sample(ctx, &start, &now, nil, drift.MaxSamples)
+	if err != nil {
+		return 0, err
+	}
+	referenceStart := start.Add(-window)
+	rs, err := results.Sample(ctx, &referenceStart, &start, nil, drift.MaxSamples)
+	if err != nil {
+		return 0, err
+	}
+	current := make(drift.Samples)
+	for i := range cs {
+		if err := current.AddResult(cs[i].Input, cs[i].Output); err != nil {
+			return 0, err
+		}
+	}
+	reference := make(drift.Samples)
+	for i := range rs {
+		if err := reference.AddResult(rs[i].Input, rs[i].Output); err != nil {
+			return 0, err
+		}
+	}
+
+	var value float64
+	var found bool
+	for _, f := range drift.Compare(reference, current, drift.DefaultBins) {
+		if field != "" && f.Name != field {
+			continue
+		}
+		var v float64
+		switch metric {
+		case PSI:
+			v = f.PSI
+		case KL:
+			v = f.KL
+		case KS:
+			if f.KS == nil {
+				continue
+			}
+			v = f.KS.Statistic
+		}
+		if !found || v > value {
+			value = v
+		}
+		found = true
+	}
+	if !found {
+		return 0, errNoData
+	}
+	return value, nil
+}
@@ -0,0 +1,312 @@
+package alerting
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/efficientgo/core/testutil"
+
+	"github.com/connylabs/model-tracking/evaluation"
+	"github.com/connylabs/model-tracking/labels"
+	"github.com/connylabs/model-tracking/store"
+	"github.com/connylabs/model-tracking/store/model-tracking/public/model"
+)
+
+// fakeStore implements the parts of the store needed to evaluate alert rules.
+// Calling any other method panics.
+type fakeStore struct {
+	store.ModelTracking
+	alerts  []*store.Alert
+	results []*model.Result
+	// locked makes the lock on the alert rules appear to be held by another replica.
+	locked bool
+}
+
+func (fs *fakeStore) Alerts() store.Alerts {
+	return &fakeAlerts{fs: fs}
+}
+
+func (fs *fakeStore) Stages(_, _ string) store.Stages {
+	return &fakeStages{}
+}
+
+func (fs *fakeStore) Versions(_, _ string) store.Versions {
+	return &fakeVersions{}
+}
+
+func (fs *fakeStore) Results(_, _, _ string) store.Results {
+	return &fakeResults{fs: fs}
+}
+
+type fakeAlerts struct {
+	store.Alerts
+	fs *fakeStore
+}
+
+func (fa *fakeAlerts) List(context.Context) ([]*store.Alert, error) {
+	return fa.fs.alerts, nil
+}
+
+func (fa *fakeAlerts) SetState(_ context.Context, id int, firing bool, activeSince *time.Time, value *float64) error {
+	for _, a := range fa.fs.alerts {
+		if int(a.Rule.ID) == id {
+			a.Rule.Firing = firing
+			a.Rule.ActiveSince = activeSince
+			a.Rule.LastValue = value
+		}
+	}
+	return nil
+}
+
+func (fa *fakeAlerts) Lock(context.Context) (func() error, bool, error) {
+	if fa.fs.locked {
+		return nil, false, nil
+	}
+	return func() error { return nil }, true, nil
+}
+
+type fakeStages struct {
+	store.Stages
+}
+
+func (fs *fakeStages) Get(_ context.Context, name string) (*model.Stage, error) {
+	return &model.Stage{Name: name, Version: 1}, nil
+}
+
+type fakeVersions struct {
+	store.Versions
+}
+
+func (fv *fakeVersions) GetByID(_ context.Context, id int) (*model.Version, error) {
+	return &model.Version{ID: int32(id), Name: "v1"}, nil
+}
+
+type fakeResults struct {
+	store.Results
+	fs *fakeStore
+}
+
+func (fr *fakeResults) Sample(_ context.Context, start, end *time.Time, _ labels.Selector, limit int) ([]*model.Result, error) {
+	rs := fr.fs.window(start, end)
+	if len(rs) > limit {
+		rs = rs[:limit]
+	}
+	return rs, nil
+}
+
+func (fs *fakeStore) Statistics() store.Statistics {
+	return &fakeStatistics{fs: fs}
+}
+
+// fakeStatistics aggregates the results of the fake store.
+type fakeStatistics struct {
+	store.Statistics
+	fs *fakeStore
+}
+
+func (fst *fakeStatistics) Get(_ context.Context, _, _, version string, start, end *time.Time, _ labels.Selector) (*store.VersionStatistics, error) {
+	var s evaluation.Summary
+	for _, r := range fst.fs.window(start, end) {
+		s.Add(r.Output, r.TrueOutput)
+	}
+	return &store.VersionStatistics{Version: version, Results: int64(s.Count), Labelled: int64(s.Labelled), Correct: int64(s.Correct)}, nil
+}
+
+// window gets the results whose time lies in the half-open interval [start, end).
+func (fs *fakeStore) window(start, end *time.Time) []*model.Result {
+	var rs []*model.Result
+	for _, r := range fs.results {
+		if (start == nil || !r.Time.Before(*start)) && (end == nil || r.Time.Before(*end)) {
+			rs = append(rs, r)
+		}
+	}
+	return rs
+}
+
+func TestOperator(t *testing.T) {
+	testutil.Assert(t, LessThan.Compare(0.8, 0.9))
+	testutil.Assert(t, !LessThan.Compare(0.9, 0.9))
+	testutil.Assert(t, LessThanOrEqual.Compare(0.9, 0.9))
+	testutil.Assert(t, GreaterThan.Compare(0.3, 0.2))
+	testutil.Assert(t, GreaterThanOrEqual.Compare(0.2, 0.2))
+	testutil.Ok(t, GreaterThan.Valid())
+	testutil.NotOk(t, Operator("=").Valid())
+	testutil.Ok(t, PSI.Valid())
+	testutil.NotOk(t, Metric("precision").Valid())
+}
+
+func TestEvaluator(t *testing.T) {
+	webhooks := make(chan WebhookPayload, 10)
+	webhook := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var p WebhookPayload
+		testutil.Ok(t, json.NewDecoder(r.Body).Decode(&p))
+		webhooks <- p
+	}))
+	defer webhook.Close()
+
+	alertmanagerAlerts := make(chan []AlertmanagerAlert, 10)
+	alertmanager := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		testutil.Equals(t, "/api/v2/alerts", r.URL.Path)
+		var as []AlertmanagerAlert
+		testutil.Ok(t, json.NewDecoder(r.Body).Decode(&as))
+		alertmanagerAlerts <- as
+	}))
+	defer alertmanager.Close()
+
+	now := time.Date(2023, 7, 10, 12, 0, 0, 0, time.UTC)
+	fs := &fakeStore{
+		alerts: []*store.Alert{{
+			Rule: model.AlertRule{
+				ID:            1,
+				Name:          "LowAccuracy",
+				Stage:         "production",
+				Metric:        string(Accuracy),
+				Operator:      string(LessThan),
+				Threshold:     0.9,
+				WindowSeconds: int32((24 * time.Hour).Seconds()),
+			},
+			Organization: model.Organization{Name: "foo"},
+			Model:        model.Model{Name: "bar"},
+		}},
+	}
+	e := NewEvaluator(fs, []Receiver{
+		NewWebhookReceiver(webhook.URL, nil),
+		NewAlertmanagerReceiver(alertmanager.URL, nil, 5*time.Minute),
+	}, time.Minute, nil)
+	e.now = func() time.Time { return now }
+
+	// Without labelled results, the rule cannot be evaluated.
+	testutil.Ok(t, e.Evaluate(context.Background()))
+	testutil.Equals(t, 0, len(webhooks))
+	testutil.Equals(t, 0, len(alertmanagerAlerts))
+
+	for i := 0; i < 10; i++ {
+		fs.results = append(fs.results, &model.Result{Output: []byte(`"cat"`), TrueOutput: []byte(`"dog"`), Time: now.Add(-time.Hour)})
+	}
+	// Results outside of the window are ignored.
+	fs.results = append(fs.results, &model.Result{Output: []byte(`"cat"`), TrueOutput: []byte(`"cat"`), Time: now.Add(-48 * time.Hour)})
+
+	testutil.Ok(t, e.Evaluate(context.Background()))
+	p := <-webhooks
+	testutil.Equals(t, 1, len(p.Notifications))
+	testutil.Equals(t, Firing, p.Notifications[0].Status)
+	testutil.Equals(t, "v1", p.Notifications[0].Version)
+	testutil.Equals(t, 0.0, p.Notifications[0].Value)
+	testutil.Assert(t, now.Equal(p.Notifications[0].StartsAt))
+	as := <-alertmanagerAlerts
+	testutil.Equals(t, 1, len(as))
+	testutil.Equals(t, "LowAccuracy", as[0].Labels["alertname"])
+	testutil.Assert(t, as[0].EndsAt.After(now))
+	testutil.Assert(t, fs.alerts[0].Rule.Firing)
+
+	// A firing alert is resent to Alertmanager but not to webhooks.
+	now = now.Add(time.Minute)
+	testutil.Ok(t, e.Evaluate(context.Background()))
+	testutil.Equals(t, 1, len(<-alertmanagerAlerts))
+	testutil.Equals(t, 0, len(webhooks))
+
+	for i := 0; i < 100; i++ {
+		fs.results = append(fs.results, &model.Result{Output: []byte(`"cat"`), TrueOutput: []byte(`"cat"`), Time: now.Add(-time.Hour)})
+	}
+	now = now.Add(time.Minute)
+	testutil.Ok(t, e.Evaluate(context.Background()))
+	p = <-webhooks
+	testutil.Equals(t, 1, len(p.Notifications))
+	testutil.Equals(t, Resolved, p.Notifications[0].Status)
+	testutil.Assert(t, now.Equal(*p.Notifications[0].EndsAt))
+	testutil.Assert(t, now.Add(-2*time.Minute).Equal(p.Notifications[0].StartsAt))
+	as = <-alertmanagerAlerts
+	testutil.Assert(t, now.Equal(as[0].EndsAt))
+	testutil.Assert(t, !fs.alerts[0].Rule.Firing)
+
+	// Resolved alerts are not sent again.
+	now = now.Add(time.Minute)
+	testutil.Ok(t, e.Evaluate(context.Background()))
+	testutil.Equals(t, 0, len(webhooks))
+	testutil.Equals(t, 0, len(alertmanagerAlerts))
+}
+
+func TestEvaluatorDrift(t *testing.T) {
+	now := time.Date(2023, 7, 10, 12, 0, 0, 0, time.UTC)
+	fs := &fakeStore{
+		alerts: []*store.Alert{{
+			Rule: model.AlertRule{
+				ID:            1,
+				Name:          "InputDrift",
+				Stage:         "production",
+				Metric:        string(PSI),
+				Operator:      string(GreaterThan),
+				Threshold:     0.2,
+				WindowSeconds: int32(time.Hour.Seconds()),
+			},
+		}},
+	}
+	for i := 0; i < 100; i++ {
+		fs.results = append(fs.results,
+			&model.Result{Input: []byte(`{"country":"de"}`), Output: []byte(`"cat"`), Time: now.Add(-90 * time.Minute)},
+			&model.Result{Input: []byte(`{"country":"fr"}`), Output: []byte(`"cat"`), Time: now.Add(-30 * time.Minute)},
+		)
+	}
+	e := NewEvaluator(fs, nil, time.Minute, nil)
+	e.now = func() time.Time { return now }
+	testutil.Ok(t, e.Evaluate(context.Background()))
+	testutil.Assert(t, fs.alerts[0].Rule.Firing)
+	testutil.Assert(t, *fs.alerts[0].Rule.LastValue > 0.2)
+}
+
+func TestEvaluatorNotifyFailure(t *testing.T) {
+	var fail bool
+	webhooks := make(chan WebhookPayload, 10)
+	webhook := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if fail {
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		var p WebhookPayload
+		testutil.Ok(t, json.NewDecoder(r.Body).Decode(&p))
+		webhooks <- p
+	}))
+	defer webhook.Close()
+
+	now := time.Date(2023, 7, 10, 12, 0, 0, 0, time.UTC)
+	fs := &fakeStore{
+		alerts: []*store.Alert{{
+			Rule: model.AlertRule{
+				ID:            1,
+				Name:          "LowAccuracy",
+				Stage:         "production",
+				Metric:        string(Accuracy),
+				Operator:      string(LessThan),
+				Threshold:     0.9,
+				WindowSeconds: int32(time.Hour.Seconds()),
+			},
+		}},
+	}
+	for i := 0; i < 10; i++ {
+		fs.results = append(fs.results, &model.Result{Output: []byte(`"cat"`), TrueOutput: []byte(`"dog"`), Time: now.Add(-time.Minute)})
+	}
+	e := NewEvaluator(fs, []Receiver{NewWebhookReceiver(webhook.URL, nil)}, time.Minute, nil)
+	e.now = func() time.Time { return now }
+
+	// Rules are not evaluated while another replica holds the lock.
+	fs.locked = true
+	testutil.Ok(t, e.Evaluate(context.Background()))
+	testutil.Assert(t, fs.alerts[0].Rule.LastValue == nil)
+
+	// The alert does not start firing until the receivers are notified.
+	fs.locked = false
+	fail = true
+	testutil.Ok(t, e.Evaluate(context.Background()))
+	testutil.Assert(t, !fs.alerts[0].Rule.Firing)
+
+	fail = false
+	testutil.Ok(t, e.Evaluate(context.Background()))
+	p := <-webhooks
+	testutil.Equals(t, 1, len(p.Notifications))
+	testutil.Equals(t, Firing, p.Notifications[0].Status)
+	testutil.Assert(t, fs.alerts[0].Rule.Firing)
+}
@@ -0,0 +1,126 @@
+package alerting
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+)
+
+// post sends the given payload as JSON to the given URL.
+func post(ctx context.Context, client *http.Client, url string, payload interface{}) error {
+	buf, err := json.Marshal(payload)
+	if err != nil {
+		return err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(buf))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	res, err := client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+	if res.StatusCode < 200 || res.StatusCode >= 300 {
+		return fmt.Errorf("unexpected status code %d from %s", res.StatusCode, url)
+	}
+	return nil
+}
+
+// WebhookPayload is the body of the requests sent by the webhook receiver.
+type WebhookPayload struct {
+	Notifications []Notification `json:"notifications"`
+}
+
+type webhookReceiver struct {
+	client *http.Client
+	url    string
+}
+
+// NewWebhookReceiver creates a receiver that posts a JSON-encoded WebhookPayload
+// to the given URL whenever alerts start or stop firing.
+// If client is nil, a client with a timeout of 10s is used.
+func NewWebhookReceiver(url string, client *http.Client) Receiver {
+	if client == nil {
+		client = &http.Client{Timeout: 10 * time.Second}
+	}
+	return &webhookReceiver{client, url}
+}
+
+func (wr *webhookReceiver) Notify(ctx context.Context, ns []Notification) error {
+	var changed []Notification
+	for _, n := range ns {
+		if n.Changed {
+			changed = append(changed, n)
+		}
+	}
+	if len(changed) == 0 {
+		return nil
+	}
+	return post(ctx, wr.client, wr.url, WebhookPayload{Notifications: changed})
+}
+
+// AlertmanagerAlert is an alert in the format of the Alertmanager v2 API.
+type AlertmanagerAlert struct {
+	Labels      map[string]string `json:"labels"`
+	Annotations map[string]string `json:"annotations"`
+	StartsAt    time.Time         `json:"startsAt"`
+	EndsAt      time.Time         `json:"endsAt"`
+}
+
+type alertmanagerReceiver struct {
+	client         *http.Client
+	url            string
+	resolveTimeout time.Duration
+	now            func() time.Time
+}
+
+// NewAlertmanagerReceiver creates a receiver that posts all firing and resolved alerts
+// to the Alertmanager at the given URL after every evaluation.
+// Firing alerts are resolved by Alertmanager if they are not sent again within
+// the given timeout, which should be a few evaluation intervals.
+// If client is nil, a client with a timeout of 10s is used.
+func NewAlertmanagerReceiver(url string, client *http.Client, resolveTimeout time.Duration) Receiver {
+	if client == nil {
+		client = &http.Client{Timeout: 10 * time.Second}
+	}
+	return &alertmanagerReceiver{client, strings.TrimSuffix(url, "/"), resolveTimeout, time.Now}
+}
+
+func (ar *alertmanagerReceiver) Notify(ctx context.Context, ns []Notification) error {
+	if len(ns) == 0 {
+		return nil
+	}
+	alerts := make([]AlertmanagerAlert, 0, len(ns))
+	for _, n := range ns {
+		a := AlertmanagerAlert{
+			Labels: map[string]string{
+				"alertname":    n.Rule,
+				"organization": n.Organization,
+				"model":        n.Model,
+				"stage":        n.Stage,
+				"version":      n.Version,
+				"metric":       string(n.Metric),
+			},
+			Annotations: map[string]string{
+				"summary": n.Summary(),
+				"value":   fmt.Sprintf("%g", n.Value),
+			},
+			StartsAt: n.StartsAt,
+			EndsAt:   ar.now().Add(ar.resolveTimeout),
+		}
+		if n.Field != "" {
+			a.Labels["field"] = n.Field
+		}
+		if n.EndsAt != nil {
+			a.EndsAt = *n.EndsAt
+		}
+		alerts = append(alerts, a)
+	}
+	return post(ctx, ar.client, ar.url+"/api/v2/alerts", alerts)
+}
@@ -0,0 +1,198 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.3.0
+// - protoc             (unknown)
+// source: api/ingestion/v1alpha1/ingestion.proto
+
+// Package modeltracking.ingestion.v1alpha1 is an API for recording the results of models
+// at high throughput. It complements the HTTP API, which manages all other resources.
+
+package v1alpha1
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+const (
+	Ingestion_RecordResults_FullMethodName = "/modeltracking.ingestion.v1alpha1.Ingestion/RecordResults"
+	Ingestion_StreamResults_FullMethodName = "/modeltracking.ingestion.v1alpha1.Ingestion/StreamResults"
+)
+
+// IngestionClient is the client API for Ingestion service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type IngestionClient interface {
+	// RecordResults records a batch of results of a version in a single transaction.
+	// If any of the results is invalid, none of them are recorded.
+	RecordResults(ctx context.Context, in *RecordResultsRequest, opts ...grpc.CallOption) (*RecordResultsResponse, error)
+	// StreamResults records the batches of results sent by the client,
+	// which may belong to different versions, as they arrive.
+	// Every batch is recorded in its own transaction; if a batch fails,
+	// the stream is aborted and the batches before it remain recorded.
+	// The response counts the results of all batches.
+	StreamResults(ctx context.Context, opts ...grpc.CallOption) (Ingestion_StreamResultsClient, error)
+}
+
+type ingestionClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewIngestionClient(cc grpc.ClientConnInterface) IngestionClient {
+	return &ingestionClient{cc}
+}
+
+func (c *ingestionClient) RecordResults(ctx context.Context, in *RecordResultsRequest, opts ...grpc.CallOption) (*RecordResultsResponse, error) {
+	out := new(RecordResultsResponse)
+	err := c.cc.Invoke(ctx, Ingestion_RecordResults_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ingestionClient) StreamResults(ctx context.Context, opts ...grpc.CallOption) (Ingestion_StreamResultsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Ingestion_ServiceDesc.Streams[0], Ingestion_StreamResults_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &ingestionStreamResultsClient{stream}
+	return x, nil
+}
+
+type Ingestion_StreamResultsClient interface {
+	Send(*RecordResultsRequest) error
+	CloseAndRecv() (*RecordResultsResponse, error)
+	grpc.ClientStream
+}
+
+type ingestionStreamResultsClient struct {
+	grpc.ClientStream
+}
+
+func (x *ingestionStreamResultsClient) Send(m *RecordResultsRequest) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *ingestionStreamResultsClient) CloseAndRecv() (*RecordResultsResponse, error) {
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	m := new(RecordResultsResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// IngestionServer is the server API for Ingestion service.
+// All implementations must embed UnimplementedIngestionServer
+// for forward compatibility
+type IngestionServer interface {
+	// RecordResults records a batch of results of a version in a single transaction.
+	// If any of the results is invalid, none of them are recorded.
+	RecordResults(context.Context, *RecordResultsRequest) (*RecordResultsResponse, error)
+	// StreamResults records the batches of results sent by the client,
+	// which may belong to different versions, as they arrive.
+	// Every batch is recorded in its own transaction; if a batch fails,
+	// the stream is aborted and the batches before it remain recorded.
+	// The response counts the results of all batches.
+	StreamResults(Ingestion_StreamResultsServer) error
+	mustEmbedUnimplementedIngestionServer()
+}
+
+// UnimplementedIngestionServer must be embedded to have forward compatible implementations.
+type UnimplementedIngestionServer struct {
+}
+
+func (UnimplementedIngestionServer) RecordResults(context.Context, *RecordResultsRequest) (*RecordResultsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RecordResults not implemented")
+}
+func (UnimplementedIngestionServer) StreamResults(Ingestion_StreamResultsServer) error {
+	return status.Errorf(codes.Unimplemented, "method StreamResults not implemented")
+}
+func (UnimplementedIngestionServer) mustEmbedUnimplementedIngestionServer() {}
+
+// UnsafeIngestionServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to IngestionServer will
+// result in compilation errors.
+type UnsafeIngestionServer interface {
+	mustEmbedUnimplementedIngestionServer()
+}
+
+func RegisterIngestionServer(s grpc.ServiceRegistrar, srv IngestionServer) {
+	s.RegisterService(&Ingestion_ServiceDesc, srv)
+}
+
+func _Ingestion_RecordResults_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RecordResultsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IngestionServer).RecordResults(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Ingestion_RecordResults_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IngestionServer).RecordResults(ctx, req.(*RecordResultsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Ingestion_StreamResults_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(IngestionServer).StreamResults(&ingestionStreamResultsServer{stream})
+}
+
+type Ingestion_StreamResultsServer interface {
+	SendAndClose(*RecordResultsResponse) error
+	Recv() (*RecordResultsRequest, error)
+	grpc.ServerStream
+}
+
+type ingestionStreamResultsServer struct {
+	grpc.ServerStream
+}
+
+func (x *ingestionStreamResultsServer) SendAndClose(m *RecordResultsResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *ingestionStreamResultsServer) Recv() (*RecordResultsRequest, error) {
+	m := new(RecordResultsRequest)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// Ingestion_ServiceDesc is the grpc.ServiceDesc for Ingestion service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var Ingestion_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "modeltracking.ingestion.v1alpha1.Ingestion",
+	HandlerType: (*IngestionServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "RecordResults",
+			Handler:    _Ingestion_RecordResults_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "StreamResults",
+			Handler:       _Ingestion_StreamResults_Handler,
+			ClientStreams: true,
+		},
+	},
+	Metadata: "api/ingestion/v1alpha1/ingestion.proto",
+}
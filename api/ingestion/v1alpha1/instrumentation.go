@@ -0,0 +1,119 @@
+package v1alpha1
+
+import (
+	"context"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	otelcodes "go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// Instrumentation provides interceptors for gRPC servers that count and time RPCs
+// and wrap them in spans, like the handlers of the HTTP API.
+type Instrumentation struct {
+	handled  *prometheus.CounterVec
+	duration *prometheus.HistogramVec
+	tracer   trace.Tracer
+}
+
+// NewInstrumentation creates new instrumentation that registers its metrics
+// with the given registerer and traces RPCs using the given tracer provider.
+func NewInstrumentation(reg prometheus.Registerer, tp trace.TracerProvider) *Instrumentation {
+	handled := prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "grpc_server_handled_total",
+		Help: "The number of RPCs completed on the server by method and status code.",
+	}, []string{"grpc_method", "grpc_code"})
+	duration := prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Name:    "grpc_server_handling_seconds",
+		Help:    "The duration of RPCs on the server by method.",
+		Buckets: prometheus.DefBuckets,
+	}, []string{"grpc_method"})
+	if reg != nil {
+		reg.MustRegister(handled, duration)
+	}
+	return &Instrumentation{
+		handled:  handled,
+		duration: duration,
+		tracer:   tp.Tracer("github.com/connylabs/model-tracking/api/ingestion/v1alpha1"),
+	}
+}
+
+// UnaryServerInterceptor returns an interceptor for unary RPCs.
+func (i *Instrumentation) UnaryServerInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		ctx, end := i.start(ctx, info.FullMethod)
+		res, err := handler(ctx, req)
+		end(err)
+		return res, err
+	}
+}
+
+// StreamServerInterceptor returns an interceptor for streaming RPCs.
+func (i *Instrumentation) StreamServerInterceptor() grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		ctx, end := i.start(ss.Context(), info.FullMethod)
+		err := handler(srv, &serverStream{ServerStream: ss, ctx: ctx})
+		end(err)
+		return err
+	}
+}
+
+// start starts a span for an RPC that continues the trace propagated by the client, if any,
+// and returns the context to pass to the handler and a function that ends the span.
+func (i *Instrumentation) start(ctx context.Context, method string) (context.Context, func(error)) {
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		ctx = otel.GetTextMapPropagator().Extract(ctx, metadataCarrier(md))
+	}
+	ctx, span := i.tracer.Start(ctx, method, trace.WithSpanKind(trace.SpanKindServer), trace.WithAttributes(attribute.String("rpc.method", method)))
+	start := time.Now()
+	return ctx, func(err error) {
+		code := status.Code(err)
+		i.handled.WithLabelValues(method, code.String()).Inc()
+		i.duration.WithLabelValues(method).Observe(time.Since(start).Seconds())
+		span.SetAttributes(attribute.Int("rpc.grpc.status_code", int(code)))
+		switch code {
+		case codes.Unknown, codes.DeadlineExceeded, codes.Unimplemented, codes.Internal, codes.Unavailable, codes.DataLoss:
+			span.SetStatus(otelcodes.Error, err.Error())
+		}
+		span.End()
+	}
+}
+
+// serverStream is a server stream with the context of its span.
+type serverStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (ss *serverStream) Context() context.Context {
+	return ss.ctx
+}
+
+// metadataCarrier adapts gRPC metadata to a carrier for propagators.
+type metadataCarrier metadata.MD
+
+func (mc metadataCarrier) Get(key string) string {
+	if v := metadata.MD(mc).Get(key); len(v) != 0 {
+		return v[0]
+	}
+	return ""
+}
+
+func (mc metadataCarrier) Set(key, value string) {
+	metadata.MD(mc).Set(key, value)
+}
+
+func (mc metadataCarrier) Keys() []string {
+	keys := make([]string, 0, len(mc))
+	for k := range mc {
+		keys = append(keys, k)
+	}
+	return keys
+}
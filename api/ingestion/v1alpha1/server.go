@@ -0,0 +1,250 @@
+package v1alpha1
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/go-kit/log"
+	"github.com/go-kit/log/level"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+
+	api "github.com/connylabs/model-tracking/api/v1alpha1"
+	"github.com/connylabs/model-tracking/auth"
+	"github.com/connylabs/model-tracking/ratelimit"
+	"github.com/connylabs/model-tracking/store"
+	"github.com/connylabs/model-tracking/store/model-tracking/public/model"
+)
+
+type server struct {
+	UnimplementedIngestionServer
+	store        store.ModelTracking
+	certificates *auth.Certificates
+	limiter      *ratelimit.Limiter
+	logger       log.Logger
+}
+
+// NewServer creates a new server for the ingestion API that records results in the store.
+// Clients with verified TLS client certificates are identified with the given certificates,
+// as by the HTTP API, and may only record results for the organizations that their roles allow;
+// if certificates is nil, all clients may record results for any organization.
+// If limiter is not nil, the rate limits and quotas of organizations are enforced.
+func NewServer(store store.ModelTracking, certificates *auth.Certificates, limiter *ratelimit.Limiter, logger log.Logger) IngestionServer {
+	if logger == nil {
+		logger = log.NewNopLogger()
+	}
+	return &server{
+		store:        store,
+		certificates: certificates,
+		limiter:      limiter,
+		logger:       logger,
+	}
+}
+
+func (s *server) RecordResults(ctx context.Context, req *RecordResultsRequest) (*RecordResultsResponse, error) {
+	created, skipped, err := s.record(ctx, req, make(map[string]*api.ResultValidator))
+	if err != nil {
+		return nil, err
+	}
+	return &RecordResultsResponse{Created: int32(created), Skipped: int32(skipped)}, nil
+}
+
+func (s *server) StreamResults(stream Ingestion_StreamResultsServer) error {
+	// The schemas of versions do not change,
+	// so their validators are reused for the whole stream.
+	validators := make(map[string]*api.ResultValidator)
+	res := new(RecordResultsResponse)
+	for {
+		req, err := stream.Recv()
+		if err == io.EOF {
+			return stream.SendAndClose(res)
+		}
+		if err != nil {
+			return err
+		}
+		created, skipped, err := s.record(stream.Context(), req, validators)
+		if err != nil {
+			return err
+		}
+		res.Created += int32(created)
+		res.Skipped += int32(skipped)
+	}
+}
+
+// record validates and creates a batch of results in a single transaction.
+func (s *server) record(ctx context.Context, req *RecordResultsRequest, validators map[string]*api.ResultValidator) (created, skipped int, err error) {
+	if req.Organization == "" || req.Model == "" {
+		return 0, 0, status.Error(codes.InvalidArgument, "the organization and model must be set")
+	}
+	if (req.Version == "") == (req.Stage == "") {
+		return 0, 0, status.Error(codes.InvalidArgument, "exactly one of version and stage must be set")
+	}
+	client, err := s.authorize(ctx, req.Organization)
+	if err != nil {
+		return 0, 0, err
+	}
+	if err := s.allow(ctx, req, client); err != nil {
+		return 0, 0, err
+	}
+
+	version := req.Version
+	if req.Stage != "" {
+		st, err := s.store.Stages(req.Organization, req.Model).Get(ctx, req.Stage)
+		if err != nil {
+			return 0, 0, s.statusError(err)
+		}
+		v, err := s.store.Versions(req.Organization, req.Model).GetByID(ctx, int(st.Version))
+		if err != nil {
+			return 0, 0, s.statusError(err)
+		}
+		version = v.Name
+	}
+
+	key := strings.Join([]string{req.Organization, req.Model, version}, "/")
+	validator, ok := validators[key]
+	if !ok {
+		if validator, err = api.NewResultValidator(ctx, s.store, req.Organization, req.Model, version); err != nil {
+			return 0, 0, s.statusError(err)
+		}
+		validators[key] = validator
+	}
+
+	var i int
+	next := func() (*model.Result, error) {
+		if i == len(req.Results) {
+			return nil, io.EOF
+		}
+		body, err := newResult(req.Results[i])
+		if err == nil {
+			var res *model.Result
+			if res, err = validator.Result(body); err == nil {
+				i++
+				return res, nil
+			}
+		}
+		return nil, fmt.Errorf("result %d: %w", i, err)
+	}
+	created, skipped, err = s.store.Results(req.Organization, req.Model, version).CreateBulk(ctx, next)
+	if err != nil {
+		return 0, 0, s.statusError(err)
+	}
+	if s.limiter != nil {
+		s.limiter.AddResults(req.Organization, int64(created))
+	}
+	return created, skipped, nil
+}
+
+// authorize checks that the client may record results for the organization
+// and returns the identity of the client for rate limiting.
+// If client certificates are mapped, clients without a verified certificate are rejected.
+func (s *server) authorize(ctx context.Context, organization string) (string, error) {
+	if s.certificates == nil {
+		return "", nil
+	}
+	p, ok := peer.FromContext(ctx)
+	if !ok {
+		return "", status.Error(codes.Unauthenticated, "a verified client certificate is required")
+	}
+	ti, ok := p.AuthInfo.(credentials.TLSInfo)
+	if !ok || len(ti.State.VerifiedChains) == 0 {
+		return "", status.Error(codes.Unauthenticated, "a verified client certificate is required")
+	}
+	cert := ti.State.VerifiedChains[0][0]
+	i, err := s.certificates.Identify(cert)
+	if err != nil {
+		level.Debug(s.logger).Log("msg", "rejected client certificate", "subject", cert.Subject.String())
+		return "", status.Error(codes.Unauthenticated, err.Error())
+	}
+	// Recording results is a write like creating them with the HTTP API.
+	if !i.Allowed(organization, http.MethodPost) {
+		return "", status.Error(codes.PermissionDenied, "the client certificate does not allow this request")
+	}
+	return "identity:" + i.Name, nil
+}
+
+// allow enforces the rate limits and quotas of the organization.
+// Every batch counts as a request, and its results count against the results quota.
+func (s *server) allow(ctx context.Context, req *RecordResultsRequest, client string) error {
+	if s.limiter == nil {
+		return nil
+	}
+	if d := s.limiter.Allow(req.Organization, client); !d.Allowed {
+		return throttled(d)
+	}
+	if d := s.limiter.AllowPayload(req.Organization, int64(proto.Size(req))); !d.Allowed {
+		return status.Error(codes.ResourceExhausted, "the batch of results is too large")
+	}
+	d, err := s.limiter.AllowResults(ctx, req.Organization, int64(len(req.Results)))
+	if err != nil {
+		// Do not reject results because quotas are temporarily unavailable.
+		level.Warn(s.logger).Log("msg", "failed to check the results quota", "organization", req.Organization, "err", err.Error())
+		return nil
+	}
+	if !d.Allowed {
+		return throttled(d)
+	}
+	return nil
+}
+
+// throttled returns the error for a request rejected by the rate limiter.
+func throttled(d ratelimit.Decision) error {
+	return status.Errorf(codes.ResourceExhausted, "too many requests: %s exceeded; retry after %s", strings.ReplaceAll(string(d.Reason), "_", " "), d.RetryAfter.Round(time.Second))
+}
+
+// newResult converts a result to a new result of the HTTP API,
+// so that it is validated in the same way.
+func newResult(r *Result) (*api.NewResult, error) {
+	body := &api.NewResult{
+		Input:      json.RawMessage(r.Input),
+		Output:     json.RawMessage(r.Output),
+		TrueOutput: json.RawMessage(r.TrueOutput),
+	}
+	if r.Time != nil {
+		if err := r.Time.CheckValid(); err != nil {
+			return nil, &store.Error{Kind: store.ErrInvalid, Message: err.Error()}
+		}
+		t := r.Time.AsTime()
+		body.Time = &t
+	}
+	if len(r.Labels) != 0 {
+		l := api.Labels(r.Labels)
+		body.Labels = &l
+	}
+	if r.CorrelationId != "" {
+		body.CorrelationID = &r.CorrelationId
+	}
+	if r.ClientId != "" {
+		body.ClientID = &r.ClientId
+	}
+	return body, nil
+}
+
+// statusError converts an error returned by the store to a status with the corresponding code.
+// Unexpected errors are logged, since clients cannot do anything about them.
+func (s *server) statusError(err error) error {
+	var code codes.Code
+	switch {
+	case errors.Is(err, context.Canceled), errors.Is(err, context.DeadlineExceeded):
+		return status.FromContextError(err).Err()
+	case errors.Is(err, store.ErrNotFound):
+		code = codes.NotFound
+	case errors.Is(err, store.ErrInvalid):
+		code = codes.InvalidArgument
+	case errors.Is(err, store.ErrConflict), errors.Is(err, store.ErrPreconditionFailed):
+		// e.g. the version is archived.
+		code = codes.FailedPrecondition
+	default:
+		level.Error(s.logger).Log("msg", "unexpected error", "err", err.Error())
+		code = codes.Internal
+	}
+	return status.Error(code, err.Error())
+}
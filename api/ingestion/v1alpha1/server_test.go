@@ -0,0 +1,224 @@
+package v1alpha1
+
+import (
+	"context"
+	"io"
+	"net"
+	"testing"
+
+	"github.com/efficientgo/core/testutil"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+
+	"github.com/connylabs/model-tracking/auth"
+	"github.com/connylabs/model-tracking/store"
+	"github.com/connylabs/model-tracking/store/model-tracking/public/model"
+)
+
+type fakeStore struct {
+	store.ModelTracking
+	results map[string][]*model.Result
+}
+
+func (fs *fakeStore) Versions(string, string) store.Versions {
+	return fakeVersions{}
+}
+
+func (fs *fakeStore) Schemas(string) store.Schemas {
+	return fakeSchemas{}
+}
+
+func (fs *fakeStore) Stages(string, string) store.Stages {
+	return fakeStages{}
+}
+
+func (fs *fakeStore) Results(_, _, version string) store.Results {
+	return &fakeResults{fs: fs, version: version}
+}
+
+type fakeVersions struct {
+	store.Versions
+}
+
+func (fakeVersions) GetOrCreate(_ context.Context, name string) (*model.Version, error) {
+	if name == "archived" {
+		return nil, &store.Error{Kind: store.ErrConflict, Message: "version \"archived\" is archived"}
+	}
+	return &model.Version{Name: name, Schema: 1}, nil
+}
+
+func (fakeVersions) GetByID(_ context.Context, id int) (*model.Version, error) {
+	return &model.Version{ID: int32(id), Name: "v2", Schema: 1}, nil
+}
+
+type fakeSchemas struct {
+	store.Schemas
+}
+
+func (fakeSchemas) GetByID(_ context.Context, id int) (*model.Schema, error) {
+	return &model.Schema{ID: int32(id), Input: []byte(`{"type": "string"}`), Output: []byte(`{"type": "integer"}`)}, nil
+}
+
+type fakeStages struct {
+	store.Stages
+}
+
+func (fakeStages) Get(_ context.Context, name string) (*model.Stage, error) {
+	if name != "production" {
+		return nil, &store.Error{Kind: store.ErrNotFound, Message: "stage not found"}
+	}
+	return &model.Stage{Name: name, Version: 2}, nil
+}
+
+type fakeResults struct {
+	store.Results
+	fs      *fakeStore
+	version string
+}
+
+func (fr *fakeResults) CreateBulk(_ context.Context, next func() (*model.Result, error)) (int, int, error) {
+	var rs []*model.Result
+	var skipped int
+	for {
+		r, err := next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return 0, 0, err
+		}
+		if r.ClientID != nil && *r.ClientID == "duplicate" {
+			skipped++
+			continue
+		}
+		rs = append(rs, r)
+	}
+	// Like a transaction, nothing is created if any result is invalid.
+	fr.fs.results[fr.version] = append(fr.fs.results[fr.version], rs...)
+	return len(rs), skipped, nil
+}
+
+func newClient(t *testing.T, fs *fakeStore, certificates *auth.Certificates) IngestionClient {
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	testutil.Ok(t, err)
+	srv := grpc.NewServer()
+	RegisterIngestionServer(srv, NewServer(fs, certificates, nil, nil))
+	go srv.Serve(l) //nolint:errcheck
+	t.Cleanup(srv.Stop)
+
+	conn, err := grpc.Dial(l.Addr().String(), grpc.WithTransportCredentials(insecure.NewCredentials()))
+	testutil.Ok(t, err)
+	t.Cleanup(func() { conn.Close() })
+	return NewIngestionClient(conn)
+}
+
+func TestRecordResults(t *testing.T) {
+	fs := &fakeStore{results: make(map[string][]*model.Result)}
+	c := newClient(t, fs, nil)
+	ctx := context.Background()
+
+	for _, tc := range []struct {
+		name    string
+		req     *RecordResultsRequest
+		code    codes.Code
+		res     *RecordResultsResponse
+		version string
+	}{
+		{
+			name: "version",
+			req: &RecordResultsRequest{Organization: "foo", Model: "bar", Version: "v1", Results: []*Result{
+				{Input: []byte(`"a"`), Output: []byte(`1`), TrueOutput: []byte(`1`), Labels: map[string]string{"region": "eu"}},
+				{Input: []byte(`"b"`), Output: []byte(`2`), TrueOutput: []byte(`1`), ClientId: "duplicate"},
+			}},
+			res:     &RecordResultsResponse{Created: 1, Skipped: 1},
+			version: "v1",
+		},
+		{
+			name: "stage",
+			req: &RecordResultsRequest{Organization: "foo", Model: "bar", Stage: "production", Results: []*Result{
+				{Input: []byte(`"a"`), Output: []byte(`1`), TrueOutput: []byte(`1`)},
+			}},
+			res:     &RecordResultsResponse{Created: 1},
+			version: "v2",
+		},
+		{
+			name: "version and stage",
+			req:  &RecordResultsRequest{Organization: "foo", Model: "bar", Version: "v1", Stage: "production"},
+			code: codes.InvalidArgument,
+		},
+		{
+			name: "unknown stage",
+			req:  &RecordResultsRequest{Organization: "foo", Model: "bar", Stage: "staging"},
+			code: codes.NotFound,
+		},
+		{
+			name: "archived",
+			req:  &RecordResultsRequest{Organization: "foo", Model: "bar", Version: "archived"},
+			code: codes.FailedPrecondition,
+		},
+		{
+			name: "invalid",
+			req: &RecordResultsRequest{Organization: "foo", Model: "bar", Version: "v3", Results: []*Result{
+				{Input: []byte(`"a"`), Output: []byte(`1`), TrueOutput: []byte(`1`)},
+				{Input: []byte(`1`), Output: []byte(`1`), TrueOutput: []byte(`1`)},
+			}},
+			code: codes.InvalidArgument,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			before := len(fs.results[tc.req.Version])
+			res, err := c.RecordResults(ctx, tc.req)
+			testutil.Equals(t, tc.code, status.Code(err))
+			if tc.code != codes.OK {
+				testutil.Equals(t, before, len(fs.results[tc.req.Version]))
+				return
+			}
+			testutil.Equals(t, tc.res.Created, res.Created)
+			testutil.Equals(t, tc.res.Skipped, res.Skipped)
+			testutil.Equals(t, int(tc.res.Created), len(fs.results[tc.version]))
+		})
+	}
+}
+
+func TestStreamResults(t *testing.T) {
+	fs := &fakeStore{results: make(map[string][]*model.Result)}
+	c := newClient(t, fs, nil)
+
+	stream, err := c.StreamResults(context.Background())
+	testutil.Ok(t, err)
+	for _, v := range []string{"v1", "v2", "v1"} {
+		testutil.Ok(t, stream.Send(&RecordResultsRequest{Organization: "foo", Model: "bar", Version: v, Results: []*Result{
+			{Input: []byte(`"a"`), Output: []byte(`1`), TrueOutput: []byte(`1`)},
+			{Input: []byte(`"b"`), Output: []byte(`2`), TrueOutput: []byte(`1`), CorrelationId: "x"},
+		}}))
+	}
+	res, err := stream.CloseAndRecv()
+	testutil.Ok(t, err)
+	testutil.Equals(t, int32(6), res.Created)
+	testutil.Equals(t, 4, len(fs.results["v1"]))
+	testutil.Equals(t, 2, len(fs.results["v2"]))
+	testutil.Equals(t, "x", *fs.results["v2"][1].CorrelationID)
+
+	// A failed batch aborts the stream.
+	stream, err = c.StreamResults(context.Background())
+	testutil.Ok(t, err)
+	testutil.Ok(t, stream.Send(&RecordResultsRequest{Organization: "foo", Model: "bar", Version: "v1", Results: []*Result{
+		{Input: []byte(`"a"`), Output: []byte(`"1"`), TrueOutput: []byte(`1`)},
+	}}))
+	_, err = stream.CloseAndRecv()
+	testutil.Equals(t, codes.InvalidArgument, status.Code(err))
+	testutil.Equals(t, 4, len(fs.results["v1"]))
+}
+
+func TestRecordResultsWithoutCertificate(t *testing.T) {
+	fs := &fakeStore{results: make(map[string][]*model.Result)}
+	c := newClient(t, fs, &auth.Certificates{Certificates: []auth.Certificate{{CommonName: "platform", Role: auth.Admin}}})
+
+	_, err := c.RecordResults(context.Background(), &RecordResultsRequest{Organization: "foo", Model: "bar", Version: "v1", Results: []*Result{
+		{Input: []byte(`"a"`), Output: []byte(`1`), TrueOutput: []byte(`1`)},
+	}})
+	testutil.Equals(t, codes.Unauthenticated, status.Code(err))
+	testutil.Equals(t, 0, len(fs.results["v1"]))
+}
@@ -0,0 +1,42 @@
+package v1alpha1
+
+import (
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/go-kit/log"
+	"github.com/go-kit/log/level"
+
+	"github.com/connylabs/model-tracking/auth"
+)
+
+// NewCertificateAuthMiddleware returns a middleware that identifies clients
+// by their verified TLS client certificates and only lets them access
+// the organizations and make the requests that their roles allow.
+// Requests without a verified client certificate are rejected, even if the TLS
+// configuration makes certificates optional, since they cannot be mapped to a role.
+// The middleware must be passed to HandlerWithOptions so that
+// it runs after the path parameters have been matched.
+func NewCertificateAuthMiddleware(certificates *auth.Certificates, logger log.Logger) MiddlewareFunc {
+	httpError := httpError(logger)
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.TLS == nil || len(r.TLS.VerifiedChains) == 0 {
+				httpError(w, "a verified client certificate is required", http.StatusUnauthorized)
+				return
+			}
+			cert := r.TLS.VerifiedChains[0][0]
+			i, err := certificates.Identify(cert)
+			if err != nil {
+				level.Debug(logger).Log("msg", "rejected client certificate", "subject", cert.Subject.String())
+				httpError(w, err.Error(), http.StatusUnauthorized)
+				return
+			}
+			if !i.Allowed(chi.URLParam(r, "organization"), r.Method) {
+				httpError(w, "the client certificate does not allow this request", http.StatusForbidden)
+				return
+			}
+			next.ServeHTTP(w, r.WithContext(auth.NewContext(r.Context(), i)))
+		})
+	}
+}
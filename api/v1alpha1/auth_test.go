@@ -0,0 +1,42 @@
+package v1alpha1
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/efficientgo/core/testutil"
+	"github.com/go-chi/chi/v5"
+	"github.com/go-kit/log"
+
+	"github.com/connylabs/model-tracking/auth"
+)
+
+func TestCertificateAuthMiddleware(t *testing.T) {
+	certificates := &auth.Certificates{Certificates: []auth.Certificate{{CommonName: "dashboard", Organization: "foo", Role: auth.Reader}}}
+	r := chi.NewRouter()
+	r.With(NewCertificateAuthMiddleware(certificates, log.NewNopLogger())).Get("/organizations/{organization}", func(w http.ResponseWriter, r *http.Request) {
+		i, ok := auth.FromContext(r.Context())
+		testutil.Assert(t, ok, "the identity of the client should be in the context")
+		testutil.Equals(t, "CN=dashboard", i.Name)
+	})
+
+	do := func(method, organization, commonName string) int {
+		req := httptest.NewRequest(method, "/organizations/"+organization, nil)
+		if commonName != "" {
+			req.TLS = &tls.ConnectionState{VerifiedChains: [][]*x509.Certificate{{{Subject: pkix.Name{CommonName: commonName}}}}}
+		}
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, req)
+		return w.Code
+	}
+
+	testutil.Equals(t, http.StatusOK, do(http.MethodGet, "foo", "dashboard"))
+	testutil.Equals(t, http.StatusForbidden, do(http.MethodGet, "bar", "dashboard"))
+	testutil.Equals(t, http.StatusUnauthorized, do(http.MethodGet, "foo", "unknown"))
+	// Clients without a verified certificate are rejected, even if certificates are optional.
+	testutil.Equals(t, http.StatusUnauthorized, do(http.MethodGet, "foo", ""))
+}
@@ -0,0 +1,112 @@
+package v1alpha1
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/go-kit/log"
+)
+
+// ParseBodyLimits parses limits for the sizes of request bodies per operation
+// from a comma-separated list of operation IDs and numbers of bytes,
+// e.g. "results-create-for-version=65536,results-create-bulk-for-version=268435456".
+func ParseBodyLimits(s string) (map[string]int64, error) {
+	limits := make(map[string]int64)
+	if s == "" {
+		return limits, nil
+	}
+	for _, l := range strings.Split(s, ",") {
+		op, n, ok := strings.Cut(strings.TrimSpace(l), "=")
+		if !ok {
+			return nil, fmt.Errorf("body limit %q must be of the form operation-id=bytes", l)
+		}
+		b, err := strconv.ParseInt(n, 10, 64)
+		if err != nil || b < 0 {
+			return nil, fmt.Errorf("body limit of operation %q must be a non-negative number of bytes", op)
+		}
+		limits[op] = b
+	}
+	return limits, nil
+}
+
+// NewBodyLimitMiddleware returns a middleware that limits the size of the bodies of requests.
+// Requests for operations with an ID in limits are limited to the respective number of bytes;
+// all other requests are limited to the default number of bytes. Zero means that the size is unlimited.
+// Requests that announce a larger body are rejected right away, while the bodies of
+// chunked requests are cut off, which handlers report with a 413 response.
+// The middleware must be passed to HandlerWithOptions together with
+// the same base URL so that it can match requests to operations.
+func NewBodyLimitMiddleware(def int64, limits map[string]int64, baseURL string, logger log.Logger) (MiddlewareFunc, error) {
+	operations, err := operationRoutes(baseURL)
+	if err != nil {
+		return nil, err
+	}
+	// Map the method and route pattern of every operation to its limit.
+	routes := make(map[string]int64)
+	for op := range limits {
+		rt, ok := operations[op]
+		if !ok {
+			return nil, fmt.Errorf("unknown operation %q", op)
+		}
+		routes[rt] = limits[op]
+	}
+
+	httpError := httpError(logger)
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			limit, ok := routes[route(r)]
+			if !ok {
+				limit = def
+			}
+			if limit > 0 {
+				if r.ContentLength > limit {
+					httpError(w, fmt.Sprintf("the request body exceeds the limit of %d bytes", limit), http.StatusRequestEntityTooLarge)
+					return
+				}
+				r.Body = http.MaxBytesReader(w, r.Body, limit)
+			}
+			next.ServeHTTP(w, r)
+		})
+	}, nil
+}
+
+// operationRoutes maps the IDs of all operations of the API to their routes,
+// i.e. their methods and path patterns when served at the base URL.
+func operationRoutes(baseURL string) (map[string]string, error) {
+	swagger, err := GetSwagger()
+	if err != nil {
+		return nil, fmt.Errorf("failed to load the API specification: %w", err)
+	}
+	routes := make(map[string]string)
+	for path, item := range swagger.Paths {
+		for method, op := range item.Operations() {
+			routes[operationID(op.OperationID)] = method + " " + baseURL + path
+		}
+	}
+	return routes, nil
+}
+
+// operationID converts the ID of an operation in the embedded specification,
+// e.g. ResultsCreateForVersion, back to the ID in v1alpha1.yaml, e.g. results-create-for-version.
+func operationID(id string) string {
+	var b strings.Builder
+	for i, r := range id {
+		if unicode.IsUpper(r) {
+			if i > 0 {
+				b.WriteByte('-')
+			}
+			r = unicode.ToLower(r)
+		}
+		b.WriteRune(r)
+	}
+	return b.String()
+}
+
+// route returns the route of a request as returned by operationRoutes.
+func route(r *http.Request) string {
+	return r.Method + " " + chi.RouteContext(r.Context()).RoutePattern()
+}
@@ -0,0 +1,55 @@
+package v1alpha1
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/efficientgo/core/testutil"
+	"github.com/go-chi/chi/v5"
+)
+
+func TestBodyLimitMiddleware(t *testing.T) {
+	limits, err := ParseBodyLimits("results-create-bulk-for-version=8")
+	testutil.Ok(t, err)
+	_, err = ParseBodyLimits("results-create-bulk-for-version")
+	testutil.NotOk(t, err)
+	_, err = NewBodyLimitMiddleware(4, map[string]int64{"nonexistent-operation": 1}, "/api/v1alpha1", nil)
+	testutil.NotOk(t, err)
+
+	mw, err := NewBodyLimitMiddleware(4, limits, "/api/v1alpha1", nil)
+	testutil.Ok(t, err)
+	r := chi.NewRouter()
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		if _, err := io.ReadAll(r.Body); err != nil {
+			w.WriteHeader(http.StatusRequestEntityTooLarge)
+			return
+		}
+		w.WriteHeader(http.StatusCreated)
+	}
+	r.With(mw).Post("/api/v1alpha1/organizations/{organization}/models/{model}/versions/{version}/results", handler)
+	r.With(mw).Post("/api/v1alpha1/organizations/{organization}/models/{model}/versions/{version}/results/bulk", handler)
+
+	for _, tc := range []struct {
+		path    string
+		body    string
+		chunked bool
+		status  int
+	}{
+		{path: "/results", body: "1234", status: http.StatusCreated},
+		{path: "/results", body: "12345", status: http.StatusRequestEntityTooLarge},
+		{path: "/results", body: "12345", chunked: true, status: http.StatusRequestEntityTooLarge},
+		{path: "/results/bulk", body: "12345678", status: http.StatusCreated},
+		{path: "/results/bulk", body: "123456789", status: http.StatusRequestEntityTooLarge},
+	} {
+		req := httptest.NewRequest(http.MethodPost, "/api/v1alpha1/organizations/foo/models/bar/versions/baz"+tc.path, strings.NewReader(tc.body))
+		if tc.chunked {
+			req.ContentLength = -1
+		}
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, req)
+		testutil.Equals(t, tc.status, w.Code, "%s with %q", tc.path, tc.body)
+	}
+}
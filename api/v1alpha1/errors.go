@@ -0,0 +1,139 @@
+package v1alpha1
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/go-kit/log"
+	"github.com/go-kit/log/level"
+
+	"github.com/connylabs/model-tracking/store"
+)
+
+// The codes of problems. Unlike the details of problems, codes are stable,
+// so clients may use them to handle errors.
+const (
+	// CodeMalformedRequest means that the request could not be parsed, e.g. because its body is not valid JSON.
+	CodeMalformedRequest = "malformed_request"
+	// CodeUnauthenticated means that the client could not be identified.
+	CodeUnauthenticated = "unauthenticated"
+	// CodeForbidden means that the client may not make the request.
+	CodeForbidden = "forbidden"
+	// CodeNotFound means that a resource does not exist.
+	CodeNotFound = "not_found"
+	// CodeConflict means that the request conflicts with the current state of a resource,
+	// e.g. because a resource with the same name already exists.
+	CodeConflict = "conflict"
+	// CodePreconditionFailed means that a precondition of the request does not hold.
+	CodePreconditionFailed = "precondition_failed"
+	// CodePayloadTooLarge means that the body of the request is too large.
+	CodePayloadTooLarge = "payload_too_large"
+	// CodeInvalid means that the request is well-formed but invalid,
+	// e.g. because a result does not match the schema of its version.
+	CodeInvalid = "invalid"
+	// CodeRateLimited means that the client made too many requests.
+	CodeRateLimited = "rate_limited"
+	// CodeQuotaExceeded means that the organization exceeded a quota.
+	CodeQuotaExceeded = "quota_exceeded"
+	// CodeUnavailable means that the server cannot handle the request right now,
+	// e.g. because its ingestion queue is full. Clients should retry later.
+	CodeUnavailable = "unavailable"
+	// CodeInternal means that the server failed unexpectedly.
+	CodeInternal = "internal"
+)
+
+// problemCodes are the default codes of problems by HTTP status code.
+var problemCodes = map[int]string{
+	http.StatusBadRequest:            CodeMalformedRequest,
+	http.StatusUnauthorized:          CodeUnauthenticated,
+	http.StatusForbidden:             CodeForbidden,
+	http.StatusNotFound:              CodeNotFound,
+	http.StatusConflict:              CodeConflict,
+	http.StatusPreconditionFailed:    CodePreconditionFailed,
+	http.StatusRequestEntityTooLarge: CodePayloadTooLarge,
+	http.StatusUnprocessableEntity:   CodeInvalid,
+	http.StatusTooManyRequests:       CodeRateLimited,
+	http.StatusServiceUnavailable:    CodeUnavailable,
+}
+
+// httpProblem writes a problem as an RFC 7807 application/problem+json response.
+func httpProblem(logger log.Logger) func(w http.ResponseWriter, code, detail string, status int) {
+	return func(w http.ResponseWriter, code, detail string, status int) {
+		if status/100 == 5 {
+			level.Error(logger).Log("msg", "unexpected error", "code", strconv.Itoa(status), "err", detail)
+		}
+		w.Header().Set("Content-Type", "application/problem+json")
+		w.WriteHeader(status)
+		if err := json.NewEncoder(w).Encode(&Problem{
+			Type:   "about:blank",
+			Title:  http.StatusText(status),
+			Status: status,
+			Detail: detail,
+			Code:   code,
+		}); err != nil {
+			level.Error(logger).Log("msg", "failed to write response", "err", err.Error())
+		}
+	}
+}
+
+// httpError writes a problem with the default code of the HTTP status code.
+func httpError(logger log.Logger) func(w http.ResponseWriter, m string, code int) {
+	hp := httpProblem(logger)
+	return func(w http.ResponseWriter, m string, code int) {
+		c, ok := problemCodes[code]
+		if !ok {
+			c = CodeInternal
+		}
+		hp(w, c, m, code)
+	}
+}
+
+// storeErrorStatus returns the HTTP status code for an error returned by the store.
+func storeErrorStatus(err error) int {
+	switch {
+	case errors.Is(err, store.ErrNotFound):
+		return http.StatusNotFound
+	case errors.Is(err, store.ErrConflict):
+		return http.StatusConflict
+	case errors.Is(err, store.ErrInvalid):
+		return http.StatusUnprocessableEntity
+	case errors.Is(err, store.ErrPreconditionFailed):
+		return http.StatusPreconditionFailed
+	default:
+		return http.StatusInternalServerError
+	}
+}
+
+// httpStoreError writes the response for an error returned by the store.
+func (s *server) httpStoreError(w http.ResponseWriter, err error) {
+	s.httpError(w, err.Error(), storeErrorStatus(err))
+}
+
+// httpDecodeError writes the response for an error decoding the body of a request.
+func (s *server) httpDecodeError(w http.ResponseWriter, err error) {
+	var mbe *http.MaxBytesError
+	if errors.As(err, &mbe) {
+		s.httpError(w, fmt.Sprintf("the request body exceeds the limit of %d bytes", mbe.Limit), http.StatusRequestEntityTooLarge)
+		return
+	}
+	// Well-formed JSON with values of the wrong types is invalid rather than malformed.
+	var ute *json.UnmarshalTypeError
+	if errors.As(err, &ute) {
+		s.httpError(w, err.Error(), http.StatusUnprocessableEntity)
+		return
+	}
+	s.httpError(w, fmt.Sprintf("the request body is malformed: %v", err), http.StatusBadRequest)
+}
+
+// NewErrorHandler returns a handler for requests whose parameters cannot be parsed
+// that writes problems like the rest of the API.
+// It is meant to be used as the ErrorHandlerFunc of ChiServerOptions.
+func NewErrorHandler(logger log.Logger) func(w http.ResponseWriter, r *http.Request, err error) {
+	httpError := httpError(logger)
+	return func(w http.ResponseWriter, _ *http.Request, err error) {
+		httpError(w, err.Error(), http.StatusBadRequest)
+	}
+}
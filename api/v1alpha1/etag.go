@@ -0,0 +1,66 @@
+package v1alpha1
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/connylabs/model-tracking/store"
+)
+
+// etag returns the entity tag of a resource, which changes whenever the resource is updated.
+// The ID is part of the tag so that a resource that is recreated with the same name
+// does not match the tags of its predecessor.
+func etag(id int32, updated *time.Time) string {
+	var u int64
+	if updated != nil {
+		u = updated.UnixMicro()
+	}
+	return `"` + strconv.FormatInt(int64(id), 36) + "-" + strconv.FormatInt(u, 36) + `"`
+}
+
+// matchETag reports whether an entity tag is in the list of entity tags of an If-Match
+// or If-None-Match header. A wildcard matches any tag. Weak tags only match
+// if weak comparison is allowed, as it is for If-None-Match.
+func matchETag(header, tag string, weak bool) bool {
+	for _, t := range strings.Split(header, ",") {
+		t = strings.TrimSpace(t)
+		if t == "*" {
+			return true
+		}
+		if strings.HasPrefix(t, "W/") {
+			if !weak {
+				continue
+			}
+			t = strings.TrimPrefix(t, "W/")
+		}
+		if t == tag {
+			return true
+		}
+	}
+	return false
+}
+
+// writeETag sets the ETag header of the response to the entity tag of a resource.
+// If the tag matches the If-None-Match header, it writes a 304 response and returns false,
+// in which case the resource must not be written.
+func writeETag(w http.ResponseWriter, ifNoneMatch *string, id int32, updated *time.Time) bool {
+	tag := etag(id, updated)
+	w.Header().Set("ETag", tag)
+	if ifNoneMatch != nil && matchETag(*ifNoneMatch, tag, true) {
+		w.WriteHeader(http.StatusNotModified)
+		return false
+	}
+	return true
+}
+
+// checkIfMatch returns an ErrPreconditionFailed error if the If-Match header, if any,
+// does not match the entity tag of the current version of a resource.
+func checkIfMatch(ifMatch *string, resource string, id int32, updated *time.Time) error {
+	if ifMatch == nil || matchETag(*ifMatch, etag(id, updated), false) {
+		return nil
+	}
+	return &store.Error{Kind: store.ErrPreconditionFailed, Message: fmt.Sprintf("the %s was changed since the version in the If-Match header", resource)}
+}
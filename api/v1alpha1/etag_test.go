@@ -0,0 +1,49 @@
+package v1alpha1
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/efficientgo/core/testutil"
+)
+
+func TestMatchETag(t *testing.T) {
+	for _, tc := range []struct {
+		name   string
+		header string
+		weak   bool
+		match  bool
+	}{
+		{name: "equal", header: `"1-a"`, match: true},
+		{name: "different", header: `"1-b"`},
+		{name: "list", header: `"1-b", "1-a"`, match: true},
+		{name: "wildcard", header: "*", match: true},
+		{name: "weak with strong comparison", header: `W/"1-a"`},
+		{name: "weak with weak comparison", header: `W/"1-a"`, weak: true, match: true},
+		{name: "unquoted", header: "1-a"},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			testutil.Equals(t, tc.match, matchETag(tc.header, `"1-a"`, tc.weak))
+		})
+	}
+}
+
+func TestWriteETag(t *testing.T) {
+	updated := time.Date(2023, 8, 1, 12, 0, 0, 1000, time.UTC)
+	tag := etag(1, &updated)
+	later := updated.Add(time.Microsecond)
+	testutil.Assert(t, tag != etag(1, &later), "the entity tag should change when the resource is updated")
+	testutil.Assert(t, tag != etag(2, &updated), "the entity tag should differ between resources")
+
+	w := httptest.NewRecorder()
+	testutil.Assert(t, writeETag(w, nil, 1, &updated))
+	testutil.Equals(t, tag, w.Header().Get("ETag"))
+	testutil.Equals(t, http.StatusOK, w.Code)
+
+	w = httptest.NewRecorder()
+	testutil.Assert(t, !writeETag(w, &tag, 1, &updated))
+	testutil.Equals(t, tag, w.Header().Get("ETag"))
+	testutil.Equals(t, http.StatusNotModified, w.Code)
+}
@@ -0,0 +1,184 @@
+package v1alpha1
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"hash"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/go-kit/log"
+	"github.com/go-kit/log/level"
+
+	"github.com/connylabs/model-tracking/store"
+)
+
+const (
+	idempotencyKeyHeader = "Idempotency-Key"
+	// idempotentReplayedHeader is set on responses that were not produced by the request itself
+	// but by an earlier request, e.g. one that the client retried.
+	idempotentReplayedHeader = "Idempotent-Replayed"
+	// maxIdempotencyKeyLength is the maximum length of an idempotency key.
+	maxIdempotencyKeyLength = 255
+	// idempotencyStoreTimeout is the time after which recording the outcome of a request
+	// is abandoned. It is independent of the request, which may have been canceled.
+	idempotencyStoreTimeout = 10 * time.Second
+)
+
+// NewIdempotencyMiddleware returns a middleware that makes requests for the operations
+// with the given IDs idempotent if clients send an Idempotency-Key header.
+// The response to the first successful request with a key is recorded for the time to live
+// and returned again for later requests with the same key and body instead of repeating the request.
+// Requests with a key that is in use by a request in progress receive a 409 response
+// and requests that reuse a key for a different request a 422 response.
+// A key is reserved for a request in progress only for the lease, so that a key whose request
+// never completed, e.g. because the server crashed, can be retried once the lease expires.
+// The lease should therefore be longer than any request takes.
+// The middleware must be passed to HandlerWithOptions together with
+// the same base URL so that it can match requests to operations.
+func NewIdempotencyMiddleware(s store.ModelTracking, operations []string, lease, ttl time.Duration, baseURL string, logger log.Logger) (MiddlewareFunc, error) {
+	all, err := operationRoutes(baseURL)
+	if err != nil {
+		return nil, err
+	}
+	routes := make(map[string]struct{})
+	for _, op := range operations {
+		rt, ok := all[op]
+		if !ok {
+			return nil, fmt.Errorf("unknown operation %q", op)
+		}
+		routes[rt] = struct{}{}
+	}
+
+	httpError := httpError(logger)
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			key := r.Header.Get(idempotencyKeyHeader)
+			organization := chi.URLParam(r, "organization")
+			rt := route(r)
+			if _, ok := routes[rt]; !ok || key == "" || organization == "" {
+				next.ServeHTTP(w, r)
+				return
+			}
+			if len(key) > maxIdempotencyKeyLength {
+				httpError(w, fmt.Sprintf("the idempotency key must not be longer than %d characters", maxIdempotencyKeyLength), http.StatusBadRequest)
+				return
+			}
+
+			// Fingerprint the request as its body is read, so that the body need not be buffered.
+			// The path and query are part of the fingerprint, so that a key cannot be reused
+			// for another model, version or stage.
+			f := newFingerprint(r.Method, r.URL.RequestURI(), r.Body)
+			r.Body = f
+
+			keys := s.IdempotencyKeys(organization)
+			k, created, err := keys.Reserve(r.Context(), key, lease)
+			if err != nil {
+				httpError(w, err.Error(), storeErrorStatus(err))
+				return
+			}
+
+			if !created {
+				if k.Status == nil {
+					httpError(w, fmt.Sprintf("a request with the idempotency key %q is in progress", key), http.StatusConflict)
+					return
+				}
+				fp, err := f.sum()
+				if err != nil {
+					httpError(w, fmt.Sprintf("failed to read the request body: %v", err), http.StatusBadRequest)
+					return
+				}
+				if k.Fingerprint == nil || *k.Fingerprint != fp {
+					httpError(w, fmt.Sprintf("the idempotency key %q was already used for a different request", key), http.StatusUnprocessableEntity)
+					return
+				}
+				w.Header().Set("Content-Type", "application/json")
+				w.Header().Set(idempotentReplayedHeader, "true")
+				w.WriteHeader(int(*k.Status))
+				if k.Response != nil {
+					if _, err := w.Write(*k.Response); err != nil {
+						level.Error(logger).Log("msg", "failed to write response", "err", err.Error())
+					}
+				}
+				return
+			}
+
+			rec := &responseRecorder{ResponseWriter: w}
+			next.ServeHTTP(rec, r)
+
+			ctx, cancel := context.WithTimeout(context.Background(), idempotencyStoreTimeout)
+			defer cancel()
+			if rec.status/100 != 2 {
+				// Let the client retry failed requests with the same key.
+				if err := keys.Release(ctx, key); err != nil {
+					level.Error(logger).Log("msg", "failed to release idempotency key", "organization", organization, "err", err.Error())
+				}
+				return
+			}
+			fp, err := f.sum()
+			if err == nil {
+				err = keys.Complete(ctx, key, fp, rec.status, rec.body.Bytes(), ttl)
+			}
+			if err != nil {
+				level.Error(logger).Log("msg", "failed to record the response for idempotency key", "organization", organization, "err", err.Error())
+				// Release the key rather than leaving it in progress until it expires.
+				if err := keys.Release(ctx, key); err != nil {
+					level.Error(logger).Log("msg", "failed to release idempotency key", "organization", organization, "err", err.Error())
+				}
+			}
+		})
+	}, nil
+}
+
+// fingerprint hashes the method, the URI and the body of a request while the body is read.
+type fingerprint struct {
+	io.ReadCloser
+	h hash.Hash
+}
+
+func newFingerprint(method, uri string, body io.ReadCloser) *fingerprint {
+	h := sha256.New()
+	h.Write([]byte(method + " " + uri + "\n")) //nolint:errcheck
+	return &fingerprint{ReadCloser: body, h: h}
+}
+
+func (f *fingerprint) Read(p []byte) (int, error) {
+	n, err := f.ReadCloser.Read(p)
+	f.h.Write(p[:n]) //nolint:errcheck
+	return n, err
+}
+
+// sum reads the rest of the body and returns the fingerprint of the request.
+func (f *fingerprint) sum() (string, error) {
+	if _, err := io.Copy(io.Discard, f); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(f.h.Sum(nil)), nil
+}
+
+// responseRecorder records the status and body of a response while writing it.
+type responseRecorder struct {
+	http.ResponseWriter
+	status int
+	body   bytes.Buffer
+}
+
+func (rr *responseRecorder) WriteHeader(status int) {
+	if rr.status == 0 {
+		rr.status = status
+	}
+	rr.ResponseWriter.WriteHeader(status)
+}
+
+func (rr *responseRecorder) Write(b []byte) (int, error) {
+	if rr.status == 0 {
+		rr.status = http.StatusOK
+	}
+	rr.body.Write(b)
+	return rr.ResponseWriter.Write(b)
+}
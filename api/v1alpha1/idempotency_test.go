@@ -0,0 +1,159 @@
+package v1alpha1
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/efficientgo/core/testutil"
+	"github.com/go-chi/chi/v5"
+
+	"github.com/connylabs/model-tracking/store"
+	"github.com/connylabs/model-tracking/store/model-tracking/public/model"
+)
+
+type fakeStore struct {
+	store.ModelTracking
+	keys     *fakeIdempotencyKeys
+	versions *fakeVersions
+	results  *fakeResults
+}
+
+func (fs *fakeStore) IdempotencyKeys(string) store.IdempotencyKeys {
+	return fs.keys
+}
+
+type fakeIdempotencyKeys struct {
+	keys map[string]*model.IdempotencyKey
+	// expires is the time to live of every key.
+	expires map[string]time.Duration
+}
+
+func (fik *fakeIdempotencyKeys) Reserve(_ context.Context, key string, lease time.Duration) (*model.IdempotencyKey, bool, error) {
+	if k, ok := fik.keys[key]; ok {
+		return k, false, nil
+	}
+	k := &model.IdempotencyKey{Key: key}
+	fik.keys[key] = k
+	fik.expires[key] = lease
+	return k, true, nil
+}
+
+func (fik *fakeIdempotencyKeys) Complete(_ context.Context, key, fingerprint string, status int, response []byte, ttl time.Duration) error {
+	fik.expires[key] = ttl
+	s := int32(status)
+	fik.keys[key].Fingerprint = &fingerprint
+	fik.keys[key].Status = &s
+	fik.keys[key].Response = &response
+	return nil
+}
+
+func (fik *fakeIdempotencyKeys) Release(_ context.Context, key string) error {
+	delete(fik.keys, key)
+	return nil
+}
+
+func TestIdempotencyMiddleware(t *testing.T) {
+	keys := &fakeIdempotencyKeys{keys: make(map[string]*model.IdempotencyKey), expires: make(map[string]time.Duration)}
+	mw, err := NewIdempotencyMiddleware(&fakeStore{keys: keys}, []string{"results-create-for-version", "results-create-for-model"}, time.Minute, time.Hour, "/api/v1alpha1", nil)
+	testutil.Ok(t, err)
+
+	var calls int
+	// lease is the time to live of the key of the last request while it was in progress.
+	var lease time.Duration
+	status := http.StatusCreated
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		lease = keys.expires[r.Header.Get(idempotencyKeyHeader)]
+		// Read only part of the body to check that the rest is fingerprinted too.
+		buf := make([]byte, 2)
+		_, _ = r.Body.Read(buf)
+		w.WriteHeader(status)
+		_, _ = w.Write([]byte(`{"id":1}`))
+	}
+	r := chi.NewRouter()
+	r.With(mw).Post("/api/v1alpha1/organizations/{organization}/models/{model}/versions/{version}/results", handler)
+	r.With(mw).Post("/api/v1alpha1/organizations/{organization}/models/{model}/results", handler)
+
+	doVersion := func(version, key, body string) *http.Response {
+		req := httptest.NewRequest(http.MethodPost, "/api/v1alpha1/organizations/foo/models/bar/versions/"+version+"/results", strings.NewReader(body))
+		if key != "" {
+			req.Header.Set(idempotencyKeyHeader, key)
+		}
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, req)
+		return w.Result()
+	}
+	do := func(key, body string) *http.Response {
+		return doVersion("baz", key, body)
+	}
+
+	res := do("a", `{"input": 1}`)
+	testutil.Equals(t, http.StatusCreated, res.StatusCode)
+	testutil.Equals(t, "", res.Header.Get(idempotentReplayedHeader))
+	testutil.Equals(t, 1, calls)
+	// Keys are reserved only for the lease while the request is in progress
+	// and kept for the time to live once it completed.
+	testutil.Equals(t, time.Minute, lease)
+	testutil.Equals(t, time.Hour, keys.expires["a"])
+
+	// Retries are answered with the recorded response.
+	res = do("a", `{"input": 1}`)
+	testutil.Equals(t, http.StatusCreated, res.StatusCode)
+	testutil.Equals(t, "true", res.Header.Get(idempotentReplayedHeader))
+	body, err := io.ReadAll(res.Body)
+	testutil.Ok(t, err)
+	testutil.Equals(t, `{"id":1}`, string(body))
+	testutil.Equals(t, 1, calls)
+
+	// Keys cannot be reused for different requests.
+	res = do("a", `{"input": 2}`)
+	testutil.Equals(t, http.StatusUnprocessableEntity, res.StatusCode)
+	testutil.Equals(t, 1, calls)
+
+	// Keys cannot be reused for the same body sent to a different version.
+	res = doVersion("qux", "a", `{"input": 1}`)
+	testutil.Equals(t, http.StatusUnprocessableEntity, res.StatusCode)
+	testutil.Equals(t, 1, calls)
+
+	// Requests without keys are not deduplicated.
+	do("", `{"input": 1}`)
+	do("", `{"input": 1}`)
+	testutil.Equals(t, 3, calls)
+
+	// Keys of failed requests are released, so the requests can be retried.
+	status = http.StatusInternalServerError
+	res = do("b", `{"input": 1}`)
+	testutil.Equals(t, http.StatusInternalServerError, res.StatusCode)
+	_, ok := keys.keys["b"]
+	testutil.Assert(t, !ok)
+	status = http.StatusCreated
+	res = do("b", `{"input": 1}`)
+	testutil.Equals(t, http.StatusCreated, res.StatusCode)
+	testutil.Equals(t, "", res.Header.Get(idempotentReplayedHeader))
+
+	// Results created for stages are deduplicated too, but keys cannot be reused for another stage.
+	doStage := func(stage, key, body string) *http.Response {
+		req := httptest.NewRequest(http.MethodPost, "/api/v1alpha1/organizations/foo/models/bar/results?stage="+stage, strings.NewReader(body))
+		req.Header.Set(idempotencyKeyHeader, key)
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, req)
+		return w.Result()
+	}
+	calls = 0
+	testutil.Equals(t, http.StatusCreated, doStage("production", "d", `{"input": 1}`).StatusCode)
+	res = doStage("production", "d", `{"input": 1}`)
+	testutil.Equals(t, http.StatusCreated, res.StatusCode)
+	testutil.Equals(t, "true", res.Header.Get(idempotentReplayedHeader))
+	testutil.Equals(t, http.StatusUnprocessableEntity, doStage("staging", "d", `{"input": 1}`).StatusCode)
+	testutil.Equals(t, 1, calls)
+
+	// Keys of requests in progress cannot be used concurrently.
+	keys.keys["c"] = &model.IdempotencyKey{Key: "c"}
+	res = do("c", `{"input": 1}`)
+	testutil.Equals(t, http.StatusConflict, res.StatusCode)
+}
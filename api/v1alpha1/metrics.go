@@ -19,6 +19,62 @@ func NewInstrumentedServerInterface(impl ServerInterface, r prometheus.Registere
 	return &InstrumentedServerInterface{impl, i}
 }
 
+func (i *InstrumentedServerInterface) AlertRulesCreateForModel(w http.ResponseWriter, r *http.Request, _c2 string, _c3 string) {
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		i.ServerInterface.AlertRulesCreateForModel(w, r, _c2, _c3)
+	}
+	i.NewHandler(prometheus.Labels{"handler": "AlertRulesCreateForModel"}, http.HandlerFunc(handler))(w, r)
+}
+
+func (i *InstrumentedServerInterface) AlertRulesDeleteForModel(w http.ResponseWriter, r *http.Request, _c2 string, _c3 string, _c4 string, _c5 AlertRulesDeleteForModelParams) {
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		i.ServerInterface.AlertRulesDeleteForModel(w, r, _c2, _c3, _c4, _c5)
+	}
+	i.NewHandler(prometheus.Labels{"handler": "AlertRulesDeleteForModel"}, http.HandlerFunc(handler))(w, r)
+}
+
+func (i *InstrumentedServerInterface) AlertRulesGetForModel(w http.ResponseWriter, r *http.Request, _c2 string, _c3 string, _c4 string, _c5 AlertRulesGetForModelParams) {
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		i.ServerInterface.AlertRulesGetForModel(w, r, _c2, _c3, _c4, _c5)
+	}
+	i.NewHandler(prometheus.Labels{"handler": "AlertRulesGetForModel"}, http.HandlerFunc(handler))(w, r)
+}
+
+func (i *InstrumentedServerInterface) AlertRulesListForModel(w http.ResponseWriter, r *http.Request, _c2 string, _c3 string) {
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		i.ServerInterface.AlertRulesListForModel(w, r, _c2, _c3)
+	}
+	i.NewHandler(prometheus.Labels{"handler": "AlertRulesListForModel"}, http.HandlerFunc(handler))(w, r)
+}
+
+func (i *InstrumentedServerInterface) ComparisonGetForModel(w http.ResponseWriter, r *http.Request, _c2 string, _c3 string, _c4 ComparisonGetForModelParams) {
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		i.ServerInterface.ComparisonGetForModel(w, r, _c2, _c3, _c4)
+	}
+	i.NewHandler(prometheus.Labels{"handler": "ComparisonGetForModel"}, http.HandlerFunc(handler))(w, r)
+}
+
+func (i *InstrumentedServerInterface) DriftGetForVersion(w http.ResponseWriter, r *http.Request, _c2 string, _c3 string, _c4 string, _c5 DriftGetForVersionParams) {
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		i.ServerInterface.DriftGetForVersion(w, r, _c2, _c3, _c4, _c5)
+	}
+	i.NewHandler(prometheus.Labels{"handler": "DriftGetForVersion"}, http.HandlerFunc(handler))(w, r)
+}
+
+func (i *InstrumentedServerInterface) MetricsGetForModel(w http.ResponseWriter, r *http.Request, _c2 string, _c3 string, _c4 MetricsGetForModelParams) {
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		i.ServerInterface.MetricsGetForModel(w, r, _c2, _c3, _c4)
+	}
+	i.NewHandler(prometheus.Labels{"handler": "MetricsGetForModel"}, http.HandlerFunc(handler))(w, r)
+}
+
+func (i *InstrumentedServerInterface) MetricsGetForVersion(w http.ResponseWriter, r *http.Request, _c2 string, _c3 string, _c4 string, _c5 MetricsGetForVersionParams) {
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		i.ServerInterface.MetricsGetForVersion(w, r, _c2, _c3, _c4, _c5)
+	}
+	i.NewHandler(prometheus.Labels{"handler": "MetricsGetForVersion"}, http.HandlerFunc(handler))(w, r)
+}
+
 func (i *InstrumentedServerInterface) ModelsCreateForOrganization(w http.ResponseWriter, r *http.Request, _c2 string) {
 	handler := func(w http.ResponseWriter, r *http.Request) {
 		i.ServerInterface.ModelsCreateForOrganization(w, r, _c2)
@@ -26,9 +82,9 @@ func (i *InstrumentedServerInterface) ModelsCreateForOrganization(w http.Respons
 	i.NewHandler(prometheus.Labels{"handler": "ModelsCreateForOrganization"}, http.HandlerFunc(handler))(w, r)
 }
 
-func (i *InstrumentedServerInterface) ModelsGetForOrganization(w http.ResponseWriter, r *http.Request, _c2 string, _c3 string) {
+func (i *InstrumentedServerInterface) ModelsGetForOrganization(w http.ResponseWriter, r *http.Request, _c2 string, _c3 string, _c4 ModelsGetForOrganizationParams) {
 	handler := func(w http.ResponseWriter, r *http.Request) {
-		i.ServerInterface.ModelsGetForOrganization(w, r, _c2, _c3)
+		i.ServerInterface.ModelsGetForOrganization(w, r, _c2, _c3, _c4)
 	}
 	i.NewHandler(prometheus.Labels{"handler": "ModelsGetForOrganization"}, http.HandlerFunc(handler))(w, r)
 }
@@ -40,9 +96,16 @@ func (i *InstrumentedServerInterface) ModelsListForOrganization(w http.ResponseW
 	i.NewHandler(prometheus.Labels{"handler": "ModelsListForOrganization"}, http.HandlerFunc(handler))(w, r)
 }
 
-func (i *InstrumentedServerInterface) ModelsUpdateForOrganization(w http.ResponseWriter, r *http.Request, _c2 string, _c3 string) {
+func (i *InstrumentedServerInterface) ModelsPatchForOrganization(w http.ResponseWriter, r *http.Request, _c2 string, _c3 string, _c4 ModelsPatchForOrganizationParams) {
 	handler := func(w http.ResponseWriter, r *http.Request) {
-		i.ServerInterface.ModelsUpdateForOrganization(w, r, _c2, _c3)
+		i.ServerInterface.ModelsPatchForOrganization(w, r, _c2, _c3, _c4)
+	}
+	i.NewHandler(prometheus.Labels{"handler": "ModelsPatchForOrganization"}, http.HandlerFunc(handler))(w, r)
+}
+
+func (i *InstrumentedServerInterface) ModelsUpdateForOrganization(w http.ResponseWriter, r *http.Request, _c2 string, _c3 string, _c4 ModelsUpdateForOrganizationParams) {
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		i.ServerInterface.ModelsUpdateForOrganization(w, r, _c2, _c3, _c4)
 	}
 	i.NewHandler(prometheus.Labels{"handler": "ModelsUpdateForOrganization"}, http.HandlerFunc(handler))(w, r)
 }
@@ -54,9 +117,23 @@ func (i *InstrumentedServerInterface) OrganizationsCreate(w http.ResponseWriter,
 	i.NewHandler(prometheus.Labels{"handler": "OrganizationsCreate"}, http.HandlerFunc(handler))(w, r)
 }
 
-func (i *InstrumentedServerInterface) ResultsCreateForVersion(w http.ResponseWriter, r *http.Request, _c2 string, _c3 string, _c4 string) {
+func (i *InstrumentedServerInterface) ResultsCreateBulkForVersion(w http.ResponseWriter, r *http.Request, _c2 string, _c3 string, _c4 string, _c5 ResultsCreateBulkForVersionParams) {
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		i.ServerInterface.ResultsCreateBulkForVersion(w, r, _c2, _c3, _c4, _c5)
+	}
+	i.NewHandler(prometheus.Labels{"handler": "ResultsCreateBulkForVersion"}, http.HandlerFunc(handler))(w, r)
+}
+
+func (i *InstrumentedServerInterface) ResultsCreateForModel(w http.ResponseWriter, r *http.Request, _c2 string, _c3 string, _c4 ResultsCreateForModelParams) {
 	handler := func(w http.ResponseWriter, r *http.Request) {
-		i.ServerInterface.ResultsCreateForVersion(w, r, _c2, _c3, _c4)
+		i.ServerInterface.ResultsCreateForModel(w, r, _c2, _c3, _c4)
+	}
+	i.NewHandler(prometheus.Labels{"handler": "ResultsCreateForModel"}, http.HandlerFunc(handler))(w, r)
+}
+
+func (i *InstrumentedServerInterface) ResultsCreateForVersion(w http.ResponseWriter, r *http.Request, _c2 string, _c3 string, _c4 string, _c5 ResultsCreateForVersionParams) {
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		i.ServerInterface.ResultsCreateForVersion(w, r, _c2, _c3, _c4, _c5)
 	}
 	i.NewHandler(prometheus.Labels{"handler": "ResultsCreateForVersion"}, http.HandlerFunc(handler))(w, r)
 }
@@ -68,13 +145,34 @@ func (i *InstrumentedServerInterface) ResultsGetForVersion(w http.ResponseWriter
 	i.NewHandler(prometheus.Labels{"handler": "ResultsGetForVersion"}, http.HandlerFunc(handler))(w, r)
 }
 
-func (i *InstrumentedServerInterface) ResultsListForVersion(w http.ResponseWriter, r *http.Request, _c2 string, _c3 string, _c4 string) {
+func (i *InstrumentedServerInterface) ResultsListForModel(w http.ResponseWriter, r *http.Request, _c2 string, _c3 string, _c4 ResultsListForModelParams) {
 	handler := func(w http.ResponseWriter, r *http.Request) {
-		i.ServerInterface.ResultsListForVersion(w, r, _c2, _c3, _c4)
+		i.ServerInterface.ResultsListForModel(w, r, _c2, _c3, _c4)
+	}
+	i.NewHandler(prometheus.Labels{"handler": "ResultsListForModel"}, http.HandlerFunc(handler))(w, r)
+}
+
+func (i *InstrumentedServerInterface) ResultsListForVersion(w http.ResponseWriter, r *http.Request, _c2 string, _c3 string, _c4 string, _c5 ResultsListForVersionParams) {
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		i.ServerInterface.ResultsListForVersion(w, r, _c2, _c3, _c4, _c5)
 	}
 	i.NewHandler(prometheus.Labels{"handler": "ResultsListForVersion"}, http.HandlerFunc(handler))(w, r)
 }
 
+func (i *InstrumentedServerInterface) ResultsStreamForModel(w http.ResponseWriter, r *http.Request, _c2 string, _c3 string, _c4 ResultsStreamForModelParams) {
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		i.ServerInterface.ResultsStreamForModel(w, r, _c2, _c3, _c4)
+	}
+	i.NewHandler(prometheus.Labels{"handler": "ResultsStreamForModel"}, http.HandlerFunc(handler))(w, r)
+}
+
+func (i *InstrumentedServerInterface) ResultsStreamForVersion(w http.ResponseWriter, r *http.Request, _c2 string, _c3 string, _c4 string, _c5 ResultsStreamForVersionParams) {
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		i.ServerInterface.ResultsStreamForVersion(w, r, _c2, _c3, _c4, _c5)
+	}
+	i.NewHandler(prometheus.Labels{"handler": "ResultsStreamForVersion"}, http.HandlerFunc(handler))(w, r)
+}
+
 func (i *InstrumentedServerInterface) SchemasCreateForOrganization(w http.ResponseWriter, r *http.Request, _c2 string) {
 	handler := func(w http.ResponseWriter, r *http.Request) {
 		i.ServerInterface.SchemasCreateForOrganization(w, r, _c2)
@@ -82,9 +180,9 @@ func (i *InstrumentedServerInterface) SchemasCreateForOrganization(w http.Respon
 	i.NewHandler(prometheus.Labels{"handler": "SchemasCreateForOrganization"}, http.HandlerFunc(handler))(w, r)
 }
 
-func (i *InstrumentedServerInterface) SchemasGetForOrganization(w http.ResponseWriter, r *http.Request, _c2 string, _c3 string) {
+func (i *InstrumentedServerInterface) SchemasGetForOrganization(w http.ResponseWriter, r *http.Request, _c2 string, _c3 string, _c4 SchemasGetForOrganizationParams) {
 	handler := func(w http.ResponseWriter, r *http.Request) {
-		i.ServerInterface.SchemasGetForOrganization(w, r, _c2, _c3)
+		i.ServerInterface.SchemasGetForOrganization(w, r, _c2, _c3, _c4)
 	}
 	i.NewHandler(prometheus.Labels{"handler": "SchemasGetForOrganization"}, http.HandlerFunc(handler))(w, r)
 }
@@ -96,6 +194,41 @@ func (i *InstrumentedServerInterface) SchemasListForOrganization(w http.Response
 	i.NewHandler(prometheus.Labels{"handler": "SchemasListForOrganization"}, http.HandlerFunc(handler))(w, r)
 }
 
+func (i *InstrumentedServerInterface) StagesGetForModel(w http.ResponseWriter, r *http.Request, _c2 string, _c3 string, _c4 string, _c5 StagesGetForModelParams) {
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		i.ServerInterface.StagesGetForModel(w, r, _c2, _c3, _c4, _c5)
+	}
+	i.NewHandler(prometheus.Labels{"handler": "StagesGetForModel"}, http.HandlerFunc(handler))(w, r)
+}
+
+func (i *InstrumentedServerInterface) StagesHistoryForModel(w http.ResponseWriter, r *http.Request, _c2 string, _c3 string, _c4 string) {
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		i.ServerInterface.StagesHistoryForModel(w, r, _c2, _c3, _c4)
+	}
+	i.NewHandler(prometheus.Labels{"handler": "StagesHistoryForModel"}, http.HandlerFunc(handler))(w, r)
+}
+
+func (i *InstrumentedServerInterface) StagesListForModel(w http.ResponseWriter, r *http.Request, _c2 string, _c3 string) {
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		i.ServerInterface.StagesListForModel(w, r, _c2, _c3)
+	}
+	i.NewHandler(prometheus.Labels{"handler": "StagesListForModel"}, http.HandlerFunc(handler))(w, r)
+}
+
+func (i *InstrumentedServerInterface) StagesPromoteForModel(w http.ResponseWriter, r *http.Request, _c2 string, _c3 string, _c4 string) {
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		i.ServerInterface.StagesPromoteForModel(w, r, _c2, _c3, _c4)
+	}
+	i.NewHandler(prometheus.Labels{"handler": "StagesPromoteForModel"}, http.HandlerFunc(handler))(w, r)
+}
+
+func (i *InstrumentedServerInterface) StagesRollbackForModel(w http.ResponseWriter, r *http.Request, _c2 string, _c3 string, _c4 string) {
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		i.ServerInterface.StagesRollbackForModel(w, r, _c2, _c3, _c4)
+	}
+	i.NewHandler(prometheus.Labels{"handler": "StagesRollbackForModel"}, http.HandlerFunc(handler))(w, r)
+}
+
 func (i *InstrumentedServerInterface) VersionsCreateForModel(w http.ResponseWriter, r *http.Request, _c2 string, _c3 string) {
 	handler := func(w http.ResponseWriter, r *http.Request) {
 		i.ServerInterface.VersionsCreateForModel(w, r, _c2, _c3)
@@ -103,16 +236,65 @@ func (i *InstrumentedServerInterface) VersionsCreateForModel(w http.ResponseWrit
 	i.NewHandler(prometheus.Labels{"handler": "VersionsCreateForModel"}, http.HandlerFunc(handler))(w, r)
 }
 
-func (i *InstrumentedServerInterface) VersionsGetForModel(w http.ResponseWriter, r *http.Request, _c2 string, _c3 string, _c4 string) {
+func (i *InstrumentedServerInterface) VersionsGetForModel(w http.ResponseWriter, r *http.Request, _c2 string, _c3 string, _c4 string, _c5 VersionsGetForModelParams) {
 	handler := func(w http.ResponseWriter, r *http.Request) {
-		i.ServerInterface.VersionsGetForModel(w, r, _c2, _c3, _c4)
+		i.ServerInterface.VersionsGetForModel(w, r, _c2, _c3, _c4, _c5)
 	}
 	i.NewHandler(prometheus.Labels{"handler": "VersionsGetForModel"}, http.HandlerFunc(handler))(w, r)
 }
 
-func (i *InstrumentedServerInterface) VersionsListForModel(w http.ResponseWriter, r *http.Request, _c2 string, _c3 string) {
+func (i *InstrumentedServerInterface) VersionsListForModel(w http.ResponseWriter, r *http.Request, _c2 string, _c3 string, _c4 VersionsListForModelParams) {
 	handler := func(w http.ResponseWriter, r *http.Request) {
-		i.ServerInterface.VersionsListForModel(w, r, _c2, _c3)
+		i.ServerInterface.VersionsListForModel(w, r, _c2, _c3, _c4)
 	}
 	i.NewHandler(prometheus.Labels{"handler": "VersionsListForModel"}, http.HandlerFunc(handler))(w, r)
 }
+
+func (i *InstrumentedServerInterface) VersionsPatchForModel(w http.ResponseWriter, r *http.Request, _c2 string, _c3 string, _c4 string, _c5 VersionsPatchForModelParams) {
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		i.ServerInterface.VersionsPatchForModel(w, r, _c2, _c3, _c4, _c5)
+	}
+	i.NewHandler(prometheus.Labels{"handler": "VersionsPatchForModel"}, http.HandlerFunc(handler))(w, r)
+}
+
+func (i *InstrumentedServerInterface) VersionsUpdateForModel(w http.ResponseWriter, r *http.Request, _c2 string, _c3 string, _c4 string, _c5 VersionsUpdateForModelParams) {
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		i.ServerInterface.VersionsUpdateForModel(w, r, _c2, _c3, _c4, _c5)
+	}
+	i.NewHandler(prometheus.Labels{"handler": "VersionsUpdateForModel"}, http.HandlerFunc(handler))(w, r)
+}
+
+func (i *InstrumentedServerInterface) WebhooksCreateForOrganization(w http.ResponseWriter, r *http.Request, _c2 string) {
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		i.ServerInterface.WebhooksCreateForOrganization(w, r, _c2)
+	}
+	i.NewHandler(prometheus.Labels{"handler": "WebhooksCreateForOrganization"}, http.HandlerFunc(handler))(w, r)
+}
+
+func (i *InstrumentedServerInterface) WebhooksDeleteForOrganization(w http.ResponseWriter, r *http.Request, _c2 string, _c3 string, _c4 WebhooksDeleteForOrganizationParams) {
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		i.ServerInterface.WebhooksDeleteForOrganization(w, r, _c2, _c3, _c4)
+	}
+	i.NewHandler(prometheus.Labels{"handler": "WebhooksDeleteForOrganization"}, http.HandlerFunc(handler))(w, r)
+}
+
+func (i *InstrumentedServerInterface) WebhooksDeliveriesForOrganization(w http.ResponseWriter, r *http.Request, _c2 string, _c3 string, _c4 WebhooksDeliveriesForOrganizationParams) {
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		i.ServerInterface.WebhooksDeliveriesForOrganization(w, r, _c2, _c3, _c4)
+	}
+	i.NewHandler(prometheus.Labels{"handler": "WebhooksDeliveriesForOrganization"}, http.HandlerFunc(handler))(w, r)
+}
+
+func (i *InstrumentedServerInterface) WebhooksGetForOrganization(w http.ResponseWriter, r *http.Request, _c2 string, _c3 string, _c4 WebhooksGetForOrganizationParams) {
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		i.ServerInterface.WebhooksGetForOrganization(w, r, _c2, _c3, _c4)
+	}
+	i.NewHandler(prometheus.Labels{"handler": "WebhooksGetForOrganization"}, http.HandlerFunc(handler))(w, r)
+}
+
+func (i *InstrumentedServerInterface) WebhooksListForOrganization(w http.ResponseWriter, r *http.Request, _c2 string) {
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		i.ServerInterface.WebhooksListForOrganization(w, r, _c2)
+	}
+	i.NewHandler(prometheus.Labels{"handler": "WebhooksListForOrganization"}, http.HandlerFunc(handler))(w, r)
+}
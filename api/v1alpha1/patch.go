@@ -0,0 +1,82 @@
+package v1alpha1
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"reflect"
+)
+
+// readMergePatch reads a JSON merge patch as defined in RFC 7386 from the body of a request.
+// The patch is decoded into fields, e.g. a ModelPatch, to check that it only changes
+// fields that may be changed and that their values have the right types.
+func readMergePatch(body io.Reader, fields interface{}) ([]byte, error) {
+	patch, err := io.ReadAll(body)
+	if err != nil {
+		return nil, err
+	}
+	// A patch that is not an object would replace the whole resource.
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(patch, &m); err != nil {
+		return nil, err
+	}
+	if m == nil {
+		return nil, &json.UnmarshalTypeError{Value: "null", Type: reflect.TypeOf(fields)}
+	}
+	d := json.NewDecoder(bytes.NewReader(patch))
+	d.DisallowUnknownFields()
+	if err := d.Decode(fields); err != nil {
+		return nil, err
+	}
+	return patch, nil
+}
+
+// applyMergePatch applies a JSON merge patch to the JSON encoding of a document
+// and decodes the patched document into patched.
+func applyMergePatch(doc interface{}, patch []byte, patched interface{}) error {
+	b, err := json.Marshal(doc)
+	if err != nil {
+		return err
+	}
+	var target, p interface{}
+	if err := unmarshalNumbers(b, &target); err != nil {
+		return err
+	}
+	if err := unmarshalNumbers(patch, &p); err != nil {
+		return err
+	}
+	b, err = json.Marshal(mergePatch(target, p))
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(b, patched)
+}
+
+// mergePatch implements the MergePatch function of RFC 7386:
+// members of objects in the patch are merged into the target recursively,
+// members that are null are removed and all other values replace the target.
+func mergePatch(target, patch interface{}) interface{} {
+	p, ok := patch.(map[string]interface{})
+	if !ok {
+		return patch
+	}
+	t, ok := target.(map[string]interface{})
+	if !ok {
+		t = make(map[string]interface{})
+	}
+	for k, v := range p {
+		if v == nil {
+			delete(t, k)
+			continue
+		}
+		t[k] = mergePatch(t[k], v)
+	}
+	return t
+}
+
+// unmarshalNumbers is like json.Unmarshal but keeps numbers as they are, e.g. large integers.
+func unmarshalNumbers(b []byte, v interface{}) error {
+	d := json.NewDecoder(bytes.NewReader(b))
+	d.UseNumber()
+	return d.Decode(v)
+}
@@ -0,0 +1,70 @@
+package v1alpha1
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/efficientgo/core/testutil"
+)
+
+func TestMergePatch(t *testing.T) {
+	// The examples of RFC 7386, appendix A.
+	for _, tc := range []struct {
+		target string
+		patch  string
+		result string
+	}{
+		{target: `{"a":"b"}`, patch: `{"a":"c"}`, result: `{"a":"c"}`},
+		{target: `{"a":"b"}`, patch: `{"b":"c"}`, result: `{"a":"b","b":"c"}`},
+		{target: `{"a":"b"}`, patch: `{"a":null}`, result: `{}`},
+		{target: `{"a":"b","b":"c"}`, patch: `{"a":null}`, result: `{"b":"c"}`},
+		{target: `{"a":["b"]}`, patch: `{"a":"c"}`, result: `{"a":"c"}`},
+		{target: `{"a":"c"}`, patch: `{"a":["b"]}`, result: `{"a":["b"]}`},
+		{target: `{"a":{"b":"c"}}`, patch: `{"a":{"b":"d","c":null}}`, result: `{"a":{"b":"d"}}`},
+		{target: `{"a":[{"b":"c"}]}`, patch: `{"a":[1]}`, result: `{"a":[1]}`},
+		{target: `["a","b"]`, patch: `["c","d"]`, result: `["c","d"]`},
+		{target: `{"a":"b"}`, patch: `["c"]`, result: `["c"]`},
+		{target: `{"a":"foo"}`, patch: `null`, result: `null`},
+		{target: `{"a":"foo"}`, patch: `"bar"`, result: `"bar"`},
+		{target: `{"e":null}`, patch: `{"a":1}`, result: `{"a":1,"e":null}`},
+		{target: `[1,2]`, patch: `{"a":"b","c":null}`, result: `{"a":"b"}`},
+		{target: `{}`, patch: `{"a":{"bb":{"ccc":null}}}`, result: `{"a":{"bb":{}}}`},
+	} {
+		var target, patch interface{}
+		testutil.Ok(t, unmarshalNumbers([]byte(tc.target), &target))
+		testutil.Ok(t, unmarshalNumbers([]byte(tc.patch), &patch))
+		b, err := json.Marshal(mergePatch(target, patch))
+		testutil.Ok(t, err)
+		testutil.Equals(t, tc.result, string(b), "patching %s with %s", tc.target, tc.patch)
+	}
+}
+
+func TestApplyMergePatch(t *testing.T) {
+	description := "classifies images"
+	archived := false
+	doc := &ModelPatch{
+		DefaultSchema: intPointer(1),
+		Description:   &description,
+		Labels:        &Labels{"team": "vision", "experimental": "true"},
+		Archived:      &archived,
+	}
+
+	patch, err := readMergePatch(strings.NewReader(`{"description": null, "labels": {"experimental": null, "region": "eu"}, "archived": true}`), new(ModelPatch))
+	testutil.Ok(t, err)
+	var p ModelPatch
+	testutil.Ok(t, applyMergePatch(doc, patch, &p))
+	testutil.Equals(t, 1, *p.DefaultSchema)
+	testutil.Assert(t, p.Description == nil)
+	testutil.Equals(t, Labels{"team": "vision", "region": "eu"}, *p.Labels)
+	testutil.Equals(t, true, *p.Archived)
+
+	for _, body := range []string{`null`, `[]`, `{"name": "foo"}`, `{"archived": "yes"}`, `{`} {
+		_, err := readMergePatch(strings.NewReader(body), new(ModelPatch))
+		testutil.NotOk(t, err, "patch %s should be rejected", body)
+	}
+}
+
+func intPointer(i int) *int {
+	return &i
+}
@@ -0,0 +1,129 @@
+package v1alpha1
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"math"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/go-kit/log"
+	"github.com/go-kit/log/level"
+
+	"github.com/connylabs/model-tracking/auth"
+	"github.com/connylabs/model-tracking/ratelimit"
+)
+
+// NewRateLimitMiddleware returns a middleware that enforces the rate limits
+// and quotas of the organization in the path of a request.
+// Clients are identified by their client certificates or else by their bearer tokens,
+// so the middleware must run after NewCertificateAuthMiddleware.
+// Requests outside of organizations are not limited.
+// The middleware must be passed to HandlerWithOptions so that
+// it runs after the path parameters have been matched.
+func NewRateLimitMiddleware(limiter *ratelimit.Limiter, logger log.Logger) MiddlewareFunc {
+	httpError := httpError(logger)
+	throttle := throttle(logger)
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			organization := chi.URLParam(r, "organization")
+			if organization == "" {
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			if d := limiter.Allow(organization, client(r)); !d.Allowed {
+				throttle(w, d)
+				return
+			}
+
+			if d := limiter.AllowPayload(organization, r.ContentLength); !d.Allowed {
+				httpError(w, "the request body is too large", http.StatusRequestEntityTooLarge)
+				return
+			}
+			if max := limiter.MaxPayloadBytes(organization); max > 0 {
+				// The content length is unknown for chunked requests.
+				r.Body = http.MaxBytesReader(w, r.Body, max)
+			}
+
+			if pattern := chi.RouteContext(r.Context()).RoutePattern(); r.Method == http.MethodPost &&
+				(strings.HasSuffix(pattern, "/results") || strings.HasSuffix(pattern, "/results/bulk")) {
+				// Only the handlers know how many valid results a request holds and how many were created,
+				// so they check and count the results against the quota. They run after the idempotency
+				// middleware, so replayed requests are not counted again.
+				r = r.WithContext(context.WithValue(r.Context(), resultsQuotaKey{}, &organizationQuota{limiter, organization, logger}))
+			}
+
+			next.ServeHTTP(w, r)
+		})
+	}
+}
+
+// throttle responds to a request that exceeded a rate limit or quota.
+func throttle(logger log.Logger) func(w http.ResponseWriter, d ratelimit.Decision) {
+	httpProblem := httpProblem(logger)
+	return func(w http.ResponseWriter, d ratelimit.Decision) {
+		w.Header().Set("Retry-After", strconv.Itoa(int(math.Ceil(d.RetryAfter.Seconds()))))
+		code := CodeRateLimited
+		if d.Reason == ratelimit.ResultsQuota {
+			code = CodeQuotaExceeded
+		}
+		httpProblem(w, code, fmt.Sprintf("too many requests: %s exceeded", strings.ReplaceAll(string(d.Reason), "_", " ")), http.StatusTooManyRequests)
+	}
+}
+
+type resultsQuotaKey struct{}
+
+// resultsQuota is the results quota of the organization of a request.
+type resultsQuota interface {
+	// Allow checks whether n more results fit in the quota without counting them.
+	Allow(ctx context.Context, n int64) ratelimit.Decision
+	// Add counts n created results against the quota.
+	Add(n int64)
+}
+
+// resultsQuotaFromContext returns the results quota of a request that creates results.
+// It returns false if the results of the request are not limited.
+func resultsQuotaFromContext(ctx context.Context) (resultsQuota, bool) {
+	q, ok := ctx.Value(resultsQuotaKey{}).(resultsQuota)
+	return q, ok
+}
+
+// organizationQuota is the results quota of an organization enforced by a limiter.
+type organizationQuota struct {
+	limiter      *ratelimit.Limiter
+	organization string
+	logger       log.Logger
+}
+
+func (oq *organizationQuota) Allow(ctx context.Context, n int64) ratelimit.Decision {
+	d, err := oq.limiter.AllowResults(ctx, oq.organization, n)
+	if err != nil {
+		// Do not reject results because quotas are temporarily unavailable.
+		level.Warn(oq.logger).Log("msg", "failed to check the results quota", "organization", oq.organization, "err", err.Error())
+		return ratelimit.Decision{Allowed: true}
+	}
+	return d
+}
+
+func (oq *organizationQuota) Add(n int64) {
+	oq.limiter.AddResults(oq.organization, n)
+}
+
+// client identifies the client making a request for rate limiting.
+// It returns an empty string if the client is anonymous.
+func client(r *http.Request) string {
+	if i, ok := auth.FromContext(r.Context()); ok {
+		return "identity:" + i.Name
+	}
+	if h := r.Header.Get("Authorization"); h != "" {
+		// Do not keep credentials in memory.
+		sum := sha256.Sum256([]byte(h))
+		return "token:" + hex.EncodeToString(sum[:])
+	}
+	return ""
+}
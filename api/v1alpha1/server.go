@@ -1,37 +1,30 @@
 package v1alpha1
 
 import (
+	"context"
 	"encoding/json"
 	"errors"
+	"fmt"
 	"net/http"
-	"strconv"
+	"net/url"
 	"time"
 
-	"github.com/go-jet/jet/v2/qrm"
 	"github.com/go-kit/log"
 	"github.com/go-kit/log/level"
+	"github.com/prometheus/client_golang/prometheus"
 	"github.com/xeipuuv/gojsonschema"
 
+	"github.com/connylabs/model-tracking/alerting"
+	"github.com/connylabs/model-tracking/drift"
+	"github.com/connylabs/model-tracking/evaluation"
+	"github.com/connylabs/model-tracking/labels"
+	"github.com/connylabs/model-tracking/ratelimit"
 	"github.com/connylabs/model-tracking/store"
 	"github.com/connylabs/model-tracking/store/model-tracking/public/model"
 )
 
 //go:generate go run github.com/leonnicolas/genstrument --file-path v1alpha1.go --pattern ServerInterface --mode handler --out metrics.go
 
-func httpError(logger log.Logger) func(w http.ResponseWriter, m string, code int) {
-	hj := httpJSON(logger)
-	return func(w http.ResponseWriter, m string, code int) {
-		response := Error{
-			Code:  code,
-			Error: m,
-		}
-		if code/100 == 5 {
-			level.Error(logger).Log("msg", "unexpected error", "code", strconv.Itoa(code), "err", m)
-		}
-		hj(w, response, code)
-	}
-}
-
 func httpJSON(logger log.Logger) func(w http.ResponseWriter, response interface{}, code int) {
 	return func(w http.ResponseWriter, response interface{}, code int) {
 		w.Header().Set("Content-Type", "application/json")
@@ -42,22 +35,55 @@ func httpJSON(logger log.Logger) func(w http.ResponseWriter, response interface{
 	}
 }
 
+// Enqueuer enqueues validated results to be created asynchronously, e.g. a queue.Queue.
+type Enqueuer interface {
+	Enqueue(organization, model, version string, result *model.Result) error
+}
+
 type server struct {
 	store     store.ModelTracking
 	logger    log.Logger
 	httpError func(w http.ResponseWriter, m string, code int)
 	httpJSON  func(w http.ResponseWriter, response interface{}, code int)
+	throttle  func(w http.ResponseWriter, d ratelimit.Decision)
+	drift     *prometheus.GaugeVec
+	// events is used to stream results. If it is nil, streaming is not enabled.
+	events         Subscriber
+	streamDuration time.Duration
+	// queue is used to create results asynchronously. If it is nil, results are created synchronously.
+	queue Enqueuer
+	// metrics shares the metrics of versions between streams.
+	metrics *metricsCache
 }
 
-func NewServer(store store.ModelTracking, logger log.Logger) ServerInterface {
+// NewServer creates a new server for the API.
+// Results are streamed to each client for at most streamDuration, which should be shorter
+// than the write timeout of the HTTP server; zero means unlimited.
+// If events is nil, the streaming endpoints respond with 501.
+// If queue is not nil, single results are enqueued once they are validated
+// and the server responds with 202 instead of waiting for them to be created.
+func NewServer(store store.ModelTracking, events Subscriber, queue Enqueuer, streamDuration time.Duration, reg prometheus.Registerer, logger log.Logger) ServerInterface {
 	if logger == nil {
 		logger = log.NewNopLogger()
 	}
+	drift := prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "model_tracking_drift",
+		Help: "The drift of a field of the inputs or outputs of a model version with respect to a reference as of the latest drift report.",
+	}, []string{"organization", "model", "version", "reference", "field", "statistic"})
+	if reg != nil {
+		reg.MustRegister(drift)
+	}
 	return &server{
-		store:     store,
-		logger:    logger,
-		httpError: httpError(logger),
-		httpJSON:  httpJSON(logger),
+		store:          store,
+		logger:         logger,
+		httpError:      httpError(logger),
+		httpJSON:       httpJSON(logger),
+		throttle:       throttle(logger),
+		drift:          drift,
+		events:         events,
+		streamDuration: streamDuration,
+		queue:          queue,
+		metrics:        new(metricsCache),
 	}
 }
 
@@ -66,17 +92,13 @@ func (s *server) OrganizationsCreate(w http.ResponseWriter, r *http.Request) {
 	d := json.NewDecoder(r.Body)
 	d.DisallowUnknownFields()
 	if err := d.Decode(body); err != nil {
-		if errors.Is(err, (*json.UnmarshalTypeError)(nil)) {
-			s.httpError(w, err.Error(), http.StatusUnprocessableEntity)
-			return
-		}
-		s.httpError(w, err.Error(), http.StatusInternalServerError)
+		s.httpDecodeError(w, err)
 		return
 	}
 
 	o, err := s.store.Organizations().Create(r.Context(), &model.Organization{Name: body.Name})
 	if err != nil {
-		s.httpError(w, err.Error(), http.StatusInternalServerError)
+		s.httpStoreError(w, err)
 		return
 	}
 
@@ -91,28 +113,68 @@ func (s *server) OrganizationsCreate(w http.ResponseWriter, r *http.Request) {
 func (s *server) ModelsListForOrganization(w http.ResponseWriter, r *http.Request, organization ParameterOrganization) {
 	ms, err := s.store.Models(organization).List(r.Context())
 	if err != nil {
-		if errors.Is(err, qrm.ErrNoRows) {
-			s.httpError(w, err.Error(), http.StatusNotFound)
-			return
-		}
-		s.httpError(w, err.Error(), http.StatusInternalServerError)
+		s.httpStoreError(w, err)
 		return
 	}
 
 	models := make([]*Model, 0, len(ms))
 	for i := range ms {
-		models = append(models, &Model{
-			ID:            int(ms[i].ID),
-			Name:          ms[i].Name,
-			Organization:  int(ms[i].Organization),
-			DefaultSchema: int32PointerToIntPointer(ms[i].DefaultSchema),
-			Created:       *ms[i].Created,
-			Updated:       *ms[i].Updated,
-		})
+		m, err := modelFromModel(ms[i])
+		if err != nil {
+			s.httpError(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+		models = append(models, m)
 	}
 	s.httpJSON(w, models, http.StatusOK)
 }
 
+func modelFromModel(m *model.Model) (*Model, error) {
+	l, err := labelsFromJSON(m.Labels)
+	if err != nil {
+		return nil, err
+	}
+	return &Model{
+		ID:            int(m.ID),
+		Name:          m.Name,
+		Organization:  int(m.Organization),
+		DefaultSchema: int32PointerToIntPointer(m.DefaultSchema),
+		Description:   m.Description,
+		Labels:        l,
+		Archived:      m.Archived,
+		Created:       *m.Created,
+		Updated:       *m.Updated,
+	}, nil
+}
+
+// maxUpdateAttempts is the number of times that a change to a resource is applied
+// if the resource is changed concurrently.
+const maxUpdateAttempts = 3
+
+// updateModel applies a change to the current version of a model and stores the result.
+// If the model is changed concurrently, the change is applied again to the new version,
+// unless the change is conditional on the version in the If-Match header.
+func (s *server) updateModel(ctx context.Context, organization, name string, ifMatch *string, change func(*model.Model) error) (*model.Model, error) {
+	for attempt := 1; ; attempt++ {
+		m, err := s.store.Models(organization).Get(ctx, name)
+		if err != nil {
+			return nil, err
+		}
+		if err := checkIfMatch(ifMatch, "model", m.ID, m.Updated); err != nil {
+			return nil, err
+		}
+		if err := change(m); err != nil {
+			return nil, err
+		}
+		// The model still has the time of its last update, so the store fails if it was changed since.
+		m, err = s.store.Models(organization).Update(ctx, m)
+		if errors.Is(err, store.ErrPreconditionFailed) && ifMatch == nil && attempt < maxUpdateAttempts {
+			continue
+		}
+		return m, err
+	}
+}
+
 func intPointerToInt32Pointer(i *int) *int32 {
 	if i == nil {
 		return nil
@@ -129,101 +191,271 @@ func int32PointerToIntPointer(i32 *int32) *int {
 	return &i
 }
 
+func labelsFromJSON(s string) (Labels, error) {
+	l := make(Labels)
+	if s == "" {
+		return l, nil
+	}
+	if err := json.Unmarshal([]byte(s), &l); err != nil {
+		return nil, fmt.Errorf("failed to decode labels: %w", err)
+	}
+	return l, nil
+}
+
+func labelsToJSON(l *Labels) (string, error) {
+	if l == nil {
+		return "{}", nil
+	}
+	b, err := json.Marshal(l)
+	if err != nil {
+		return "", fmt.Errorf("failed to encode labels: %w", err)
+	}
+	return string(b), nil
+}
+
+// invalid returns an error for an invalid request that is reported like invalid resources in the store.
+func invalid(format string, a ...interface{}) error {
+	return &store.Error{Kind: store.ErrInvalid, Message: fmt.Sprintf(format, a...)}
+}
+
+// ResultValidator validates new results against the schema of a version
+// and converts them to results for the store.
+// It is shared by all APIs that create results.
+type ResultValidator struct {
+	input  *gojsonschema.Schema
+	output *gojsonschema.Schema
+}
+
+// NewResultValidator gets a version of a model, creating it with the default schema
+// of the model if it does not exist, and returns a validator for its results.
+func NewResultValidator(ctx context.Context, s store.ModelTracking, organization, modelParam, version string) (*ResultValidator, error) {
+	v, err := s.Versions(organization, modelParam).GetOrCreate(ctx, version)
+	if err != nil {
+		return nil, err
+	}
+	schema, err := s.Schemas(organization).GetByID(ctx, int(v.Schema))
+	if err != nil {
+		return nil, err
+	}
+	input, err := gojsonschema.NewSchema(gojsonschema.NewBytesLoader(schema.Input))
+	if err != nil {
+		return nil, err
+	}
+	output, err := gojsonschema.NewSchema(gojsonschema.NewBytesLoader(schema.Output))
+	if err != nil {
+		return nil, err
+	}
+	return &ResultValidator{input: input, output: output}, nil
+}
+
+// validate validates a document, e.g. the true output, against a schema, e.g. the output schema.
+func validate(schema *gojsonschema.Schema, schemaName string, document json.RawMessage, name string) error {
+	vr, err := schema.Validate(gojsonschema.NewBytesLoader(document))
+	if err != nil {
+		return invalid("%v", err)
+	}
+	if !vr.Valid() {
+		return invalid("%s does not match %s schema", name, schemaName)
+	}
+	return nil
+}
+
+// Result validates the new result and returns the result to store.
+// If the result is invalid, an error of kind store.ErrInvalid is returned.
+func (rv *ResultValidator) Result(body *NewResult) (*model.Result, error) {
+	if err := validate(rv.input, "input", body.Input, "input"); err != nil {
+		return nil, err
+	}
+	if err := validate(rv.output, "output", body.Output, "output"); err != nil {
+		return nil, err
+	}
+	// Results whose true output is not known yet are stored unlabelled.
+	trueOutput := json.RawMessage("null")
+	if evaluation.IsLabelled(body.TrueOutput) {
+		if err := validate(rv.output, "output", body.TrueOutput, "true output"); err != nil {
+			return nil, err
+		}
+		trueOutput = body.TrueOutput
+	}
+
+	t := time.Now()
+	if body.Time != nil {
+		t = *body.Time
+	}
+
+	if body.Labels != nil {
+		if err := labels.Validate(*body.Labels); err != nil {
+			return nil, invalid("%v", err)
+		}
+	}
+	l, err := labelsToJSON(body.Labels)
+	if err != nil {
+		return nil, err
+	}
+
+	return &model.Result{Input: body.Input, Output: body.Output, TrueOutput: trueOutput, Time: t, Labels: l, CorrelationID: body.CorrelationID, ClientID: body.ClientID}, nil
+}
+
+func parseLabelSelector(selector *LabelSelector) (labels.Selector, error) {
+	if selector == nil {
+		return nil, nil
+	}
+	return labels.Parse(*selector)
+}
+
+func rawMessagePointerToStringPointer(m *json.RawMessage) *string {
+	if m == nil {
+		return nil
+	}
+	s := string(*m)
+	return &s
+}
+
+func stringPointerToRawMessagePointer(s *string) *json.RawMessage {
+	if s == nil {
+		return nil
+	}
+	m := json.RawMessage(*s)
+	return &m
+}
+
 func (s *server) ModelsCreateForOrganization(w http.ResponseWriter, r *http.Request, organization ParameterOrganization) {
 	body := new(ModelsCreateForOrganizationJSONBody)
 	d := json.NewDecoder(r.Body)
 	d.DisallowUnknownFields()
 	if err := d.Decode(body); err != nil {
-		if errors.Is(err, (*json.UnmarshalTypeError)(nil)) {
+		s.httpDecodeError(w, err)
+		return
+	}
+
+	if body.Labels != nil {
+		if err := labels.Validate(*body.Labels); err != nil {
 			s.httpError(w, err.Error(), http.StatusUnprocessableEntity)
 			return
 		}
+	}
+	l, err := labelsToJSON(body.Labels)
+	if err != nil {
 		s.httpError(w, err.Error(), http.StatusInternalServerError)
 		return
 	}
 
-	m, err := s.store.Models(organization).Create(r.Context(), &model.Model{Name: body.Name, DefaultSchema: intPointerToInt32Pointer(body.DefaultSchema)})
+	m, err := s.store.Models(organization).Create(r.Context(), &model.Model{
+		Name:          body.Name,
+		DefaultSchema: intPointerToInt32Pointer(body.DefaultSchema),
+		Description:   body.Description,
+		Labels:        l,
+	})
 	if err != nil {
-		if errors.Is(err, qrm.ErrNoRows) {
-			s.httpError(w, err.Error(), http.StatusNotFound)
-			return
-		}
-		s.httpError(w, err.Error(), http.StatusInternalServerError)
+		s.httpStoreError(w, err)
 		return
 	}
 
-	s.httpJSON(w, &Model{
-		ID:            int(m.ID),
-		Name:          m.Name,
-		Organization:  int(m.Organization),
-		DefaultSchema: int32PointerToIntPointer(m.DefaultSchema),
-		Created:       *m.Created,
-		Updated:       *m.Updated,
-	}, http.StatusCreated)
+	res, err := modelFromModel(m)
+	if err != nil {
+		s.httpError(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	s.httpJSON(w, res, http.StatusCreated)
 }
 
-func (s *server) ModelsUpdateForOrganization(w http.ResponseWriter, r *http.Request, organization ParameterOrganization, modelParam ParameterModel) {
+func (s *server) ModelsUpdateForOrganization(w http.ResponseWriter, r *http.Request, organization ParameterOrganization, modelParam ParameterModel, params ModelsUpdateForOrganizationParams) {
 	body := new(ModelsUpdateForOrganizationJSONRequestBody)
 	d := json.NewDecoder(r.Body)
 	d.DisallowUnknownFields()
 	if err := d.Decode(body); err != nil {
-		if errors.Is(err, (*json.UnmarshalTypeError)(nil)) {
-			s.httpError(w, err.Error(), http.StatusUnprocessableEntity)
-			return
-		}
+		s.httpDecodeError(w, err)
+		return
+	}
+
+	m, err := s.updateModel(r.Context(), organization, modelParam, params.IfMatch, func(m *model.Model) error {
+		m.DefaultSchema = intPointerToInt32Pointer(body.DefaultSchema)
+		return nil
+	})
+	if err != nil {
+		s.httpStoreError(w, err)
+		return
+	}
+
+	res, err := modelFromModel(m)
+	if err != nil {
 		s.httpError(w, err.Error(), http.StatusInternalServerError)
 		return
 	}
+	writeETag(w, nil, m.ID, m.Updated)
+	s.httpJSON(w, res, http.StatusOK)
+}
 
-	m, err := s.store.Models(organization).Update(r.Context(), &model.Model{Name: modelParam, DefaultSchema: intPointerToInt32Pointer(body.DefaultSchema)})
+func (s *server) ModelsPatchForOrganization(w http.ResponseWriter, r *http.Request, organization ParameterOrganization, modelParam ParameterModel, params ModelsPatchForOrganizationParams) {
+	patch, err := readMergePatch(r.Body, new(ModelPatch))
 	if err != nil {
-		if errors.Is(err, qrm.ErrNoRows) {
-			s.httpError(w, err.Error(), http.StatusNotFound)
-			return
+		s.httpDecodeError(w, err)
+		return
+	}
+
+	m, err := s.updateModel(r.Context(), organization, modelParam, params.IfMatch, func(m *model.Model) error {
+		l, err := labelsFromJSON(m.Labels)
+		if err != nil {
+			return err
 		}
-		s.httpError(w, err.Error(), http.StatusInternalServerError)
+		var p ModelPatch
+		if err := applyMergePatch(&ModelPatch{
+			DefaultSchema: int32PointerToIntPointer(m.DefaultSchema),
+			Description:   m.Description,
+			Labels:        &l,
+			Archived:      &m.Archived,
+		}, patch, &p); err != nil {
+			return invalid("%v", err)
+		}
+		if p.Labels != nil {
+			if err := labels.Validate(*p.Labels); err != nil {
+				return invalid("%v", err)
+			}
+		}
+		if m.Labels, err = labelsToJSON(p.Labels); err != nil {
+			return err
+		}
+		m.DefaultSchema = intPointerToInt32Pointer(p.DefaultSchema)
+		m.Description = p.Description
+		m.Archived = p.Archived != nil && *p.Archived
+		return nil
+	})
+	if err != nil {
+		s.httpStoreError(w, err)
 		return
 	}
 
-	s.httpJSON(w, &Model{
-		ID:            int(m.ID),
-		Name:          m.Name,
-		Organization:  int(m.Organization),
-		DefaultSchema: int32PointerToIntPointer(m.DefaultSchema),
-		Created:       *m.Created,
-		Updated:       *m.Updated,
-	}, http.StatusOK)
+	res, err := modelFromModel(m)
+	if err != nil {
+		s.httpError(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	writeETag(w, nil, m.ID, m.Updated)
+	s.httpJSON(w, res, http.StatusOK)
 }
 
-func (s *server) ModelsGetForOrganization(w http.ResponseWriter, r *http.Request, organization ParameterOrganization, model ParameterModel) {
+func (s *server) ModelsGetForOrganization(w http.ResponseWriter, r *http.Request, organization ParameterOrganization, model ParameterModel, params ModelsGetForOrganizationParams) {
 	m, err := s.store.Models(organization).Get(r.Context(), model)
 	if err != nil {
-		if errors.Is(err, qrm.ErrNoRows) {
-			s.httpError(w, err.Error(), http.StatusNotFound)
-			return
-		}
-		s.httpError(w, err.Error(), http.StatusInternalServerError)
+		s.httpStoreError(w, err)
 		return
 	}
 
-	s.httpJSON(w, &Model{
-		ID:            int(m.ID),
-		Name:          m.Name,
-		Organization:  int(m.Organization),
-		DefaultSchema: int32PointerToIntPointer(m.DefaultSchema),
-		Created:       *m.Created,
-		Updated:       *m.Updated,
-	}, http.StatusOK)
+	res, err := modelFromModel(m)
+	if err != nil {
+		s.httpError(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	if !writeETag(w, params.IfNoneMatch, m.ID, m.Updated) {
+		return
+	}
+	s.httpJSON(w, res, http.StatusOK)
 }
 
 func (s *server) SchemasListForOrganization(w http.ResponseWriter, r *http.Request, organization ParameterOrganization) {
 	ss, err := s.store.Schemas(organization).List(r.Context())
 	if err != nil {
-		if errors.Is(err, qrm.ErrNoRows) {
-			s.httpError(w, err.Error(), http.StatusNotFound)
-			return
-		}
-		s.httpError(w, err.Error(), http.StatusInternalServerError)
+		s.httpStoreError(w, err)
 		return
 	}
 
@@ -247,11 +479,7 @@ func (s *server) SchemasCreateForOrganization(w http.ResponseWriter, r *http.Req
 	d := json.NewDecoder(r.Body)
 	d.DisallowUnknownFields()
 	if err := d.Decode(body); err != nil {
-		if errors.Is(err, (*json.UnmarshalTypeError)(nil)) {
-			s.httpError(w, err.Error(), http.StatusUnprocessableEntity)
-			return
-		}
-		s.httpError(w, err.Error(), http.StatusInternalServerError)
+		s.httpDecodeError(w, err)
 		return
 	}
 
@@ -269,11 +497,7 @@ func (s *server) SchemasCreateForOrganization(w http.ResponseWriter, r *http.Req
 
 	schema, err := s.store.Schemas(organization).Create(r.Context(), &model.Schema{Input: body.Input, Name: body.Name, Output: body.Output})
 	if err != nil {
-		if errors.Is(err, qrm.ErrNoRows) {
-			s.httpError(w, err.Error(), http.StatusNotFound)
-			return
-		}
-		s.httpError(w, err.Error(), http.StatusInternalServerError)
+		s.httpStoreError(w, err)
 		return
 	}
 
@@ -288,14 +512,14 @@ func (s *server) SchemasCreateForOrganization(w http.ResponseWriter, r *http.Req
 	}, http.StatusCreated)
 }
 
-func (s *server) SchemasGetForOrganization(w http.ResponseWriter, r *http.Request, organization ParameterOrganization, schema ParameterSchema) {
+func (s *server) SchemasGetForOrganization(w http.ResponseWriter, r *http.Request, organization ParameterOrganization, schema ParameterSchema, params SchemasGetForOrganizationParams) {
 	sc, err := s.store.Schemas(organization).Get(r.Context(), schema)
 	if err != nil {
-		if errors.Is(err, qrm.ErrNoRows) {
-			s.httpError(w, err.Error(), http.StatusNotFound)
-			return
-		}
-		s.httpError(w, err.Error(), http.StatusInternalServerError)
+		s.httpStoreError(w, err)
+		return
+	}
+
+	if !writeETag(w, params.IfNoneMatch, sc.ID, sc.Updated) {
 		return
 	}
 
@@ -310,226 +534,444 @@ func (s *server) SchemasGetForOrganization(w http.ResponseWriter, r *http.Reques
 	}, http.StatusOK)
 }
 
-func (s *server) VersionsListForModel(w http.ResponseWriter, r *http.Request, organization ParameterOrganization, model ParameterModel) {
-	vs, err := s.store.Versions(organization, model).List(r.Context())
+func (s *server) VersionsListForModel(w http.ResponseWriter, r *http.Request, organization ParameterOrganization, model ParameterModel, params VersionsListForModelParams) {
+	selector, err := parseLabelSelector(params.LabelSelector)
 	if err != nil {
-		if errors.Is(err, qrm.ErrNoRows) {
-			s.httpError(w, err.Error(), http.StatusNotFound)
-			return
-		}
-		s.httpError(w, err.Error(), http.StatusInternalServerError)
+		s.httpError(w, err.Error(), http.StatusUnprocessableEntity)
+		return
+	}
+
+	vs, err := s.store.Versions(organization, model).List(r.Context(), selector)
+	if err != nil {
+		s.httpStoreError(w, err)
 		return
 	}
 
 	versions := make([]*Version, 0, len(vs))
 	for i := range vs {
-		versions = append(versions, &Version{
-			ID:           int(vs[i].ID),
-			Name:         vs[i].Name,
-			Organization: int(vs[i].Organization),
-			Model:        int(vs[i].Model),
-			Schema:       int(vs[i].Schema),
-			Created:      *vs[i].Created,
-			Updated:      *vs[i].Updated,
-		})
+		v, err := versionFromModel(vs[i])
+		if err != nil {
+			s.httpError(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+		versions = append(versions, v)
 	}
 	s.httpJSON(w, versions, http.StatusOK)
 }
 
+func versionFromModel(v *model.Version) (*Version, error) {
+	l, err := labelsFromJSON(v.Labels)
+	if err != nil {
+		return nil, err
+	}
+	return &Version{
+		ID:              int(v.ID),
+		Name:            v.Name,
+		Organization:    int(v.Organization),
+		Model:           int(v.Model),
+		Schema:          int(v.Schema),
+		Description:     v.Description,
+		ArtifactURI:     v.ArtifactURI,
+		Commit:          v.Commit,
+		Dataset:         v.Dataset,
+		Hyperparameters: stringPointerToRawMessagePointer(v.Hyperparameters),
+		Labels:          l,
+		Archived:        v.Archived,
+		Created:         *v.Created,
+		Updated:         *v.Updated,
+	}, nil
+}
+
 func (s *server) VersionsCreateForModel(w http.ResponseWriter, r *http.Request, organization ParameterOrganization, modelParam ParameterModel) {
 	body := new(VersionsCreateForModelJSONBody)
 	d := json.NewDecoder(r.Body)
 	d.DisallowUnknownFields()
 	if err := d.Decode(body); err != nil {
-		if errors.Is(err, (*json.UnmarshalTypeError)(nil)) {
-			s.httpError(w, err.Error(), http.StatusUnprocessableEntity)
-			return
-		}
-		s.httpError(w, err.Error(), http.StatusInternalServerError)
-		return
-	}
-
-	v, err := s.store.Versions(organization, modelParam).Create(r.Context(), &model.Version{Name: body.Name, Schema: int32(body.Schema)})
-	if err != nil {
-		if errors.Is(err, qrm.ErrNoRows) {
-			s.httpError(w, err.Error(), http.StatusNotFound)
-			return
-		}
-		s.httpError(w, err.Error(), http.StatusInternalServerError)
+		s.httpDecodeError(w, err)
 		return
 	}
 
-	s.httpJSON(w, &Version{
-		ID:           int(v.ID),
-		Name:         v.Name,
-		Organization: int(v.Organization),
-		Model:        int(v.Model),
-		Schema:       int(v.Schema),
-		Created:      *v.Created,
-		Updated:      *v.Updated,
-	}, http.StatusCreated)
-}
-
-func (s *server) VersionsGetForModel(w http.ResponseWriter, r *http.Request, organization ParameterOrganization, model ParameterModel, version ParameterVersion) {
-	v, err := s.store.Versions(organization, model).Get(r.Context(), version)
-	if err != nil {
-		if errors.Is(err, qrm.ErrNoRows) {
-			s.httpError(w, err.Error(), http.StatusNotFound)
+	if body.Labels != nil {
+		if err := labels.Validate(*body.Labels); err != nil {
+			s.httpError(w, err.Error(), http.StatusUnprocessableEntity)
 			return
 		}
-		s.httpError(w, err.Error(), http.StatusInternalServerError)
-		return
 	}
-
-	s.httpJSON(w, &Version{
-		ID:           int(v.ID),
-		Name:         v.Name,
-		Organization: int(v.Organization),
-		Model:        int(v.Model),
-		Schema:       int(v.Schema),
-		Created:      *v.Created,
-		Updated:      *v.Updated,
-	}, http.StatusOK)
-}
-
-func (s *server) ResultsListForVersion(w http.ResponseWriter, r *http.Request, organization ParameterOrganization, model ParameterModel, version ParameterVersion) {
-	rs, err := s.store.Results(organization, model, version).List(r.Context())
+	l, err := labelsToJSON(body.Labels)
 	if err != nil {
-		if errors.Is(err, qrm.ErrNoRows) {
-			s.httpError(w, err.Error(), http.StatusNotFound)
-			return
-		}
 		s.httpError(w, err.Error(), http.StatusInternalServerError)
 		return
 	}
 
-	results := make([]*Result, 0, len(rs))
-	for i := range rs {
-		results = append(results, &Result{
-			ID:           int(rs[i].ID),
-			Organization: int(rs[i].Organization),
-			Model:        int(rs[i].Model),
-			Version:      int(rs[i].Version),
-			Input:        rs[i].Input,
-			Output:       rs[i].Output,
-			TrueOutput:   rs[i].TrueOutput,
-			Time:         rs[i].Time,
-			Created:      *rs[i].Created,
-			Updated:      *rs[i].Updated,
-		})
-	}
-	s.httpJSON(w, results, http.StatusOK)
-}
-
-func (s *server) ResultsCreateForVersion(w http.ResponseWriter, r *http.Request, organization ParameterOrganization, modelParam ParameterModel, version ParameterVersion) {
-	v, err := s.store.Versions(organization, modelParam).GetOrCreate(r.Context(), version)
+	v, err := s.store.Versions(organization, modelParam).Create(r.Context(), &model.Version{
+		Name:            body.Name,
+		Schema:          int32(body.Schema),
+		Description:     body.Description,
+		ArtifactURI:     body.ArtifactURI,
+		Commit:          body.Commit,
+		Dataset:         body.Dataset,
+		Hyperparameters: rawMessagePointerToStringPointer(body.Hyperparameters),
+		Labels:          l,
+	})
 	if err != nil {
-		if errors.Is(err, qrm.ErrNoRows) {
-			s.httpError(w, err.Error(), http.StatusNotFound)
-			return
-		}
-		s.httpError(w, err.Error(), http.StatusInternalServerError)
+		s.httpStoreError(w, err)
 		return
 	}
 
-	schema, err := s.store.Schemas(organization).GetByID(r.Context(), int(v.Schema))
+	version, err := versionFromModel(v)
 	if err != nil {
-		if errors.Is(err, qrm.ErrNoRows) {
-			s.httpError(w, err.Error(), http.StatusNotFound)
-			return
-		}
 		s.httpError(w, err.Error(), http.StatusInternalServerError)
 		return
 	}
+	s.httpJSON(w, version, http.StatusCreated)
+}
 
-	body := new(ResultsCreateForVersionJSONBody)
+func (s *server) VersionsUpdateForModel(w http.ResponseWriter, r *http.Request, organization ParameterOrganization, modelParam ParameterModel, versionParam ParameterVersion, params VersionsUpdateForModelParams) {
+	body := new(VersionsUpdateForModelJSONBody)
 	d := json.NewDecoder(r.Body)
 	d.DisallowUnknownFields()
 	if err := d.Decode(body); err != nil {
-		if errors.Is(err, (*json.UnmarshalTypeError)(nil)) {
+		s.httpDecodeError(w, err)
+		return
+	}
+
+	if body.Labels != nil {
+		if err := labels.Validate(*body.Labels); err != nil {
 			s.httpError(w, err.Error(), http.StatusUnprocessableEntity)
 			return
 		}
-		s.httpError(w, err.Error(), http.StatusInternalServerError)
-		return
 	}
-
-	inputSchema, err := gojsonschema.NewSchema(gojsonschema.NewBytesLoader(schema.Input))
+	l, err := labelsToJSON(body.Labels)
 	if err != nil {
 		s.httpError(w, err.Error(), http.StatusInternalServerError)
 		return
 	}
-	validationResult, err := inputSchema.Validate(gojsonschema.NewBytesLoader(body.Input))
+
+	v, err := s.updateVersion(r.Context(), organization, modelParam, versionParam, params.IfMatch, nil, func(v *model.Version) error {
+		v.Description = body.Description
+		v.ArtifactURI = body.ArtifactURI
+		v.Commit = body.Commit
+		v.Dataset = body.Dataset
+		v.Hyperparameters = rawMessagePointerToStringPointer(body.Hyperparameters)
+		v.Labels = l
+		return nil
+	})
 	if err != nil {
-		s.httpError(w, err.Error(), http.StatusUnprocessableEntity)
-		return
-	}
-	if !validationResult.Valid() {
-		s.httpError(w, "input does not match input schema", http.StatusUnprocessableEntity)
+		s.httpStoreError(w, err)
 		return
 	}
 
-	outputSchema, err := gojsonschema.NewSchema(gojsonschema.NewBytesLoader(schema.Output))
+	version, err := versionFromModel(v)
 	if err != nil {
 		s.httpError(w, err.Error(), http.StatusInternalServerError)
 		return
 	}
-	validationResult, err = outputSchema.Validate(gojsonschema.NewBytesLoader(body.Output))
-	if err != nil {
-		s.httpError(w, err.Error(), http.StatusUnprocessableEntity)
+	writeETag(w, nil, v.ID, v.Updated)
+	s.httpJSON(w, version, http.StatusOK)
+}
+
+func (s *server) VersionsPatchForModel(w http.ResponseWriter, r *http.Request, organization ParameterOrganization, modelParam ParameterModel, versionParam ParameterVersion, params VersionsPatchForModelParams) {
+	fields := new(VersionPatch)
+	patch, err := readMergePatch(r.Body, fields)
+	if err != nil {
+		s.httpDecodeError(w, err)
 		return
 	}
-	if !validationResult.Valid() {
-		s.httpError(w, "output does not match output schema", http.StatusUnprocessableEntity)
+
+	// The stage is not a field of the version but points at it,
+	// so it is changed together with the version.
+	v, err := s.updateVersion(r.Context(), organization, modelParam, versionParam, params.IfMatch, fields.Stage, func(v *model.Version) error {
+		l, err := labelsFromJSON(v.Labels)
+		if err != nil {
+			return err
+		}
+		var p VersionPatch
+		if err := applyMergePatch(&VersionPatch{
+			Description:     v.Description,
+			ArtifactURI:     v.ArtifactURI,
+			Commit:          v.Commit,
+			Dataset:         v.Dataset,
+			Hyperparameters: stringPointerToRawMessagePointer(v.Hyperparameters),
+			Labels:          &l,
+			Archived:        &v.Archived,
+		}, patch, &p); err != nil {
+			return invalid("%v", err)
+		}
+		if p.Labels != nil {
+			if err := labels.Validate(*p.Labels); err != nil {
+				return invalid("%v", err)
+			}
+		}
+		if v.Labels, err = labelsToJSON(p.Labels); err != nil {
+			return err
+		}
+		v.Description = p.Description
+		v.ArtifactURI = p.ArtifactURI
+		v.Commit = p.Commit
+		v.Dataset = p.Dataset
+		v.Hyperparameters = rawMessagePointerToStringPointer(p.Hyperparameters)
+		v.Archived = p.Archived != nil && *p.Archived
+		return nil
+	})
+	if err != nil {
+		s.httpStoreError(w, err)
 		return
 	}
-	validationResult, err = outputSchema.Validate(gojsonschema.NewBytesLoader(body.TrueOutput))
+
+	version, err := versionFromModel(v)
+	if err != nil {
+		s.httpError(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	writeETag(w, nil, v.ID, v.Updated)
+	s.httpJSON(w, version, http.StatusOK)
+}
+
+// updateVersion applies a change to the current version of a model version and stores the result
+// like updateModel does for models. If a stage is given, it is promoted to the version in the same transaction.
+func (s *server) updateVersion(ctx context.Context, organization, modelParam, name string, ifMatch, stage *string, change func(*model.Version) error) (*model.Version, error) {
+	for attempt := 1; ; attempt++ {
+		v, err := s.store.Versions(organization, modelParam).Get(ctx, name)
+		if err != nil {
+			return nil, err
+		}
+		if err := checkIfMatch(ifMatch, "version", v.ID, v.Updated); err != nil {
+			return nil, err
+		}
+		if err := change(v); err != nil {
+			return nil, err
+		}
+		if stage != nil {
+			v, err = s.store.Versions(organization, modelParam).UpdateAndPromote(ctx, v, *stage)
+		} else {
+			v, err = s.store.Versions(organization, modelParam).Update(ctx, v)
+		}
+		if errors.Is(err, store.ErrPreconditionFailed) && ifMatch == nil && attempt < maxUpdateAttempts {
+			continue
+		}
+		return v, err
+	}
+}
+
+func (s *server) VersionsGetForModel(w http.ResponseWriter, r *http.Request, organization ParameterOrganization, model ParameterModel, version ParameterVersion, params VersionsGetForModelParams) {
+	v, err := s.store.Versions(organization, model).Get(r.Context(), version)
+	if err != nil {
+		s.httpStoreError(w, err)
+		return
+	}
+
+	if !writeETag(w, params.IfNoneMatch, v.ID, v.Updated) {
+		return
+	}
+
+	res, err := versionFromModel(v)
+	if err != nil {
+		s.httpError(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	s.httpJSON(w, res, http.StatusOK)
+}
+
+func (s *server) ResultsListForVersion(w http.ResponseWriter, r *http.Request, organization ParameterOrganization, model ParameterModel, version ParameterVersion, params ResultsListForVersionParams) {
+	selector, err := parseLabelSelector(params.LabelSelector)
 	if err != nil {
 		s.httpError(w, err.Error(), http.StatusUnprocessableEntity)
 		return
 	}
-	if !validationResult.Valid() {
-		s.httpError(w, "true output does not match output schema", http.StatusUnprocessableEntity)
+
+	rs, err := s.store.Results(organization, model, version).List(r.Context(), selector)
+	if err != nil {
+		s.httpStoreError(w, err)
+		return
+	}
+
+	results := make([]*Result, 0, len(rs))
+	for i := range rs {
+		res, err := resultFromModel(rs[i])
+		if err != nil {
+			s.httpError(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+		results = append(results, res)
+	}
+	s.httpJSON(w, results, http.StatusOK)
+}
+
+func resultFromModel(r *model.Result) (*Result, error) {
+	l, err := labelsFromJSON(r.Labels)
+	if err != nil {
+		return nil, err
+	}
+	return &Result{
+		ID:            int(r.ID),
+		Organization:  int(r.Organization),
+		Model:         int(r.Model),
+		Version:       int(r.Version),
+		Input:         r.Input,
+		Output:        r.Output,
+		TrueOutput:    r.TrueOutput,
+		Time:          r.Time,
+		Labels:        l,
+		CorrelationID: r.CorrelationID,
+		ClientID:      r.ClientID,
+		Created:       *r.Created,
+		Updated:       *r.Updated,
+	}, nil
+}
+
+func (s *server) ResultsCreateForVersion(w http.ResponseWriter, r *http.Request, organization ParameterOrganization, modelParam ParameterModel, version ParameterVersion, _ ResultsCreateForVersionParams) {
+	validator, err := NewResultValidator(r.Context(), s.store, organization, modelParam, version)
+	if err != nil {
+		s.httpStoreError(w, err)
 		return
 	}
 
-	if body.Time == nil {
-		t := time.Now()
-		body.Time = &t
+	body := new(NewResult)
+	d := json.NewDecoder(r.Body)
+	d.DisallowUnknownFields()
+	if err := d.Decode(body); err != nil {
+		s.httpDecodeError(w, err)
+		return
 	}
 
-	result, err := s.store.Results(organization, modelParam, version).Create(r.Context(), &model.Result{Input: body.Input, Output: body.Output, TrueOutput: body.TrueOutput, Time: *body.Time})
+	res, err := validator.Result(body)
 	if err != nil {
-		if errors.Is(err, qrm.ErrNoRows) {
-			s.httpError(w, err.Error(), http.StatusNotFound)
+		s.httpStoreError(w, err)
+		return
+	}
+
+	quota, limited := resultsQuotaFromContext(r.Context())
+	if limited {
+		if d := quota.Allow(r.Context(), 1); !d.Allowed {
+			s.throttle(w, d)
 			return
 		}
+	}
+
+	if s.queue != nil {
+		if s.enqueueResult(w, organization, modelParam, version, body, res) && limited {
+			quota.Add(1)
+		}
+		return
+	}
+
+	result, err := s.store.Results(organization, modelParam, version).Create(r.Context(), res)
+	switch {
+	case err == nil:
+		if limited {
+			quota.Add(1)
+		}
+	case errors.Is(err, store.ErrConflict) && res.ClientID != nil:
+		// The result was already created, e.g. by a request that the client retried,
+		// so respond as if it had been created now.
+		w.Header().Set(idempotentReplayedHeader, "true")
+		result, err = s.store.Results(organization, modelParam, version).GetByClientID(r.Context(), *res.ClientID)
+	}
+	if err != nil {
+		s.httpStoreError(w, err)
+		return
+	}
+
+	rr, err := resultFromModel(result)
+	if err != nil {
 		s.httpError(w, err.Error(), http.StatusInternalServerError)
 		return
 	}
 
-	s.httpJSON(w, &Result{
-		ID:           int(result.ID),
-		Organization: int(result.Organization),
-		Model:        int(result.Model),
-		Version:      int(result.Version),
-		Input:        result.Input,
-		Output:       result.Output,
-		TrueOutput:   result.TrueOutput,
-		Time:         result.Time,
-		Created:      *result.Created,
-		Updated:      *result.Updated,
-	}, http.StatusCreated)
+	s.httpJSON(w, rr, http.StatusCreated)
 }
 
-func (s *server) ResultsGetForVersion(w http.ResponseWriter, r *http.Request, organization ParameterOrganization, model ParameterModel, version ParameterVersion, result ParameterResult) {
-	v, err := s.store.Versions(organization, model).Get(r.Context(), version)
+// enqueueResult enqueues a validated result and responds with the result as it will be created.
+// Results with a client ID that already exists for the version are skipped when they are created.
+// It returns false if the result could not be enqueued.
+func (s *server) enqueueResult(w http.ResponseWriter, organization, modelParam, version string, body *NewResult, res *model.Result) bool {
+	if err := s.queue.Enqueue(organization, modelParam, version, res); err != nil {
+		w.Header().Set("Retry-After", "1")
+		s.httpError(w, err.Error(), http.StatusServiceUnavailable)
+		return false
+	}
+	body.Time = &res.Time
+	s.httpJSON(w, body, http.StatusAccepted)
+	return true
+}
+
+func (s *server) ResultsCreateBulkForVersion(w http.ResponseWriter, r *http.Request, organization ParameterOrganization, modelParam ParameterModel, version ParameterVersion, _ ResultsCreateBulkForVersionParams) {
+	validator, err := NewResultValidator(r.Context(), s.store, organization, modelParam, version)
 	if err != nil {
-		if errors.Is(err, qrm.ErrNoRows) {
-			s.httpError(w, err.Error(), http.StatusNotFound)
+		s.httpStoreError(w, err)
+		return
+	}
+
+	// Decode the results one at a time, so that the memory used
+	// does not depend on the number of results in the request.
+	d := json.NewDecoder(r.Body)
+	d.DisallowUnknownFields()
+	if t, err := d.Token(); err != nil {
+		s.httpDecodeError(w, err)
+		return
+	} else if t != json.Delim('[') {
+		s.httpError(w, "the request body must be an array of results", http.StatusUnprocessableEntity)
+		return
+	}
+
+	// Read the whole request before the results are created, so that a slow client
+	// does not hold a database connection and an open transaction while it sends the body.
+	sp := new(spool)
+	defer func() {
+		if err := sp.close(); err != nil {
+			level.Warn(s.logger).Log("msg", "failed to remove the spooled results", "err", err.Error())
+		}
+	}()
+	quota, limited := resultsQuotaFromContext(r.Context())
+	for i := 0; d.More(); i++ {
+		body := new(NewResult)
+		if err := d.Decode(body); err != nil {
+			s.httpDecodeError(w, err)
 			return
 		}
-		s.httpError(w, err.Error(), http.StatusInternalServerError)
+		res, err := validator.Result(body)
+		if err != nil {
+			s.httpStoreError(w, fmt.Errorf("result %d: %w", i, err))
+			return
+		}
+		if limited {
+			// Check that all results read so far fit; they are counted once they are created.
+			if decision := quota.Allow(r.Context(), int64(i+1)); !decision.Allowed {
+				s.throttle(w, decision)
+				return
+			}
+		}
+		if err := sp.add(res); err != nil {
+			s.httpError(w, fmt.Sprintf("failed to buffer the results: %v", err), http.StatusInternalServerError)
+			return
+		}
+	}
+	if _, err := d.Token(); err != nil {
+		s.httpDecodeError(w, err)
+		return
+	}
+
+	next, err := sp.next()
+	if err != nil {
+		s.httpError(w, fmt.Sprintf("failed to read the buffered results: %v", err), http.StatusInternalServerError)
+		return
+	}
+	created, skipped, err := s.store.Results(organization, modelParam, version).CreateBulk(r.Context(), next)
+	if err != nil {
+		s.httpStoreError(w, err)
+		return
+	}
+	if limited {
+		quota.Add(int64(created))
+	}
+
+	s.httpJSON(w, &BulkResults{Count: created, Skipped: skipped}, http.StatusCreated)
+}
+
+func (s *server) ResultsGetForVersion(w http.ResponseWriter, r *http.Request, organization ParameterOrganization, model ParameterModel, version ParameterVersion, result ParameterResult) {
+	v, err := s.store.Versions(organization, model).Get(r.Context(), version)
+	if err != nil {
+		s.httpStoreError(w, err)
 		return
 	}
 
@@ -543,3 +985,587 @@ func (s *server) ResultsGetForVersion(w http.ResponseWriter, r *http.Request, or
 		Updated:      *v.Updated,
 	}, http.StatusOK)
 }
+
+func stageFromModel(st *model.Stage) *Stage {
+	return &Stage{
+		ID:           int(st.ID),
+		Name:         st.Name,
+		Organization: int(st.Organization),
+		Model:        int(st.Model),
+		Version:      int(st.Version),
+		Created:      *st.Created,
+		Updated:      *st.Updated,
+	}
+}
+
+func (s *server) StagesListForModel(w http.ResponseWriter, r *http.Request, organization ParameterOrganization, model ParameterModel) {
+	sts, err := s.store.Stages(organization, model).List(r.Context())
+	if err != nil {
+		s.httpStoreError(w, err)
+		return
+	}
+
+	stages := make([]*Stage, 0, len(sts))
+	for i := range sts {
+		stages = append(stages, stageFromModel(sts[i]))
+	}
+	s.httpJSON(w, stages, http.StatusOK)
+}
+
+func (s *server) StagesGetForModel(w http.ResponseWriter, r *http.Request, organization ParameterOrganization, model ParameterModel, stage ParameterStage, params StagesGetForModelParams) {
+	st, err := s.store.Stages(organization, model).Get(r.Context(), stage)
+	if err != nil {
+		s.httpStoreError(w, err)
+		return
+	}
+
+	if !writeETag(w, params.IfNoneMatch, st.ID, st.Updated) {
+		return
+	}
+
+	s.httpJSON(w, stageFromModel(st), http.StatusOK)
+}
+
+func (s *server) StagesPromoteForModel(w http.ResponseWriter, r *http.Request, organization ParameterOrganization, model ParameterModel, stage ParameterStage) {
+	body := new(StagesPromoteForModelJSONBody)
+	d := json.NewDecoder(r.Body)
+	d.DisallowUnknownFields()
+	if err := d.Decode(body); err != nil {
+		s.httpDecodeError(w, err)
+		return
+	}
+
+	st, err := s.store.Stages(organization, model).Promote(r.Context(), stage, body.Version)
+	if err != nil {
+		s.httpStoreError(w, err)
+		return
+	}
+
+	s.httpJSON(w, stageFromModel(st), http.StatusOK)
+}
+
+func (s *server) StagesRollbackForModel(w http.ResponseWriter, r *http.Request, organization ParameterOrganization, model ParameterModel, stage ParameterStage) {
+	st, err := s.store.Stages(organization, model).Rollback(r.Context(), stage)
+	if err != nil {
+		s.httpStoreError(w, err)
+		return
+	}
+
+	s.httpJSON(w, stageFromModel(st), http.StatusOK)
+}
+
+func (s *server) StagesHistoryForModel(w http.ResponseWriter, r *http.Request, organization ParameterOrganization, model ParameterModel, stage ParameterStage) {
+	hs, err := s.store.Stages(organization, model).History(r.Context(), stage)
+	if err != nil {
+		s.httpStoreError(w, err)
+		return
+	}
+
+	history := make([]*StageHistoryEntry, 0, len(hs))
+	for i := range hs {
+		history = append(history, &StageHistoryEntry{
+			ID:              int(hs[i].ID),
+			Stage:           int(hs[i].Stage),
+			Version:         int(hs[i].Version),
+			PreviousVersion: int32PointerToIntPointer(hs[i].PreviousVersion),
+			Created:         *hs[i].Created,
+		})
+	}
+	s.httpJSON(w, history, http.StatusOK)
+}
+
+// stageVersion returns the version that a stage of a model points at.
+func (s *server) stageVersion(ctx context.Context, organization, modelParam, stage string) (*model.Version, error) {
+	st, err := s.store.Stages(organization, modelParam).Get(ctx, stage)
+	if err != nil {
+		return nil, err
+	}
+	return s.store.Versions(organization, modelParam).GetByID(ctx, int(st.Version))
+}
+
+func (s *server) ResultsListForModel(w http.ResponseWriter, r *http.Request, organization ParameterOrganization, model ParameterModel, params ResultsListForModelParams) {
+	v, err := s.stageVersion(r.Context(), organization, model, params.Stage)
+	if err != nil {
+		s.httpStoreError(w, err)
+		return
+	}
+
+	s.ResultsListForVersion(w, r, organization, model, v.Name, ResultsListForVersionParams{LabelSelector: params.LabelSelector})
+}
+
+func (s *server) ResultsCreateForModel(w http.ResponseWriter, r *http.Request, organization ParameterOrganization, model ParameterModel, params ResultsCreateForModelParams) {
+	v, err := s.stageVersion(r.Context(), organization, model, params.Stage)
+	if err != nil {
+		s.httpStoreError(w, err)
+		return
+	}
+
+	s.ResultsCreateForVersion(w, r, organization, model, v.Name, ResultsCreateForVersionParams{})
+}
+
+func metricsFromSummary(sum evaluation.Summary) Metrics {
+	m := Metrics{
+		Count:    sum.Count,
+		Labelled: sum.Labelled,
+		Correct:  sum.Correct,
+	}
+	if a, ok := sum.Accuracy(); ok {
+		m.Accuracy = &a
+	}
+	return m
+}
+
+func (s *server) MetricsGetForVersion(w http.ResponseWriter, r *http.Request, organization ParameterOrganization, model ParameterModel, version ParameterVersion, params MetricsGetForVersionParams) {
+	selector, err := parseLabelSelector(params.LabelSelector)
+	if err != nil {
+		s.httpError(w, err.Error(), http.StatusUnprocessableEntity)
+		return
+	}
+
+	// Aggregate the results in the store rather than loading all of them.
+	st, err := s.store.Statistics().Get(r.Context(), organization, model, version, nil, nil, selector)
+	if err != nil {
+		s.httpStoreError(w, err)
+		return
+	}
+
+	s.httpJSON(w, metricsFromSummary(evaluation.Summary{Count: int(st.Results), Labelled: int(st.Labelled), Correct: int(st.Correct)}), http.StatusOK)
+}
+
+func (s *server) MetricsGetForModel(w http.ResponseWriter, r *http.Request, organization ParameterOrganization, model ParameterModel, params MetricsGetForModelParams) {
+	v, err := s.stageVersion(r.Context(), organization, model, params.Stage)
+	if err != nil {
+		s.httpStoreError(w, err)
+		return
+	}
+
+	s.MetricsGetForVersion(w, r, organization, model, v.Name, MetricsGetForVersionParams{LabelSelector: params.LabelSelector})
+}
+
+func (s *server) ComparisonGetForModel(w http.ResponseWriter, r *http.Request, organization ParameterOrganization, model ParameterModel, params ComparisonGetForModelParams) {
+	selector, err := parseLabelSelector(params.LabelSelector)
+	if err != nil {
+		s.httpError(w, err.Error(), http.StatusUnprocessableEntity)
+		return
+	}
+
+	ps, err := s.store.Results(organization, model, params.Champion).Pair(r.Context(), params.Challenger, selector)
+	if err != nil {
+		s.httpStoreError(w, err)
+		return
+	}
+
+	pairs := make([]evaluation.Pair, 0, len(ps))
+	for i := range ps {
+		pairs = append(pairs, evaluation.Pair{
+			ChampionOutput:       ps[i].Champion.Output,
+			ChampionTrueOutput:   ps[i].Champion.TrueOutput,
+			ChallengerOutput:     ps[i].Challenger.Output,
+			ChallengerTrueOutput: ps[i].Challenger.TrueOutput,
+		})
+	}
+	c := evaluation.Compare(pairs)
+
+	res := &Comparison{
+		Champion:          params.Champion,
+		Challenger:        params.Challenger,
+		Pairs:             c.Pairs,
+		ChampionWins:      c.ChampionWins,
+		ChallengerWins:    c.ChallengerWins,
+		ChampionMetrics:   metricsFromSummary(c.Champion),
+		ChallengerMetrics: metricsFromSummary(c.Challenger),
+	}
+	if a, ok := c.Agreement(); ok {
+		res.Agreement = &a
+	}
+	if wr, ok := c.WinRate(); ok {
+		res.WinRate = &wr
+	}
+	if d, ok := c.AccuracyDelta(); ok {
+		res.AccuracyDelta = &d
+	}
+	s.httpJSON(w, res, http.StatusOK)
+}
+
+// samples extracts the fields of the inputs and outputs of the given results.
+func samples(rs []*model.Result) (drift.Samples, error) {
+	ss := make(drift.Samples)
+	for i := range rs {
+		if err := ss.AddResult(rs[i].Input, rs[i].Output); err != nil {
+			return nil, err
+		}
+	}
+	return ss, nil
+}
+
+func (s *server) DriftGetForVersion(w http.ResponseWriter, r *http.Request, organization ParameterOrganization, model ParameterModel, version ParameterVersion, params DriftGetForVersionParams) {
+	selector, err := parseLabelSelector(params.LabelSelector)
+	if err != nil {
+		s.httpError(w, err.Error(), http.StatusUnprocessableEntity)
+		return
+	}
+
+	bins := drift.DefaultBins
+	if params.Bins != nil {
+		if *params.Bins < 1 {
+			s.httpError(w, "the number of bins must be positive", http.StatusUnprocessableEntity)
+			return
+		}
+		bins = *params.Bins
+	}
+
+	reference := version
+	if params.Reference != nil {
+		reference = *params.Reference
+	}
+
+	// Listing results does not fail for nonexistent versions,
+	// so check that both versions exist explicitly.
+	for _, v := range []string{reference, version} {
+		if _, err := s.store.Versions(organization, model).Get(r.Context(), v); err != nil {
+			s.httpStoreError(w, err)
+			return
+		}
+	}
+
+	// Windows default to the whole history of a version,
+	// so sample them rather than loading all of their results.
+	rs, err := s.store.Results(organization, model, reference).Sample(r.Context(), params.ReferenceStart, params.ReferenceEnd, selector, drift.MaxSamples)
+	if err != nil {
+		s.httpStoreError(w, err)
+		return
+	}
+	cs, err := s.store.Results(organization, model, version).Sample(r.Context(), params.Start, params.End, selector, drift.MaxSamples)
+	if err != nil {
+		s.httpStoreError(w, err)
+		return
+	}
+
+	rss, err := samples(rs)
+	if err != nil {
+		s.httpError(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	css, err := samples(cs)
+	if err != nil {
+		s.httpError(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	fields := drift.Compare(rss, css, bins)
+	res := &DriftReport{
+		Version:        version,
+		Reference:      reference,
+		CurrentCount:   len(cs),
+		ReferenceCount: len(rs),
+		Fields:         make([]FieldDrift, 0, len(fields)),
+	}
+	for _, f := range fields {
+		fd := FieldDrift{
+			Name:           f.Name,
+			Kind:           FieldDriftKind(f.Kind),
+			CurrentCount:   f.Current,
+			ReferenceCount: f.Reference,
+			PSI:            f.PSI,
+			KL:             f.KL,
+		}
+		s.drift.WithLabelValues(organization, model, version, reference, f.Name, "psi").Set(f.PSI)
+		s.drift.WithLabelValues(organization, model, version, reference, f.Name, "kl").Set(f.KL)
+		if f.KS != nil {
+			fd.KSStatistic = &f.KS.Statistic
+			fd.KSPValue = &f.KS.PValue
+			s.drift.WithLabelValues(organization, model, version, reference, f.Name, "ks").Set(f.KS.Statistic)
+		}
+		res.Fields = append(res.Fields, fd)
+	}
+	s.httpJSON(w, res, http.StatusOK)
+}
+
+func alertRuleFromModel(ar *model.AlertRule) *AlertRule {
+	return &AlertRule{
+		ID:           int(ar.ID),
+		Name:         ar.Name,
+		Organization: int(ar.Organization),
+		Model:        int(ar.Model),
+		Stage:        ar.Stage,
+		Metric:       AlertMetric(ar.Metric),
+		Field:        ar.Field,
+		Operator:     AlertOperator(ar.Operator),
+		Threshold:    ar.Threshold,
+		Window:       (time.Duration(ar.WindowSeconds) * time.Second).String(),
+		Firing:       ar.Firing,
+		ActiveSince:  ar.ActiveSince,
+		Value:        ar.LastValue,
+		Created:      *ar.Created,
+		Updated:      *ar.Updated,
+	}
+}
+
+func (s *server) AlertRulesListForModel(w http.ResponseWriter, r *http.Request, organization ParameterOrganization, model ParameterModel) {
+	ars, err := s.store.AlertRules(organization, model).List(r.Context())
+	if err != nil {
+		s.httpStoreError(w, err)
+		return
+	}
+
+	rules := make([]*AlertRule, 0, len(ars))
+	for i := range ars {
+		rules = append(rules, alertRuleFromModel(ars[i]))
+	}
+	s.httpJSON(w, rules, http.StatusOK)
+}
+
+func (s *server) AlertRulesCreateForModel(w http.ResponseWriter, r *http.Request, organization ParameterOrganization, modelParam ParameterModel) {
+	body := new(AlertRulesCreateForModelJSONBody)
+	d := json.NewDecoder(r.Body)
+	d.DisallowUnknownFields()
+	if err := d.Decode(body); err != nil {
+		s.httpDecodeError(w, err)
+		return
+	}
+
+	metric := alerting.Metric(body.Metric)
+	if err := metric.Valid(); err != nil {
+		s.httpError(w, err.Error(), http.StatusUnprocessableEntity)
+		return
+	}
+	if body.Field != nil && !metric.Drift() {
+		s.httpError(w, fmt.Sprintf("a field can only be given for drift metrics, not %q", metric), http.StatusUnprocessableEntity)
+		return
+	}
+	if err := alerting.Operator(body.Operator).Valid(); err != nil {
+		s.httpError(w, err.Error(), http.StatusUnprocessableEntity)
+		return
+	}
+	window, err := time.ParseDuration(body.Window)
+	if err != nil {
+		s.httpError(w, err.Error(), http.StatusUnprocessableEntity)
+		return
+	}
+	if window < time.Second {
+		s.httpError(w, "the window must be at least one second", http.StatusUnprocessableEntity)
+		return
+	}
+
+	ar, err := s.store.AlertRules(organization, modelParam).Create(r.Context(), &model.AlertRule{
+		Name:          body.Name,
+		Stage:         body.Stage,
+		Metric:        string(metric),
+		Field:         body.Field,
+		Operator:      string(body.Operator),
+		Threshold:     body.Threshold,
+		WindowSeconds: int32(window.Seconds()),
+	})
+	if err != nil {
+		s.httpStoreError(w, err)
+		return
+	}
+
+	s.httpJSON(w, alertRuleFromModel(ar), http.StatusCreated)
+}
+
+func (s *server) AlertRulesGetForModel(w http.ResponseWriter, r *http.Request, organization ParameterOrganization, model ParameterModel, alertRule ParameterAlertRule, params AlertRulesGetForModelParams) {
+	ar, err := s.store.AlertRules(organization, model).Get(r.Context(), alertRule)
+	if err != nil {
+		s.httpStoreError(w, err)
+		return
+	}
+
+	if !writeETag(w, params.IfNoneMatch, ar.ID, ar.Updated) {
+		return
+	}
+
+	s.httpJSON(w, alertRuleFromModel(ar), http.StatusOK)
+}
+
+func (s *server) AlertRulesDeleteForModel(w http.ResponseWriter, r *http.Request, organization ParameterOrganization, model ParameterModel, alertRule ParameterAlertRule, params AlertRulesDeleteForModelParams) {
+	var updated *time.Time
+	if params.IfMatch != nil {
+		ar, err := s.store.AlertRules(organization, model).Get(r.Context(), alertRule)
+		if err != nil {
+			s.httpStoreError(w, err)
+			return
+		}
+		if err := checkIfMatch(params.IfMatch, "alert rule", ar.ID, ar.Updated); err != nil {
+			s.httpStoreError(w, err)
+			return
+		}
+		updated = ar.Updated
+	}
+
+	if err := s.store.AlertRules(organization, model).Delete(r.Context(), alertRule, updated); err != nil {
+		s.httpStoreError(w, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func webhookFromModel(wh *model.Webhook) (*Webhook, error) {
+	events := make([]EventType, 0)
+	if err := json.Unmarshal([]byte(wh.Events), &events); err != nil {
+		return nil, err
+	}
+	return &Webhook{
+		ID:           int(wh.ID),
+		Name:         wh.Name,
+		Organization: int(wh.Organization),
+		URL:          wh.URL,
+		Events:       events,
+		Created:      *wh.Created,
+		Updated:      *wh.Updated,
+	}, nil
+}
+
+func (s *server) WebhooksListForOrganization(w http.ResponseWriter, r *http.Request, organization ParameterOrganization) {
+	whs, err := s.store.Webhooks(organization).List(r.Context())
+	if err != nil {
+		s.httpStoreError(w, err)
+		return
+	}
+
+	webhooks := make([]*Webhook, 0, len(whs))
+	for i := range whs {
+		wh, err := webhookFromModel(whs[i])
+		if err != nil {
+			s.httpError(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+		webhooks = append(webhooks, wh)
+	}
+	s.httpJSON(w, webhooks, http.StatusOK)
+}
+
+func (s *server) WebhooksCreateForOrganization(w http.ResponseWriter, r *http.Request, organization ParameterOrganization) {
+	body := new(WebhooksCreateForOrganizationJSONBody)
+	d := json.NewDecoder(r.Body)
+	d.DisallowUnknownFields()
+	if err := d.Decode(body); err != nil {
+		s.httpDecodeError(w, err)
+		return
+	}
+
+	u, err := url.Parse(body.URL)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		s.httpError(w, fmt.Sprintf("%q is not a valid HTTP or HTTPS URL", body.URL), http.StatusUnprocessableEntity)
+		return
+	}
+	if body.Secret == "" {
+		s.httpError(w, "the secret must not be empty", http.StatusUnprocessableEntity)
+		return
+	}
+	events := make([]EventType, 0)
+	if body.Events != nil {
+		events = *body.Events
+	}
+	for _, e := range events {
+		var known bool
+		for _, t := range store.EventTypes {
+			if string(e) == string(t) {
+				known = true
+				break
+			}
+		}
+		if !known {
+			s.httpError(w, fmt.Sprintf("unknown event type %q", e), http.StatusUnprocessableEntity)
+			return
+		}
+	}
+	// Marshalling a slice of strings cannot fail.
+	ev, _ := json.Marshal(events)
+
+	wh, err := s.store.Webhooks(organization).Create(r.Context(), &model.Webhook{
+		Name:   body.Name,
+		URL:    body.URL,
+		Secret: body.Secret,
+		Events: string(ev),
+	})
+	if err != nil {
+		s.httpStoreError(w, err)
+		return
+	}
+
+	res, err := webhookFromModel(wh)
+	if err != nil {
+		s.httpError(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	s.httpJSON(w, res, http.StatusCreated)
+}
+
+func (s *server) WebhooksGetForOrganization(w http.ResponseWriter, r *http.Request, organization ParameterOrganization, webhook ParameterWebhook, params WebhooksGetForOrganizationParams) {
+	wh, err := s.store.Webhooks(organization).Get(r.Context(), webhook)
+	if err != nil {
+		s.httpStoreError(w, err)
+		return
+	}
+
+	if !writeETag(w, params.IfNoneMatch, wh.ID, wh.Updated) {
+		return
+	}
+
+	res, err := webhookFromModel(wh)
+	if err != nil {
+		s.httpError(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	s.httpJSON(w, res, http.StatusOK)
+}
+
+func (s *server) WebhooksDeleteForOrganization(w http.ResponseWriter, r *http.Request, organization ParameterOrganization, webhook ParameterWebhook, params WebhooksDeleteForOrganizationParams) {
+	var updated *time.Time
+	if params.IfMatch != nil {
+		wh, err := s.store.Webhooks(organization).Get(r.Context(), webhook)
+		if err != nil {
+			s.httpStoreError(w, err)
+			return
+		}
+		if err := checkIfMatch(params.IfMatch, "webhook", wh.ID, wh.Updated); err != nil {
+			s.httpStoreError(w, err)
+			return
+		}
+		updated = wh.Updated
+	}
+
+	if err := s.store.Webhooks(organization).Delete(r.Context(), webhook, updated); err != nil {
+		s.httpStoreError(w, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (s *server) WebhooksDeliveriesForOrganization(w http.ResponseWriter, r *http.Request, organization ParameterOrganization, webhook ParameterWebhook, params WebhooksDeliveriesForOrganizationParams) {
+	limit := 100
+	if params.Limit != nil {
+		if *params.Limit < 1 || *params.Limit > 1000 {
+			s.httpError(w, "the limit must be between 1 and 1000", http.StatusUnprocessableEntity)
+			return
+		}
+		limit = *params.Limit
+	}
+
+	ds, err := s.store.Webhooks(organization).Deliveries(r.Context(), webhook, limit)
+	if err != nil {
+		s.httpStoreError(w, err)
+		return
+	}
+
+	deliveries := make([]*WebhookDelivery, 0, len(ds))
+	for i := range ds {
+		deliveries = append(deliveries, &WebhookDelivery{
+			ID:             int(ds[i].ID),
+			Webhook:        int(ds[i].Webhook),
+			Event:          EventType(ds[i].Event),
+			Payload:        json.RawMessage(ds[i].Payload),
+			Status:         ds[i].Status,
+			Attempts:       int(ds[i].Attempts),
+			NextAttempt:    ds[i].NextAttempt,
+			ResponseStatus: int32PointerToIntPointer(ds[i].ResponseStatus),
+			Error:          ds[i].Error,
+			Created:        *ds[i].Created,
+			Updated:        *ds[i].Updated,
+		})
+	}
+	s.httpJSON(w, deliveries, http.StatusOK)
+}
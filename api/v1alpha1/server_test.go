@@ -0,0 +1,334 @@
+package v1alpha1
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/efficientgo/core/testutil"
+
+	"github.com/connylabs/model-tracking/drift"
+	"github.com/connylabs/model-tracking/evaluation"
+	"github.com/connylabs/model-tracking/labels"
+	"github.com/connylabs/model-tracking/ratelimit"
+	"github.com/connylabs/model-tracking/store"
+	"github.com/connylabs/model-tracking/store/model-tracking/public/model"
+)
+
+func (fs *fakeStore) Schemas(string) store.Schemas {
+	return fakeSchemas{}
+}
+
+func (fv *fakeVersions) GetOrCreate(ctx context.Context, name string) (*model.Version, error) {
+	v, err := fv.Get(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+	v.Schema = 1
+	return v, nil
+}
+
+func (fs *fakeStore) Statistics() store.Statistics {
+	return &fakeStatistics{fs: fs}
+}
+
+func (fv *fakeVersions) Update(_ context.Context, v *model.Version) (*model.Version, error) {
+	now := time.Now()
+	v.Created, v.Updated = &now, &now
+	return v, nil
+}
+
+func (fv *fakeVersions) UpdateAndPromote(ctx context.Context, v *model.Version, stage string) (*model.Version, error) {
+	fv.promoted = append(fv.promoted, stage)
+	return fv.Update(ctx, v)
+}
+
+// fakeStatistics aggregates the results of the fake store.
+type fakeStatistics struct {
+	store.Statistics
+	fs *fakeStore
+}
+
+func (fst *fakeStatistics) Get(ctx context.Context, organization, modelParam, version string, _, _ *time.Time, selector labels.Selector) (*store.VersionStatistics, error) {
+	if _, err := fst.fs.Versions(organization, modelParam).Get(ctx, version); err != nil {
+		return nil, err
+	}
+	rs, err := fst.fs.Results(organization, modelParam, version).List(ctx, selector)
+	if err != nil {
+		return nil, err
+	}
+	var sum evaluation.Summary
+	for i := range rs {
+		sum.Add(rs[i].Output, rs[i].TrueOutput)
+	}
+	return &store.VersionStatistics{Version: version, Results: int64(sum.Count), Labelled: int64(sum.Labelled), Correct: int64(sum.Correct)}, nil
+}
+
+type fakeSchemas struct {
+	store.Schemas
+}
+
+func (fakeSchemas) GetByID(_ context.Context, id int) (*model.Schema, error) {
+	return &model.Schema{ID: int32(id), Input: []byte(`{"type": "string"}`), Output: []byte(`{"type": "integer"}`)}, nil
+}
+
+func (fr *fakeResults) CreateBulk(_ context.Context, next func() (*model.Result, error)) (int, int, error) {
+	var rs []*model.Result
+	for {
+		r, err := next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return 0, 0, err
+		}
+		rs = append(rs, r)
+	}
+	// Like a transaction, nothing is created if any result fails.
+	fr.mu.Lock()
+	defer fr.mu.Unlock()
+	fr.results = append(fr.results, rs...)
+	return len(rs), 0, nil
+}
+
+func (fr *fakeResults) Sample(_ context.Context, _, _ *time.Time, _ labels.Selector, limit int) ([]*model.Result, error) {
+	rs := fr.find(func(*model.Result) bool { return true })
+	if len(rs) > limit {
+		rs = rs[:limit]
+	}
+	return rs, nil
+}
+
+type fakeQueue struct {
+	err   error
+	items []*model.Result
+}
+
+func (fq *fakeQueue) Enqueue(_, _, _ string, r *model.Result) error {
+	if fq.err != nil {
+		return fq.err
+	}
+	fq.items = append(fq.items, r)
+	return nil
+}
+
+func (fr *fakeResults) Create(_ context.Context, r *model.Result) (*model.Result, error) {
+	fr.mu.Lock()
+	defer fr.mu.Unlock()
+	now := time.Now()
+	r.ID, r.Created, r.Updated = int32(len(fr.results)+1), &now, &now
+	fr.results = append(fr.results, r)
+	return r, nil
+}
+
+func TestResultsCreateForVersionTrueOutput(t *testing.T) {
+	results := &fakeResults{version: "v1"}
+	fs := &fakeStore{versions: &fakeVersions{names: []string{"v1"}}, results: results}
+	create := func(body string) *httptest.ResponseRecorder {
+		w := httptest.NewRecorder()
+		NewServer(fs, nil, nil, 0, nil, nil).ResultsCreateForVersion(w, httptest.NewRequest(http.MethodPost, "/", strings.NewReader(body)), "foo", "bar", "v1", ResultsCreateForVersionParams{})
+		return w
+	}
+
+	for _, tc := range []struct {
+		name       string
+		body       string
+		code       int
+		trueOutput string
+	}{
+		{name: "labelled", body: `{"input": "a", "output": 1, "trueOutput": 2}`, code: http.StatusCreated, trueOutput: `2`},
+		{name: "missing", body: `{"input": "a", "output": 1}`, code: http.StatusCreated, trueOutput: `null`},
+		{name: "null", body: `{"input": "a", "output": 1, "trueOutput": null}`, code: http.StatusCreated, trueOutput: `null`},
+		{name: "invalid", body: `{"input": "a", "output": 1, "trueOutput": "b"}`, code: http.StatusUnprocessableEntity},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			w := create(tc.body)
+			testutil.Equals(t, tc.code, w.Code)
+			if tc.code != http.StatusCreated {
+				return
+			}
+			var res Result
+			testutil.Ok(t, json.NewDecoder(w.Body).Decode(&res))
+			testutil.Equals(t, tc.trueOutput, string(res.TrueOutput))
+			stored := results.find(func(r *model.Result) bool { return int(r.ID) == res.ID })
+			testutil.Equals(t, 1, len(stored))
+			testutil.Equals(t, tc.trueOutput, string(stored[0].TrueOutput))
+		})
+	}
+}
+
+func TestResultsCreateForVersionQueued(t *testing.T) {
+	fs := &fakeStore{versions: &fakeVersions{names: []string{"v1"}}, results: &fakeResults{version: "v1"}}
+	create := func(q Enqueuer, body string) *httptest.ResponseRecorder {
+		w := httptest.NewRecorder()
+		NewServer(fs, nil, q, 0, nil, nil).ResultsCreateForVersion(w, httptest.NewRequest(http.MethodPost, "/", strings.NewReader(body)), "foo", "bar", "v1", ResultsCreateForVersionParams{})
+		return w
+	}
+
+	q := new(fakeQueue)
+	w := create(q, `{"input": "a", "output": 1, "trueOutput": 1, "time": "2023-01-01T00:00:00Z", "clientId": "x"}`)
+	testutil.Equals(t, http.StatusAccepted, w.Code)
+	var res NewResult
+	testutil.Ok(t, json.NewDecoder(w.Body).Decode(&res))
+	testutil.Equals(t, time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC), *res.Time)
+	testutil.Equals(t, "x", *res.ClientID)
+	testutil.Equals(t, 1, len(q.items))
+	testutil.Equals(t, `"a"`, string(q.items[0].Input))
+
+	// Invalid results are rejected before they are enqueued.
+	w = create(q, `{"input": 1, "output": 1, "trueOutput": 1}`)
+	testutil.Equals(t, http.StatusUnprocessableEntity, w.Code)
+	testutil.Equals(t, 1, len(q.items))
+
+	// Results whose true output is not known yet are not validated against the output schema.
+	for _, body := range []string{`{"input": "a", "output": 1, "trueOutput": null}`, `{"input": "a", "output": 1}`} {
+		w = create(q, body)
+		testutil.Equals(t, http.StatusAccepted, w.Code)
+	}
+	testutil.Equals(t, 3, len(q.items))
+	testutil.Equals(t, `null`, string(q.items[1].TrueOutput))
+	testutil.Equals(t, `null`, string(q.items[2].TrueOutput))
+	w = create(q, `{"input": "a", "output": 1, "trueOutput": "b"}`)
+	testutil.Equals(t, http.StatusUnprocessableEntity, w.Code)
+
+	w = create(&fakeQueue{err: errors.New("the ingestion queue is full")}, `{"input": "a", "output": 1, "trueOutput": 1}`)
+	testutil.Equals(t, http.StatusServiceUnavailable, w.Code)
+	testutil.Equals(t, "1", w.Header().Get("Retry-After"))
+	var p Problem
+	testutil.Ok(t, json.NewDecoder(w.Body).Decode(&p))
+	testutil.Equals(t, CodeUnavailable, p.Code)
+}
+
+// fakeQuota is a results quota of which only the remaining results are left.
+type fakeQuota struct {
+	remaining int64
+}
+
+func (fq *fakeQuota) Allow(_ context.Context, n int64) ratelimit.Decision {
+	if fq.remaining < n {
+		return ratelimit.Decision{Reason: ratelimit.ResultsQuota, RetryAfter: time.Hour}
+	}
+	return ratelimit.Decision{Allowed: true}
+}
+
+func (fq *fakeQuota) Add(n int64) {
+	fq.remaining -= n
+}
+
+func TestResultsCreateForVersionQuota(t *testing.T) {
+	fs := &fakeStore{versions: &fakeVersions{names: []string{"v1"}}, results: &fakeResults{version: "v1"}}
+	quota := &fakeQuota{remaining: 1}
+	create := func(body string) *httptest.ResponseRecorder {
+		r := httptest.NewRequest(http.MethodPost, "/", strings.NewReader(body))
+		r = r.WithContext(context.WithValue(r.Context(), resultsQuotaKey{}, resultsQuota(quota)))
+		w := httptest.NewRecorder()
+		NewServer(fs, nil, nil, 0, nil, nil).ResultsCreateForVersion(w, r, "foo", "bar", "v1", ResultsCreateForVersionParams{})
+		return w
+	}
+
+	// Invalid results do not count against the quota.
+	testutil.Equals(t, http.StatusUnprocessableEntity, create(`{"input": 1, "output": 1}`).Code)
+	testutil.Equals(t, int64(1), quota.remaining)
+
+	testutil.Equals(t, http.StatusCreated, create(`{"input": "a", "output": 1}`).Code)
+	testutil.Equals(t, int64(0), quota.remaining)
+
+	w := create(`{"input": "a", "output": 1}`)
+	testutil.Equals(t, http.StatusTooManyRequests, w.Code)
+	testutil.Equals(t, "3600", w.Header().Get("Retry-After"))
+}
+
+func TestResultsCreateBulkForVersionQuota(t *testing.T) {
+	results := &fakeResults{version: "v1"}
+	fs := &fakeStore{versions: &fakeVersions{names: []string{"v1"}}, results: results}
+	quota := &fakeQuota{remaining: 3}
+	create := func(body string) *httptest.ResponseRecorder {
+		r := httptest.NewRequest(http.MethodPost, "/", strings.NewReader(body))
+		r = r.WithContext(context.WithValue(r.Context(), resultsQuotaKey{}, resultsQuota(quota)))
+		w := httptest.NewRecorder()
+		NewServer(fs, nil, nil, 0, nil, nil).ResultsCreateBulkForVersion(w, r, "foo", "bar", "v1", ResultsCreateBulkForVersionParams{})
+		return w
+	}
+	batch := func(n int) string {
+		return "[" + strings.TrimSuffix(strings.Repeat(`{"input": "a", "output": 1, "trueOutput": 1},`, n), ",") + "]"
+	}
+
+	// Only created results count against the quota.
+	testutil.Equals(t, http.StatusUnprocessableEntity, create(`[{"input": "a", "output": 1}, {"input": 1, "output": 1}]`).Code)
+	testutil.Equals(t, int64(3), quota.remaining)
+	testutil.Equals(t, http.StatusCreated, create(batch(2)).Code)
+	testutil.Equals(t, int64(1), quota.remaining)
+
+	w := create(batch(2))
+	testutil.Equals(t, http.StatusTooManyRequests, w.Code)
+	testutil.Equals(t, "3600", w.Header().Get("Retry-After"))
+	var p Problem
+	testutil.Ok(t, json.NewDecoder(w.Body).Decode(&p))
+	testutil.Equals(t, CodeQuotaExceeded, p.Code)
+	testutil.Equals(t, int64(1), quota.remaining)
+	testutil.Equals(t, 2, len(results.find(func(*model.Result) bool { return true })))
+}
+
+func TestMetricsGetForVersion(t *testing.T) {
+	results := &fakeResults{version: "v1"}
+	results.add(1)
+	results.add(2)
+	results.results[1].TrueOutput = []byte(`2`)
+	fs := &fakeStore{versions: &fakeVersions{names: []string{"v1"}}, results: results}
+	get := func(version string) *httptest.ResponseRecorder {
+		w := httptest.NewRecorder()
+		NewServer(fs, nil, nil, 0, nil, nil).MetricsGetForVersion(w, httptest.NewRequest(http.MethodGet, "/", nil), "foo", "bar", version, MetricsGetForVersionParams{})
+		return w
+	}
+
+	w := get("v1")
+	testutil.Equals(t, http.StatusOK, w.Code)
+	var m Metrics
+	testutil.Ok(t, json.NewDecoder(w.Body).Decode(&m))
+	testutil.Equals(t, 2, m.Count)
+	testutil.Equals(t, 2, m.Labelled)
+	testutil.Equals(t, 0.5, *m.Accuracy)
+
+	testutil.Equals(t, http.StatusNotFound, get("v2").Code)
+}
+
+func TestDriftGetForVersion(t *testing.T) {
+	results := &fakeResults{version: "v1"}
+	for i := 0; i <= drift.MaxSamples; i++ {
+		results.add(int32(i + 1))
+		results.results[i].Input = []byte(`"a"`)
+	}
+	fs := &fakeStore{versions: &fakeVersions{names: []string{"v1"}}, results: results}
+	w := httptest.NewRecorder()
+	NewServer(fs, nil, nil, 0, nil, nil).DriftGetForVersion(w, httptest.NewRequest(http.MethodGet, "/", nil), "foo", "bar", "v1", DriftGetForVersionParams{})
+	testutil.Equals(t, http.StatusOK, w.Code)
+
+	// Windows with more results than can be compared are sampled.
+	var d DriftReport
+	testutil.Ok(t, json.NewDecoder(w.Body).Decode(&d))
+	testutil.Equals(t, drift.MaxSamples, d.CurrentCount)
+	testutil.Equals(t, drift.MaxSamples, d.ReferenceCount)
+}
+
+func TestVersionsPatchForModel(t *testing.T) {
+	versions := &fakeVersions{names: []string{"v1"}}
+	fs := &fakeStore{versions: versions}
+	patch := func(body string) *httptest.ResponseRecorder {
+		w := httptest.NewRecorder()
+		NewServer(fs, nil, nil, 0, nil, nil).VersionsPatchForModel(w, httptest.NewRequest(http.MethodPatch, "/", strings.NewReader(body)), "foo", "bar", "v1", VersionsPatchForModelParams{})
+		return w
+	}
+
+	testutil.Equals(t, http.StatusOK, patch(`{"description": "a"}`).Code)
+	testutil.Equals(t, 0, len(versions.promoted))
+
+	// The stage is promoted together with the update of the version.
+	testutil.Equals(t, http.StatusOK, patch(`{"description": "b", "stage": "production"}`).Code)
+	testutil.Equals(t, []string{"production"}, versions.promoted)
+}
@@ -0,0 +1,88 @@
+package v1alpha1
+
+import (
+	"bufio"
+	"encoding/gob"
+	"errors"
+	"io"
+	"os"
+
+	"github.com/connylabs/model-tracking/store/model-tracking/public/model"
+)
+
+// spoolMemoryResults is the number of results that a spool holds in memory
+// before it writes the rest to a temporary file.
+const spoolMemoryResults = 500
+
+// spool buffers the results of a bulk request, so that they can be created
+// in a transaction without waiting for the client while it sends them.
+// The first results are held in memory and the rest are written to a temporary file,
+// so that the memory used does not grow with the number of results.
+// The zero value is an empty spool. It must be closed to remove the file.
+type spool struct {
+	results []*model.Result
+	file    *os.File
+	w       *bufio.Writer
+	enc     *gob.Encoder
+}
+
+// add appends a result to the spool.
+func (s *spool) add(r *model.Result) error {
+	if len(s.results) < spoolMemoryResults {
+		s.results = append(s.results, r)
+		return nil
+	}
+	if s.file == nil {
+		f, err := os.CreateTemp("", "model-tracking-results-*")
+		if err != nil {
+			return err
+		}
+		s.file = f
+		s.w = bufio.NewWriter(f)
+		s.enc = gob.NewEncoder(s.w)
+	}
+	return s.enc.Encode(r)
+}
+
+// next returns a function that returns the results of the spool in the order
+// in which they were added and io.EOF once all results were returned.
+// No results may be added afterwards.
+func (s *spool) next() (func() (*model.Result, error), error) {
+	var dec *gob.Decoder
+	if s.file != nil {
+		if err := s.w.Flush(); err != nil {
+			return nil, err
+		}
+		if _, err := s.file.Seek(0, io.SeekStart); err != nil {
+			return nil, err
+		}
+		dec = gob.NewDecoder(bufio.NewReader(s.file))
+	}
+	var i int
+	return func() (*model.Result, error) {
+		if i < len(s.results) {
+			i++
+			return s.results[i-1], nil
+		}
+		if dec == nil {
+			return nil, io.EOF
+		}
+		r := new(model.Result)
+		if err := dec.Decode(r); err != nil {
+			if errors.Is(err, io.EOF) {
+				return nil, io.EOF
+			}
+			return nil, err
+		}
+		return r, nil
+	}, nil
+}
+
+// close removes the temporary file of the spool, if any.
+func (s *spool) close() error {
+	if s.file == nil {
+		return nil
+	}
+	s.file.Close() //nolint:errcheck
+	return os.Remove(s.file.Name())
+}
@@ -0,0 +1,43 @@
+package v1alpha1
+
+import (
+	"io"
+	"os"
+	"strconv"
+	"testing"
+
+	"github.com/efficientgo/core/testutil"
+
+	"github.com/connylabs/model-tracking/store/model-tracking/public/model"
+)
+
+func TestSpool(t *testing.T) {
+	for _, n := range []int{0, spoolMemoryResults, 2*spoolMemoryResults + 1} {
+		t.Run(strconv.Itoa(n), func(t *testing.T) {
+			sp := new(spool)
+			for i := 0; i < n; i++ {
+				id := strconv.Itoa(i)
+				testutil.Ok(t, sp.add(&model.Result{Input: []byte(id), Output: []byte(`1`), TrueOutput: []byte(`null`), Labels: "{}", ClientID: &id}))
+			}
+			next, err := sp.next()
+			testutil.Ok(t, err)
+			for i := 0; i < n; i++ {
+				r, err := next()
+				testutil.Ok(t, err)
+				testutil.Equals(t, strconv.Itoa(i), string(r.Input))
+				testutil.Equals(t, strconv.Itoa(i), *r.ClientID)
+				testutil.Equals(t, `null`, string(r.TrueOutput))
+			}
+			_, err = next()
+			testutil.Equals(t, io.EOF, err)
+
+			// Only results that do not fit in memory are written to a file, which is removed on close.
+			testutil.Equals(t, n > spoolMemoryResults, sp.file != nil)
+			testutil.Ok(t, sp.close())
+			if sp.file != nil {
+				_, err := os.Stat(sp.file.Name())
+				testutil.Assert(t, os.IsNotExist(err), "the file should be removed")
+			}
+		})
+	}
+}
@@ -0,0 +1,341 @@
+package v1alpha1
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/go-kit/log/level"
+
+	"github.com/connylabs/model-tracking/evaluation"
+	"github.com/connylabs/model-tracking/store"
+)
+
+// Subscriber subscribes to the events emitted by the store, e.g. a notify.Hub.
+// The channel of a subscription is closed if events were lost.
+type Subscriber interface {
+	Subscribe(organization string) (<-chan store.Event, func())
+}
+
+const (
+	// streamBacklog is the maximum number of results that are sent
+	// to a client resuming a stream with the Last-Event-ID header.
+	// Clients that fell further behind receive the rest when they resume again.
+	streamBacklog = 1000
+	// streamHeartbeat is the interval at which comments are sent
+	// to keep idle streams from being closed by proxies.
+	streamHeartbeat = 15 * time.Second
+	// streamMetricsInterval is the minimum interval between metrics events,
+	// since computing the metrics aggregates all results of the version.
+	streamMetricsInterval = 5 * time.Second
+	// streamRetry is the time after which clients should reconnect when a stream ends.
+	streamRetry = time.Second
+)
+
+func (s *server) ResultsStreamForVersion(w http.ResponseWriter, r *http.Request, organization ParameterOrganization, model ParameterModel, version ParameterVersion, params ResultsStreamForVersionParams) {
+	s.streamResults(w, r, organization, model, version, "", params.LastEventID)
+}
+
+func (s *server) ResultsStreamForModel(w http.ResponseWriter, r *http.Request, organization ParameterOrganization, model ParameterModel, params ResultsStreamForModelParams) {
+	s.streamResults(w, r, organization, model, "", params.Stage, params.LastEventID)
+}
+
+// streamResults streams the results of a version, or of the version that a stage points at
+// if a stage is given, as server-sent events until the stream duration elapses.
+func (s *server) streamResults(w http.ResponseWriter, r *http.Request, organization, modelParam, version, stage string, lastEventID *int) {
+	if s.events == nil {
+		s.httpError(w, "streaming results is not enabled", http.StatusNotImplemented)
+		return
+	}
+	flusher, ok := w.(http.Flusher)
+	if !ok {
+		s.httpError(w, "the response cannot be streamed", http.StatusInternalServerError)
+		return
+	}
+	ctx := r.Context()
+
+	// Subscribe before reading the store, so that no results are missed in between.
+	events, cancel := s.events.Subscribe(organization)
+	defer cancel()
+	subscribed := time.Now()
+
+	if stage != "" {
+		v, err := s.stageVersion(ctx, organization, modelParam, stage)
+		if err != nil {
+			s.httpStoreError(w, err)
+			return
+		}
+		version = v.Name
+	} else if _, err := s.store.Versions(organization, modelParam).Get(ctx, version); err != nil {
+		s.httpStoreError(w, err)
+		return
+	}
+
+	// sent contains the IDs of the results that were sent, since the IDs of batches
+	// created concurrently may interleave, so that a result can be in the ranges of several events.
+	sent := make(map[int32]struct{})
+	var backlog []*Result
+	if lastEventID != nil {
+		rs, err := s.store.Results(organization, modelParam, version).After(ctx, *lastEventID, streamBacklog)
+		if err != nil {
+			s.httpStoreError(w, err)
+			return
+		}
+		for i := range rs {
+			res, err := resultFromModel(rs[i])
+			if err != nil {
+				s.httpError(w, err.Error(), http.StatusInternalServerError)
+				return
+			}
+			backlog = append(backlog, res)
+			sent[rs[i].ID] = struct{}{}
+		}
+	}
+	metrics, release := s.metrics.acquire(metricsKey{organization, modelParam, version})
+	defer func() { release() }()
+	m, err := s.versionMetrics(ctx, metrics, organization, modelParam, version, subscribed)
+	if err != nil {
+		s.httpStoreError(w, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	// Keep reverse proxies like NGINX from buffering the events.
+	w.Header().Set("X-Accel-Buffering", "no")
+	w.WriteHeader(http.StatusOK)
+	ew := &eventWriter{w: w, flusher: flusher}
+	ew.retry(streamRetry)
+	for _, res := range backlog {
+		ew.event(fmt.Sprint(res.ID), "result", res)
+	}
+	ew.event("", "metrics", m)
+	if ew.err != nil {
+		return
+	}
+
+	var deadline <-chan time.Time
+	if s.streamDuration > 0 {
+		t := time.NewTimer(s.streamDuration)
+		defer t.Stop()
+		deadline = t.C
+	}
+	heartbeat := time.NewTicker(streamHeartbeat)
+	defer heartbeat.Stop()
+	throttle := time.NewTicker(streamMetricsInterval)
+	defer throttle.Stop()
+	// changed is the time of the first change that the last metrics event does not reflect, if any.
+	var changed time.Time
+
+	for ew.err == nil {
+		select {
+		case <-ctx.Done():
+			return
+		case <-deadline:
+			return
+		case <-heartbeat.C:
+			ew.comment("heartbeat")
+		case <-throttle.C:
+			if changed.IsZero() {
+				continue
+			}
+			m, err := s.versionMetrics(ctx, metrics, organization, modelParam, version, changed)
+			if err != nil {
+				s.logStreamError(err)
+				return
+			}
+			ew.event("", "metrics", m)
+			changed = time.Time{}
+		case e, ok := <-events:
+			if !ok {
+				// Events were lost, so end the stream and let the client
+				// catch up by resuming it with the Last-Event-ID header.
+				return
+			}
+			// ranges are the ranges of IDs of the batches of results to send.
+			var ranges [][2]int
+			// Handle all events that are already buffered at once.
+			for more := true; more; {
+				if e.Model == modelParam {
+					switch {
+					case e.Type == store.EventResultBatchCreated && e.Version == version:
+						last := e.LastID
+						if last < e.ID {
+							last = e.ID
+						}
+						ranges = append(ranges, [2]int{int(e.ID), int(last)})
+					case e.Type == store.EventStageUpdated && stage != "" && e.Stage == stage && e.Version != version:
+						// The results of the previous version may not have been read yet,
+						// but they no longer belong to the stage.
+						version, ranges = e.Version, nil
+						release()
+						metrics, release = s.metrics.acquire(metricsKey{organization, modelParam, version})
+						if changed.IsZero() {
+							changed = time.Now()
+						}
+					}
+				}
+				select {
+				case e, more = <-events:
+				default:
+					more = false
+				}
+			}
+			for _, r := range ranges {
+				rs, err := s.store.Results(organization, modelParam, version).Range(ctx, r[0], r[1])
+				if err != nil {
+					s.logStreamError(err)
+					return
+				}
+				for i := range rs {
+					if _, ok := sent[rs[i].ID]; ok {
+						continue
+					}
+					res, err := resultFromModel(rs[i])
+					if err != nil {
+						s.logStreamError(err)
+						return
+					}
+					ew.event(fmt.Sprint(res.ID), "result", res)
+					sent[rs[i].ID] = struct{}{}
+					if changed.IsZero() {
+						changed = time.Now()
+					}
+				}
+			}
+		}
+	}
+}
+
+// versionMetrics returns the metrics of all results of a version as of a time after since.
+// The metrics are shared between all streams of the version through the entry.
+func (s *server) versionMetrics(ctx context.Context, e *metricsEntry, organization, modelParam, version string, since time.Time) (Metrics, error) {
+	return e.get(since, func() (Metrics, error) {
+		st, err := s.store.Statistics().Get(ctx, organization, modelParam, version, nil, nil, nil)
+		if err != nil {
+			return Metrics{}, err
+		}
+		return metricsFromSummary(evaluation.Summary{Count: int(st.Results), Labelled: int(st.Labelled), Correct: int(st.Correct)}), nil
+	})
+}
+
+type metricsKey struct {
+	organization, model, version string
+}
+
+// metricsCache shares the metrics of versions between streams, so that the results
+// of a version are aggregated once for all clients that wait for new metrics at the same time
+// rather than once for every client.
+// It holds one entry per version that is being streamed.
+type metricsCache struct {
+	mu      sync.Mutex
+	entries map[metricsKey]*metricsEntry
+}
+
+type metricsEntry struct {
+	// streams is the number of streams that acquired the entry.
+	// It is guarded by the mutex of the cache.
+	streams int
+
+	mu sync.Mutex
+	// computed is the time at which the computation of the metrics started.
+	computed time.Time
+	metrics  Metrics
+}
+
+// acquire returns the entry of a version for a stream.
+// The returned function releases the entry and must be called once;
+// the entry is removed once all streams of the version have released it.
+func (mc *metricsCache) acquire(key metricsKey) (*metricsEntry, func()) {
+	mc.mu.Lock()
+	defer mc.mu.Unlock()
+	if mc.entries == nil {
+		mc.entries = make(map[metricsKey]*metricsEntry)
+	}
+	e, ok := mc.entries[key]
+	if !ok {
+		e = new(metricsEntry)
+		mc.entries[key] = e
+	}
+	e.streams++
+
+	return e, func() {
+		mc.mu.Lock()
+		defer mc.mu.Unlock()
+		if e.streams--; e.streams == 0 {
+			delete(mc.entries, key)
+		}
+	}
+}
+
+// get returns the metrics of the version that were computed after since,
+// computing them only if no other stream has done so.
+func (e *metricsEntry) get(since time.Time, compute func() (Metrics, error)) (Metrics, error) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	if !e.computed.IsZero() && !e.computed.Before(since) {
+		return e.metrics, nil
+	}
+	start := time.Now()
+	m, err := compute()
+	if err != nil {
+		return Metrics{}, err
+	}
+	e.computed, e.metrics = start, m
+	return m, nil
+}
+
+// logStreamError logs an error that ends a stream after its response was started,
+// when it can no longer be returned as a problem.
+func (s *server) logStreamError(err error) {
+	if !errors.Is(err, context.Canceled) {
+		level.Error(s.logger).Log("msg", "failed to stream results", "err", err.Error())
+	}
+}
+
+// eventWriter writes server-sent events and flushes them to the client immediately.
+// After the first error, all writes are skipped.
+type eventWriter struct {
+	w       http.ResponseWriter
+	flusher http.Flusher
+	err     error
+}
+
+func (ew *eventWriter) write(format string, a ...interface{}) {
+	if ew.err != nil {
+		return
+	}
+	if _, ew.err = fmt.Fprintf(ew.w, format, a...); ew.err == nil {
+		ew.flusher.Flush()
+	}
+}
+
+// event writes an event whose data is the JSON encoding of v.
+// Events without an ID leave the ID of the last event unchanged.
+func (ew *eventWriter) event(id, typ string, v interface{}) {
+	// The JSON encoding does not contain newlines, so it fits in a single data field.
+	data, err := json.Marshal(v)
+	if err != nil {
+		ew.err = err
+		return
+	}
+	if id != "" {
+		ew.write("id: %s\nevent: %s\ndata: %s\n\n", id, typ, data)
+		return
+	}
+	ew.write("event: %s\ndata: %s\n\n", typ, data)
+}
+
+// comment writes a comment, which clients ignore.
+func (ew *eventWriter) comment(c string) {
+	ew.write(": %s\n\n", c)
+}
+
+// retry tells clients how long to wait before reconnecting.
+func (ew *eventWriter) retry(d time.Duration) {
+	ew.write("retry: %d\n\n", d.Milliseconds())
+}
@@ -0,0 +1,210 @@
+package v1alpha1
+
+import (
+	"bufio"
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/efficientgo/core/testutil"
+
+	"github.com/connylabs/model-tracking/labels"
+	"github.com/connylabs/model-tracking/store"
+	"github.com/connylabs/model-tracking/store/model-tracking/public/model"
+)
+
+func (fs *fakeStore) Versions(string, string) store.Versions {
+	return fs.versions
+}
+
+func (fs *fakeStore) Results(_, _, version string) store.Results {
+	if version != fs.results.version {
+		return &fakeResults{}
+	}
+	return fs.results
+}
+
+type fakeVersions struct {
+	store.Versions
+	names []string
+	// promoted contains the stages that versions were promoted to when they were updated.
+	promoted []string
+}
+
+func (fv *fakeVersions) Get(_ context.Context, name string) (*model.Version, error) {
+	for _, n := range fv.names {
+		if n == name {
+			return &model.Version{Name: name}, nil
+		}
+	}
+	return nil, &store.Error{Kind: store.ErrNotFound, Message: "version not found"}
+}
+
+type fakeResults struct {
+	store.Results
+	version string
+
+	mu      sync.Mutex
+	results []*model.Result
+}
+
+func (fr *fakeResults) add(id int32) {
+	fr.mu.Lock()
+	defer fr.mu.Unlock()
+	now := time.Now()
+	fr.results = append(fr.results, &model.Result{ID: id, Output: []byte(`1`), TrueOutput: []byte(`1`), Time: now, Created: &now, Updated: &now, Labels: "{}"})
+}
+
+func (fr *fakeResults) find(match func(*model.Result) bool) []*model.Result {
+	fr.mu.Lock()
+	defer fr.mu.Unlock()
+	var rs []*model.Result
+	for _, r := range fr.results {
+		if match(r) {
+			rs = append(rs, r)
+		}
+	}
+	return rs
+}
+
+func (fr *fakeResults) Range(_ context.Context, first, last int) ([]*model.Result, error) {
+	return fr.find(func(r *model.Result) bool { return int(r.ID) >= first && int(r.ID) <= last }), nil
+}
+
+func (fr *fakeResults) After(_ context.Context, id, _ int) ([]*model.Result, error) {
+	return fr.find(func(r *model.Result) bool { return int(r.ID) > id }), nil
+}
+
+func (fr *fakeResults) List(context.Context, labels.Selector) ([]*model.Result, error) {
+	return fr.find(func(*model.Result) bool { return true }), nil
+}
+
+type fakeSubscriber chan store.Event
+
+func (fs fakeSubscriber) Subscribe(string) (<-chan store.Event, func()) {
+	return fs, func() {}
+}
+
+func TestResultsStreamForVersion(t *testing.T) {
+	results := &fakeResults{version: "v1"}
+	results.add(1)
+	results.add(2)
+	fs := &fakeStore{versions: &fakeVersions{names: []string{"v1", "v2"}}, results: results}
+
+	t.Run("not enabled", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		NewServer(fs, nil, nil, 0, nil, nil).ResultsStreamForVersion(w, httptest.NewRequest(http.MethodGet, "/", nil), "foo", "bar", "v1", ResultsStreamForVersionParams{})
+		testutil.Equals(t, http.StatusNotImplemented, w.Code)
+	})
+
+	t.Run("version not found", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		NewServer(fs, make(fakeSubscriber), nil, 0, nil, nil).ResultsStreamForVersion(w, httptest.NewRequest(http.MethodGet, "/", nil), "foo", "bar", "v3", ResultsStreamForVersionParams{})
+		testutil.Equals(t, http.StatusNotFound, w.Code)
+	})
+
+	t.Run("stream", func(t *testing.T) {
+		events := make(fakeSubscriber, 10)
+		s := NewServer(fs, events, nil, time.Minute, nil, nil)
+		srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			s.ResultsStreamForVersion(w, r, "foo", "bar", "v1", ResultsStreamForVersionParams{LastEventID: intPointer(1)})
+		}))
+		defer srv.Close()
+
+		res, err := http.Get(srv.URL)
+		testutil.Ok(t, err)
+		defer res.Body.Close()
+		testutil.Equals(t, http.StatusOK, res.StatusCode)
+		testutil.Equals(t, "text/event-stream", res.Header.Get("Content-Type"))
+
+		r := bufio.NewReader(res.Body)
+		next := func() string {
+			var lines []string
+			for {
+				l, err := r.ReadString('\n')
+				testutil.Ok(t, err)
+				if l == "\n" {
+					return strings.Join(lines, "")
+				}
+				lines = append(lines, l)
+			}
+		}
+		testutil.Equals(t, "retry: 1000\n", next())
+		// Results created since the last event are sent first.
+		testutil.Assert(t, strings.HasPrefix(next(), "id: 2\nevent: result\ndata: {"))
+		testutil.Equals(t, "event: metrics\ndata: {\"accuracy\":1,\"correct\":2,\"count\":2,\"labelled\":2}\n", next())
+
+		results.add(3)
+		// Results that were already sent, of other models and of other versions are skipped.
+		events <- store.Event{Type: store.EventResultBatchCreated, Organization: "foo", Model: "bar", Version: "v1", ID: 2, LastID: 2, Count: 1}
+		events <- store.Event{Type: store.EventResultBatchCreated, Organization: "foo", Model: "baz", Version: "v1", ID: 3, LastID: 3, Count: 1}
+		events <- store.Event{Type: store.EventResultBatchCreated, Organization: "foo", Model: "bar", Version: "v2", ID: 3, LastID: 3, Count: 1}
+		events <- store.Event{Type: store.EventResultBatchCreated, Organization: "foo", Model: "bar", Version: "v1", ID: 3, LastID: 3, Count: 1}
+		testutil.Assert(t, strings.HasPrefix(next(), "id: 3\nevent: result\ndata: {"))
+
+		// All results of a batch are sent, but only once, even if the range of the batch overlaps others.
+		results.add(4)
+		results.add(5)
+		events <- store.Event{Type: store.EventResultBatchCreated, Organization: "foo", Model: "bar", Version: "v1", ID: 3, LastID: 5, Count: 2}
+		testutil.Assert(t, strings.HasPrefix(next(), "id: 4\nevent: result\ndata: {"))
+		testutil.Assert(t, strings.HasPrefix(next(), "id: 5\nevent: result\ndata: {"))
+
+		// The stream ends when events are lost.
+		close(events)
+		_, err = r.ReadString('\n')
+		testutil.NotOk(t, err)
+	})
+}
+
+func TestMetricsCache(t *testing.T) {
+	var mc metricsCache
+	var computed int
+	compute := func() (Metrics, error) {
+		computed++
+		return Metrics{Count: computed}, nil
+	}
+	key := metricsKey{"foo", "bar", "v1"}
+
+	e1, release1 := mc.acquire(key)
+	e2, release2 := mc.acquire(key)
+	before := time.Now()
+	m, err := e1.get(before, compute)
+	testutil.Ok(t, err)
+	testutil.Equals(t, 1, m.Count)
+
+	// Metrics computed after the change are shared.
+	m, err = e2.get(before, compute)
+	testutil.Ok(t, err)
+	testutil.Equals(t, 1, m.Count)
+	testutil.Equals(t, 1, computed)
+
+	// Metrics computed before a change are computed again.
+	m, err = e2.get(time.Now(), compute)
+	testutil.Ok(t, err)
+	testutil.Equals(t, 2, m.Count)
+
+	// Other versions are computed separately.
+	e3, release3 := mc.acquire(metricsKey{"foo", "bar", "v2"})
+	m, err = e3.get(before, compute)
+	testutil.Ok(t, err)
+	testutil.Equals(t, 3, m.Count)
+
+	// Entries are removed once their last stream released them.
+	release3()
+	release1()
+	testutil.Equals(t, 1, len(mc.entries))
+	release2()
+	testutil.Equals(t, 0, len(mc.entries))
+
+	// Acquiring a released version starts a new entry.
+	e4, release4 := mc.acquire(key)
+	defer release4()
+	testutil.Assert(t, e4 != e1)
+	m, err = e4.get(before, compute)
+	testutil.Ok(t, err)
+	testutil.Equals(t, 4, m.Count)
+}
@@ -0,0 +1,306 @@
+package v1alpha1
+
+import (
+	"net/http"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// TracedServerInterface wraps every handler of a ServerInterface in a span
+// named after the operation. The span is a child of the span in the context
+// of the request, so that it nests within the span of the HTTP server,
+// and it is propagated to the wrapped handler and in turn to the store.
+type TracedServerInterface struct {
+	ServerInterface
+	tracer trace.Tracer
+}
+
+// NewTracedServerInterface returns a new ServerInterface that traces the handlers
+// of the given implementation using the given tracer provider.
+func NewTracedServerInterface(impl ServerInterface, tp trace.TracerProvider) *TracedServerInterface {
+	return &TracedServerInterface{impl, tp.Tracer("github.com/connylabs/model-tracking/api/v1alpha1")}
+}
+
+// statusRecorder records the status code written to a response.
+type statusRecorder struct {
+	http.ResponseWriter
+	status int
+}
+
+func (sr *statusRecorder) WriteHeader(code int) {
+	sr.status = code
+	sr.ResponseWriter.WriteHeader(code)
+}
+
+func (sr *statusRecorder) Write(b []byte) (int, error) {
+	if sr.status == 0 {
+		sr.status = http.StatusOK
+	}
+	return sr.ResponseWriter.Write(b)
+}
+
+// Flush implements http.Flusher if the wrapped response writer does.
+func (sr *statusRecorder) Flush() {
+	if f, ok := sr.ResponseWriter.(http.Flusher); ok {
+		f.Flush()
+	}
+}
+
+// start starts a span for the given operation and returns the response writer
+// and request to pass to the handler and a function that ends the span.
+func (t *TracedServerInterface) start(w http.ResponseWriter, r *http.Request, operation string) (http.ResponseWriter, *http.Request, func()) {
+	ctx, span := t.tracer.Start(r.Context(), operation, trace.WithAttributes(attribute.String("operation", operation)))
+	sr := &statusRecorder{ResponseWriter: w}
+	return sr, r.WithContext(ctx), func() {
+		if sr.status == 0 {
+			sr.status = http.StatusOK
+		}
+		span.SetAttributes(attribute.Int("http.status_code", sr.status))
+		if sr.status >= http.StatusInternalServerError {
+			span.SetStatus(codes.Error, http.StatusText(sr.status))
+		}
+		span.End()
+	}
+}
+
+func (t *TracedServerInterface) AlertRulesCreateForModel(w http.ResponseWriter, r *http.Request, parameterOrganization ParameterOrganization, parameterModel ParameterModel) {
+	w, r, end := t.start(w, r, "AlertRulesCreateForModel")
+	defer end()
+	t.ServerInterface.AlertRulesCreateForModel(w, r, parameterOrganization, parameterModel)
+}
+
+func (t *TracedServerInterface) AlertRulesDeleteForModel(w http.ResponseWriter, r *http.Request, parameterOrganization ParameterOrganization, parameterModel ParameterModel, parameterAlertRule ParameterAlertRule, params AlertRulesDeleteForModelParams) {
+	w, r, end := t.start(w, r, "AlertRulesDeleteForModel")
+	defer end()
+	t.ServerInterface.AlertRulesDeleteForModel(w, r, parameterOrganization, parameterModel, parameterAlertRule, params)
+}
+
+func (t *TracedServerInterface) AlertRulesGetForModel(w http.ResponseWriter, r *http.Request, parameterOrganization ParameterOrganization, parameterModel ParameterModel, parameterAlertRule ParameterAlertRule, params AlertRulesGetForModelParams) {
+	w, r, end := t.start(w, r, "AlertRulesGetForModel")
+	defer end()
+	t.ServerInterface.AlertRulesGetForModel(w, r, parameterOrganization, parameterModel, parameterAlertRule, params)
+}
+
+func (t *TracedServerInterface) AlertRulesListForModel(w http.ResponseWriter, r *http.Request, parameterOrganization ParameterOrganization, parameterModel ParameterModel) {
+	w, r, end := t.start(w, r, "AlertRulesListForModel")
+	defer end()
+	t.ServerInterface.AlertRulesListForModel(w, r, parameterOrganization, parameterModel)
+}
+
+func (t *TracedServerInterface) ComparisonGetForModel(w http.ResponseWriter, r *http.Request, parameterOrganization ParameterOrganization, parameterModel ParameterModel, params ComparisonGetForModelParams) {
+	w, r, end := t.start(w, r, "ComparisonGetForModel")
+	defer end()
+	t.ServerInterface.ComparisonGetForModel(w, r, parameterOrganization, parameterModel, params)
+}
+
+func (t *TracedServerInterface) DriftGetForVersion(w http.ResponseWriter, r *http.Request, parameterOrganization ParameterOrganization, parameterModel ParameterModel, parameterVersion ParameterVersion, params DriftGetForVersionParams) {
+	w, r, end := t.start(w, r, "DriftGetForVersion")
+	defer end()
+	t.ServerInterface.DriftGetForVersion(w, r, parameterOrganization, parameterModel, parameterVersion, params)
+}
+
+func (t *TracedServerInterface) MetricsGetForModel(w http.ResponseWriter, r *http.Request, parameterOrganization ParameterOrganization, parameterModel ParameterModel, params MetricsGetForModelParams) {
+	w, r, end := t.start(w, r, "MetricsGetForModel")
+	defer end()
+	t.ServerInterface.MetricsGetForModel(w, r, parameterOrganization, parameterModel, params)
+}
+
+func (t *TracedServerInterface) MetricsGetForVersion(w http.ResponseWriter, r *http.Request, parameterOrganization ParameterOrganization, parameterModel ParameterModel, parameterVersion ParameterVersion, params MetricsGetForVersionParams) {
+	w, r, end := t.start(w, r, "MetricsGetForVersion")
+	defer end()
+	t.ServerInterface.MetricsGetForVersion(w, r, parameterOrganization, parameterModel, parameterVersion, params)
+}
+
+func (t *TracedServerInterface) ModelsCreateForOrganization(w http.ResponseWriter, r *http.Request, parameterOrganization ParameterOrganization) {
+	w, r, end := t.start(w, r, "ModelsCreateForOrganization")
+	defer end()
+	t.ServerInterface.ModelsCreateForOrganization(w, r, parameterOrganization)
+}
+
+func (t *TracedServerInterface) ModelsGetForOrganization(w http.ResponseWriter, r *http.Request, parameterOrganization ParameterOrganization, parameterModel ParameterModel, params ModelsGetForOrganizationParams) {
+	w, r, end := t.start(w, r, "ModelsGetForOrganization")
+	defer end()
+	t.ServerInterface.ModelsGetForOrganization(w, r, parameterOrganization, parameterModel, params)
+}
+
+func (t *TracedServerInterface) ModelsListForOrganization(w http.ResponseWriter, r *http.Request, parameterOrganization ParameterOrganization) {
+	w, r, end := t.start(w, r, "ModelsListForOrganization")
+	defer end()
+	t.ServerInterface.ModelsListForOrganization(w, r, parameterOrganization)
+}
+
+func (t *TracedServerInterface) ModelsPatchForOrganization(w http.ResponseWriter, r *http.Request, parameterOrganization ParameterOrganization, parameterModel ParameterModel, params ModelsPatchForOrganizationParams) {
+	w, r, end := t.start(w, r, "ModelsPatchForOrganization")
+	defer end()
+	t.ServerInterface.ModelsPatchForOrganization(w, r, parameterOrganization, parameterModel, params)
+}
+
+func (t *TracedServerInterface) ModelsUpdateForOrganization(w http.ResponseWriter, r *http.Request, parameterOrganization ParameterOrganization, parameterModel ParameterModel, params ModelsUpdateForOrganizationParams) {
+	w, r, end := t.start(w, r, "ModelsUpdateForOrganization")
+	defer end()
+	t.ServerInterface.ModelsUpdateForOrganization(w, r, parameterOrganization, parameterModel, params)
+}
+
+func (t *TracedServerInterface) OrganizationsCreate(w http.ResponseWriter, r *http.Request) {
+	w, r, end := t.start(w, r, "OrganizationsCreate")
+	defer end()
+	t.ServerInterface.OrganizationsCreate(w, r)
+}
+
+func (t *TracedServerInterface) ResultsCreateBulkForVersion(w http.ResponseWriter, r *http.Request, parameterOrganization ParameterOrganization, parameterModel ParameterModel, parameterVersion ParameterVersion, params ResultsCreateBulkForVersionParams) {
+	w, r, end := t.start(w, r, "ResultsCreateBulkForVersion")
+	defer end()
+	t.ServerInterface.ResultsCreateBulkForVersion(w, r, parameterOrganization, parameterModel, parameterVersion, params)
+}
+
+func (t *TracedServerInterface) ResultsCreateForModel(w http.ResponseWriter, r *http.Request, parameterOrganization ParameterOrganization, parameterModel ParameterModel, params ResultsCreateForModelParams) {
+	w, r, end := t.start(w, r, "ResultsCreateForModel")
+	defer end()
+	t.ServerInterface.ResultsCreateForModel(w, r, parameterOrganization, parameterModel, params)
+}
+
+func (t *TracedServerInterface) ResultsCreateForVersion(w http.ResponseWriter, r *http.Request, parameterOrganization ParameterOrganization, parameterModel ParameterModel, parameterVersion ParameterVersion, params ResultsCreateForVersionParams) {
+	w, r, end := t.start(w, r, "ResultsCreateForVersion")
+	defer end()
+	t.ServerInterface.ResultsCreateForVersion(w, r, parameterOrganization, parameterModel, parameterVersion, params)
+}
+
+func (t *TracedServerInterface) ResultsGetForVersion(w http.ResponseWriter, r *http.Request, parameterOrganization ParameterOrganization, parameterModel ParameterModel, parameterVersion ParameterVersion, parameterResult ParameterResult) {
+	w, r, end := t.start(w, r, "ResultsGetForVersion")
+	defer end()
+	t.ServerInterface.ResultsGetForVersion(w, r, parameterOrganization, parameterModel, parameterVersion, parameterResult)
+}
+
+func (t *TracedServerInterface) ResultsListForModel(w http.ResponseWriter, r *http.Request, parameterOrganization ParameterOrganization, parameterModel ParameterModel, params ResultsListForModelParams) {
+	w, r, end := t.start(w, r, "ResultsListForModel")
+	defer end()
+	t.ServerInterface.ResultsListForModel(w, r, parameterOrganization, parameterModel, params)
+}
+
+func (t *TracedServerInterface) ResultsListForVersion(w http.ResponseWriter, r *http.Request, parameterOrganization ParameterOrganization, parameterModel ParameterModel, parameterVersion ParameterVersion, params ResultsListForVersionParams) {
+	w, r, end := t.start(w, r, "ResultsListForVersion")
+	defer end()
+	t.ServerInterface.ResultsListForVersion(w, r, parameterOrganization, parameterModel, parameterVersion, params)
+}
+
+func (t *TracedServerInterface) ResultsStreamForModel(w http.ResponseWriter, r *http.Request, parameterOrganization ParameterOrganization, parameterModel ParameterModel, params ResultsStreamForModelParams) {
+	w, r, end := t.start(w, r, "ResultsStreamForModel")
+	defer end()
+	t.ServerInterface.ResultsStreamForModel(w, r, parameterOrganization, parameterModel, params)
+}
+
+func (t *TracedServerInterface) ResultsStreamForVersion(w http.ResponseWriter, r *http.Request, parameterOrganization ParameterOrganization, parameterModel ParameterModel, parameterVersion ParameterVersion, params ResultsStreamForVersionParams) {
+	w, r, end := t.start(w, r, "ResultsStreamForVersion")
+	defer end()
+	t.ServerInterface.ResultsStreamForVersion(w, r, parameterOrganization, parameterModel, parameterVersion, params)
+}
+
+func (t *TracedServerInterface) SchemasCreateForOrganization(w http.ResponseWriter, r *http.Request, parameterOrganization ParameterOrganization) {
+	w, r, end := t.start(w, r, "SchemasCreateForOrganization")
+	defer end()
+	t.ServerInterface.SchemasCreateForOrganization(w, r, parameterOrganization)
+}
+
+func (t *TracedServerInterface) SchemasGetForOrganization(w http.ResponseWriter, r *http.Request, parameterOrganization ParameterOrganization, parameterSchema ParameterSchema, params SchemasGetForOrganizationParams) {
+	w, r, end := t.start(w, r, "SchemasGetForOrganization")
+	defer end()
+	t.ServerInterface.SchemasGetForOrganization(w, r, parameterOrganization, parameterSchema, params)
+}
+
+func (t *TracedServerInterface) SchemasListForOrganization(w http.ResponseWriter, r *http.Request, parameterOrganization ParameterOrganization) {
+	w, r, end := t.start(w, r, "SchemasListForOrganization")
+	defer end()
+	t.ServerInterface.SchemasListForOrganization(w, r, parameterOrganization)
+}
+
+func (t *TracedServerInterface) StagesGetForModel(w http.ResponseWriter, r *http.Request, parameterOrganization ParameterOrganization, parameterModel ParameterModel, parameterStage ParameterStage, params StagesGetForModelParams) {
+	w, r, end := t.start(w, r, "StagesGetForModel")
+	defer end()
+	t.ServerInterface.StagesGetForModel(w, r, parameterOrganization, parameterModel, parameterStage, params)
+}
+
+func (t *TracedServerInterface) StagesHistoryForModel(w http.ResponseWriter, r *http.Request, parameterOrganization ParameterOrganization, parameterModel ParameterModel, parameterStage ParameterStage) {
+	w, r, end := t.start(w, r, "StagesHistoryForModel")
+	defer end()
+	t.ServerInterface.StagesHistoryForModel(w, r, parameterOrganization, parameterModel, parameterStage)
+}
+
+func (t *TracedServerInterface) StagesListForModel(w http.ResponseWriter, r *http.Request, parameterOrganization ParameterOrganization, parameterModel ParameterModel) {
+	w, r, end := t.start(w, r, "StagesListForModel")
+	defer end()
+	t.ServerInterface.StagesListForModel(w, r, parameterOrganization, parameterModel)
+}
+
+func (t *TracedServerInterface) StagesPromoteForModel(w http.ResponseWriter, r *http.Request, parameterOrganization ParameterOrganization, parameterModel ParameterModel, parameterStage ParameterStage) {
+	w, r, end := t.start(w, r, "StagesPromoteForModel")
+	defer end()
+	t.ServerInterface.StagesPromoteForModel(w, r, parameterOrganization, parameterModel, parameterStage)
+}
+
+func (t *TracedServerInterface) StagesRollbackForModel(w http.ResponseWriter, r *http.Request, parameterOrganization ParameterOrganization, parameterModel ParameterModel, parameterStage ParameterStage) {
+	w, r, end := t.start(w, r, "StagesRollbackForModel")
+	defer end()
+	t.ServerInterface.StagesRollbackForModel(w, r, parameterOrganization, parameterModel, parameterStage)
+}
+
+func (t *TracedServerInterface) VersionsCreateForModel(w http.ResponseWriter, r *http.Request, parameterOrganization ParameterOrganization, parameterModel ParameterModel) {
+	w, r, end := t.start(w, r, "VersionsCreateForModel")
+	defer end()
+	t.ServerInterface.VersionsCreateForModel(w, r, parameterOrganization, parameterModel)
+}
+
+func (t *TracedServerInterface) VersionsGetForModel(w http.ResponseWriter, r *http.Request, parameterOrganization ParameterOrganization, parameterModel ParameterModel, parameterVersion ParameterVersion, params VersionsGetForModelParams) {
+	w, r, end := t.start(w, r, "VersionsGetForModel")
+	defer end()
+	t.ServerInterface.VersionsGetForModel(w, r, parameterOrganization, parameterModel, parameterVersion, params)
+}
+
+func (t *TracedServerInterface) VersionsListForModel(w http.ResponseWriter, r *http.Request, parameterOrganization ParameterOrganization, parameterModel ParameterModel, params VersionsListForModelParams) {
+	w, r, end := t.start(w, r, "VersionsListForModel")
+	defer end()
+	t.ServerInterface.VersionsListForModel(w, r, parameterOrganization, parameterModel, params)
+}
+
+func (t *TracedServerInterface) VersionsPatchForModel(w http.ResponseWriter, r *http.Request, parameterOrganization ParameterOrganization, parameterModel ParameterModel, parameterVersion ParameterVersion, params VersionsPatchForModelParams) {
+	w, r, end := t.start(w, r, "VersionsPatchForModel")
+	defer end()
+	t.ServerInterface.VersionsPatchForModel(w, r, parameterOrganization, parameterModel, parameterVersion, params)
+}
+
+func (t *TracedServerInterface) VersionsUpdateForModel(w http.ResponseWriter, r *http.Request, parameterOrganization ParameterOrganization, parameterModel ParameterModel, parameterVersion ParameterVersion, params VersionsUpdateForModelParams) {
+	w, r, end := t.start(w, r, "VersionsUpdateForModel")
+	defer end()
+	t.ServerInterface.VersionsUpdateForModel(w, r, parameterOrganization, parameterModel, parameterVersion, params)
+}
+
+func (t *TracedServerInterface) WebhooksCreateForOrganization(w http.ResponseWriter, r *http.Request, parameterOrganization ParameterOrganization) {
+	w, r, end := t.start(w, r, "WebhooksCreateForOrganization")
+	defer end()
+	t.ServerInterface.WebhooksCreateForOrganization(w, r, parameterOrganization)
+}
+
+func (t *TracedServerInterface) WebhooksDeleteForOrganization(w http.ResponseWriter, r *http.Request, parameterOrganization ParameterOrganization, parameterWebhook ParameterWebhook, params WebhooksDeleteForOrganizationParams) {
+	w, r, end := t.start(w, r, "WebhooksDeleteForOrganization")
+	defer end()
+	t.ServerInterface.WebhooksDeleteForOrganization(w, r, parameterOrganization, parameterWebhook, params)
+}
+
+func (t *TracedServerInterface) WebhooksDeliveriesForOrganization(w http.ResponseWriter, r *http.Request, parameterOrganization ParameterOrganization, parameterWebhook ParameterWebhook, params WebhooksDeliveriesForOrganizationParams) {
+	w, r, end := t.start(w, r, "WebhooksDeliveriesForOrganization")
+	defer end()
+	t.ServerInterface.WebhooksDeliveriesForOrganization(w, r, parameterOrganization, parameterWebhook, params)
+}
+
+func (t *TracedServerInterface) WebhooksGetForOrganization(w http.ResponseWriter, r *http.Request, parameterOrganization ParameterOrganization, parameterWebhook ParameterWebhook, params WebhooksGetForOrganizationParams) {
+	w, r, end := t.start(w, r, "WebhooksGetForOrganization")
+	defer end()
+	t.ServerInterface.WebhooksGetForOrganization(w, r, parameterOrganization, parameterWebhook, params)
+}
+
+func (t *TracedServerInterface) WebhooksListForOrganization(w http.ResponseWriter, r *http.Request, parameterOrganization ParameterOrganization) {
+	w, r, end := t.start(w, r, "WebhooksListForOrganization")
+	defer end()
+	t.ServerInterface.WebhooksListForOrganization(w, r, parameterOrganization)
+}
@@ -22,19 +22,184 @@ import (
 	"github.com/go-chi/chi/v5"
 )
 
-// Error An error response.
-type Error struct {
-	Code  int    `json:"code"`
-	Error string `json:"error"`
+// Defines values for FieldDriftKind.
+const (
+	Categorical FieldDriftKind = "categorical"
+	Numeric     FieldDriftKind = "numeric"
+)
+
+// AlertMetric The metric an alert rule is defined on; one of `accuracy`, `psi`, `kl` or `ks`. `accuracy` is the accuracy of the labelled results; `psi`, `kl` and `ks` measure the drift of the fields of the inputs and outputs.
+type AlertMetric = string
+
+// AlertOperator The operator used to compare the value of the metric with the threshold; one of `<`, `<=`, `>` or `>=`.
+type AlertOperator = string
+
+// AlertRule An alert rule fires when a metric of the version of a model that a deployment stage points at violates a threshold.
+type AlertRule struct {
+	// ActiveSince The time the alert started firing.
+	ActiveSince *time.Time `json:"activeSince,omitempty"`
+	Created     time.Time  `json:"created"`
+
+	// Field The field whose drift is evaluated.
+	Field *string `json:"field,omitempty"`
+
+	// Firing Whether the alert was firing as of the most recent evaluation.
+	Firing bool `json:"firing"`
+	ID     int  `json:"id"`
+
+	// Metric The metric an alert rule is defined on; one of `accuracy`, `psi`, `kl` or `ks`. `accuracy` is the accuracy of the labelled results; `psi`, `kl` and `ks` measure the drift of the fields of the inputs and outputs.
+	Metric AlertMetric `json:"metric"`
+
+	// Model ID of the model.
+	Model int `json:"model"`
+
+	// Name Name of the alert rule.
+	Name string `json:"name"`
+
+	// Operator The operator used to compare the value of the metric with the threshold; one of `<`, `<=`, `>` or `>=`.
+	Operator AlertOperator `json:"operator"`
+
+	// Organization ID of the organization.
+	Organization int `json:"organization"`
+
+	// Stage Name of the deployment stage whose version is evaluated.
+	Stage string `json:"stage"`
+
+	// Threshold The value the metric is compared with.
+	Threshold float64   `json:"threshold"`
+	Updated   time.Time `json:"updated"`
+
+	// Value The value of the metric as of the most recent evaluation.
+	Value *float64 `json:"value,omitempty"`
+
+	// Window The length of the window of results that is evaluated.
+	Window string `json:"window"`
+}
+
+// BulkResults The outcome of creating results in bulk.
+type BulkResults struct {
+	// Count The number of created results.
+	Count int `json:"count"`
+
+	// Skipped The number of results that were not created because a result with the same client ID already exists.
+	Skipped int `json:"skipped"`
+}
+
+// Comparison A head-to-head comparison of a challenger version with a champion version based on results paired by their correlation ID.
+type Comparison struct {
+	// AccuracyDelta The challenger's accuracy minus the champion's accuracy on the paired results. Omitted if either accuracy is unknown.
+	AccuracyDelta *float64 `json:"accuracyDelta,omitempty"`
+
+	// Agreement The fraction of pairs for which both versions produced the same output. Omitted if there are no pairs.
+	Agreement *float64 `json:"agreement,omitempty"`
+
+	// Challenger Name of the challenger version.
+	Challenger string `json:"challenger"`
+
+	// ChallengerMetrics Quality metrics computed from a set of results. A result is labelled if its true output is not null and correct if its output equals its true output.
+	ChallengerMetrics Metrics `json:"challengerMetrics"`
+
+	// ChallengerWins The number of labelled pairs for which only the challenger was correct.
+	ChallengerWins int `json:"challengerWins"`
+
+	// Champion Name of the champion version.
+	Champion string `json:"champion"`
+
+	// ChampionMetrics Quality metrics computed from a set of results. A result is labelled if its true output is not null and correct if its output equals its true output.
+	ChampionMetrics Metrics `json:"championMetrics"`
+
+	// ChampionWins The number of labelled pairs for which only the champion was correct.
+	ChampionWins int `json:"championWins"`
+
+	// Pairs The number of paired results.
+	Pairs int `json:"pairs"`
+
+	// WinRate The fraction of pairs won by either version that were won by the challenger. Omitted if no pair was won.
+	WinRate *float64 `json:"winRate,omitempty"`
+}
+
+// DriftReport A comparison of the distributions of the fields of the inputs and outputs of the results of a version with those of a reference.
+type DriftReport struct {
+	// CurrentCount The number of results of the current window that were compared.
+	CurrentCount int          `json:"currentCount"`
+	Fields       []FieldDrift `json:"fields"`
+
+	// Reference Name of the reference version.
+	Reference string `json:"reference"`
+
+	// ReferenceCount The number of results of the reference window that were compared.
+	ReferenceCount int `json:"referenceCount"`
+
+	// Version Name of the version.
+	Version string `json:"version"`
+}
+
+// EventType The type of an event; one of `model.created`, `model.updated`, `schema.created`, `version.created`, `version.updated`, `result.batch_created` or `stage.updated`. A `result.batch_created` event is emitted once for all results created by a request, with the number of results in `count` and the lowest and highest of their IDs in `id` and `lastId`.
+type EventType = string
+
+// FieldDrift The drift of a single field. Fields of objects and elements of arrays are separated by dots, e.g. `input.features.0`.
+type FieldDrift struct {
+	// CurrentCount The number of values of the field in the current window.
+	CurrentCount int `json:"currentCount"`
+
+	// Kind How the values of the field are compared.
+	Kind FieldDriftKind `json:"kind"`
+
+	// Kl The Kullback-Leibler divergence of the current distribution from the reference distribution.
+	KL float64 `json:"kl"`
+
+	// KsPValue The asymptotic p-value of the two-sample Kolmogorov-Smirnov test. Only set for numeric fields.
+	KSPValue *float64 `json:"ksPValue,omitempty"`
+
+	// KsStatistic The statistic of the two-sample Kolmogorov-Smirnov test. Only set for numeric fields.
+	KSStatistic *float64 `json:"ksStatistic,omitempty"`
+
+	// Name The path of the field, prefixed with `input` or `output`.
+	Name string `json:"name"`
+
+	// Psi The population stability index of the current distribution with respect to the reference distribution.
+	PSI float64 `json:"psi"`
+
+	// ReferenceCount The number of values of the field in the reference window.
+	ReferenceCount int `json:"referenceCount"`
+}
+
+// FieldDriftKind How the values of the field are compared.
+type FieldDriftKind string
+
+// Labels Free-form key-value pairs used to filter resources with label selectors.
+type Labels map[string]string
+
+// Metrics Quality metrics computed from a set of results. A result is labelled if its true output is not null and correct if its output equals its true output.
+type Metrics struct {
+	// Accuracy The fraction of labelled results that are correct. Omitted if there are no labelled results.
+	Accuracy *float64 `json:"accuracy,omitempty"`
+
+	// Correct The number of labelled results whose output equals the true output.
+	Correct int `json:"correct"`
+
+	// Count The number of results.
+	Count int `json:"count"`
+
+	// Labelled The number of results with a known true output.
+	Labelled int `json:"labelled"`
 }
 
 // Model A model represents a machine learning service fullfilling requests.
 type Model struct {
-	Created time.Time `json:"created"`
+	// Archived Whether the model is archived. Archived models accept no new results.
+	Archived bool      `json:"archived"`
+	Created  time.Time `json:"created"`
 
 	// DefaultSchema ID of the schema to use for implicitly created model versions.
 	DefaultSchema *int `json:"defaultSchema,omitempty"`
-	ID            int  `json:"id"`
+
+	// Description A human-readable description of the model.
+	Description *string `json:"description,omitempty"`
+	ID          int     `json:"id"`
+
+	// Labels Free-form key-value pairs used to filter resources with label selectors.
+	Labels Labels `json:"labels"`
 
 	// Name Name of the model.
 	Name string `json:"name"`
@@ -44,6 +209,45 @@ type Model struct {
 	Updated      time.Time `json:"updated"`
 }
 
+// ModelPatch The fields of a model that can be changed with a JSON merge patch. Fields and labels that are null are reset.
+type ModelPatch struct {
+	// Archived Whether the model is archived. Archived models accept no new results.
+	Archived *bool `json:"archived,omitempty"`
+
+	// DefaultSchema ID of the schema to use for implicitly created model versions.
+	DefaultSchema *int `json:"defaultSchema,omitempty"`
+
+	// Description A human-readable description of the model.
+	Description *string `json:"description,omitempty"`
+
+	// Labels Free-form key-value pairs used to filter resources with label selectors.
+	Labels *Labels `json:"labels,omitempty"`
+}
+
+// NewResult A result to create.
+type NewResult struct {
+	// ClientId A unique identifier of the result chosen by the client, e.g. a UUID. If a result with the same client ID already exists for the version, no new result is created, so that submissions can be retried safely.
+	ClientID *string `json:"clientId,omitempty"`
+
+	// CorrelationId An identifier of the request that produced this result. Results of different versions with the same correlation ID are paired when comparing versions.
+	CorrelationID *string `json:"correlationId,omitempty"`
+
+	// Input The input given to the model to produce this result.
+	Input json.RawMessage `json:"input"`
+
+	// Labels Free-form key-value pairs used to filter resources with label selectors.
+	Labels *Labels `json:"labels,omitempty"`
+
+	// Output The output produced by the model for the given input.
+	Output json.RawMessage `json:"output"`
+
+	// Time The timestamp of when the result was produced. Defaults to the time that the model-tracking server receives the request.
+	Time *time.Time `json:"time,omitempty"`
+
+	// TrueOutput The correct output that should be produced for the given input, or null if it is not known yet. Unless it is null, it must match the output schema of the version.
+	TrueOutput json.RawMessage `json:"trueOutput"`
+}
+
 // Organization An organization is a namespace holding models and their schemas.
 type Organization struct {
 	Created time.Time `json:"created"`
@@ -54,14 +258,40 @@ type Organization struct {
 	Updated time.Time `json:"updated"`
 }
 
+// Problem An error response in the format of RFC 7807.
+type Problem struct {
+	// Code A stable, machine-readable code of the problem; one of `malformed_request`, `unauthenticated`, `forbidden`, `not_found`, `conflict`, `precondition_failed`, `payload_too_large`, `invalid`, `rate_limited`, `quota_exceeded`, `unavailable` or `internal`.
+	Code string `json:"code"`
+
+	// Detail An explanation specific to this occurrence of the problem.
+	Detail string `json:"detail"`
+
+	// Status The HTTP status code of the response.
+	Status int `json:"status"`
+
+	// Title A short summary of the type of the problem.
+	Title string `json:"title"`
+
+	// Type A URI reference that identifies the type of the problem.
+	Type string `json:"type"`
+}
+
 // Result A result represents the output produce by a particular version of a machine learning service fullfilling requests.
 type Result struct {
-	Created time.Time `json:"created"`
-	ID      int       `json:"id"`
+	// ClientId The unique identifier of this result chosen by the client.
+	ClientID *string `json:"clientId,omitempty"`
+
+	// CorrelationId An identifier of the request that produced this result.
+	CorrelationID *string   `json:"correlationId,omitempty"`
+	Created       time.Time `json:"created"`
+	ID            int       `json:"id"`
 
 	// Input The input given to the model to produce this result.
 	Input json.RawMessage `json:"input"`
 
+	// Labels Free-form key-value pairs used to filter resources with label selectors.
+	Labels Labels `json:"labels"`
+
 	// Model ID of the model.
 	Model int `json:"model"`
 
@@ -101,10 +331,65 @@ type Schema struct {
 	Updated time.Time       `json:"updated"`
 }
 
+// Stage A stage represents a deployment of a model, e.g. staging, canary or production, that points at a version of the model.
+type Stage struct {
+	Created time.Time `json:"created"`
+	ID      int       `json:"id"`
+
+	// Model ID of the model.
+	Model int `json:"model"`
+
+	// Name Name of the stage.
+	Name string `json:"name"`
+
+	// Organization ID of the model's organization.
+	Organization int       `json:"organization"`
+	Updated      time.Time `json:"updated"`
+
+	// Version ID of the version that the stage points at.
+	Version int `json:"version"`
+}
+
+// StageHistoryEntry A stage history entry records a change of the version that a stage points at.
+type StageHistoryEntry struct {
+	// Created The timestamp of the change.
+	Created time.Time `json:"created"`
+	ID      int       `json:"id"`
+
+	// PreviousVersion ID of the version that the stage pointed at before the change.
+	PreviousVersion *int `json:"previousVersion,omitempty"`
+
+	// Stage ID of the stage.
+	Stage int `json:"stage"`
+
+	// Version ID of the version that the stage pointed at after the change.
+	Version int `json:"version"`
+}
+
 // Version A version represents a version of a machine learning service fullfilling requests.
 type Version struct {
+	// Archived Whether the version is archived. Archived versions accept no new results.
+	Archived bool `json:"archived"`
+
+	// ArtifactUri The URI of the artifact that implements this version of the model.
+	ArtifactURI *string `json:"artifactUri,omitempty"`
+
+	// Commit The commit SHA of the source code that produced this version of the model.
+	Commit  *string   `json:"commit,omitempty"`
 	Created time.Time `json:"created"`
-	ID      int       `json:"id"`
+
+	// Dataset A reference to the dataset on which this version of the model was trained.
+	Dataset *string `json:"dataset,omitempty"`
+
+	// Description A human-readable description of the version.
+	Description *string `json:"description,omitempty"`
+
+	// Hyperparameters The hyperparameters with which this version of the model was trained.
+	Hyperparameters *json.RawMessage `json:"hyperparameters,omitempty"`
+	ID              int              `json:"id"`
+
+	// Labels Free-form key-value pairs used to filter resources with label selectors.
+	Labels Labels `json:"labels"`
 
 	// Model ID of the model.
 	Model int `json:"model"`
@@ -120,6 +405,100 @@ type Version struct {
 	Updated time.Time `json:"updated"`
 }
 
+// VersionPatch The fields of a version that can be changed with a JSON merge patch. Fields and labels that are null are reset.
+type VersionPatch struct {
+	// Archived Whether the version is archived. Archived versions accept no new results.
+	Archived *bool `json:"archived,omitempty"`
+
+	// ArtifactUri The URI of the artifact that implements this version of the model.
+	ArtifactURI *string `json:"artifactUri,omitempty"`
+
+	// Commit The commit SHA of the source code that produced this version of the model.
+	Commit *string `json:"commit,omitempty"`
+
+	// Dataset A reference to the dataset on which this version of the model was trained.
+	Dataset *string `json:"dataset,omitempty"`
+
+	// Description A human-readable description of the version.
+	Description *string `json:"description,omitempty"`
+
+	// Hyperparameters The hyperparameters with which this version of the model was trained.
+	Hyperparameters *json.RawMessage `json:"hyperparameters,omitempty"`
+
+	// Labels Free-form key-value pairs used to filter resources with label selectors.
+	Labels *Labels `json:"labels,omitempty"`
+
+	// Stage The name of a deployment stage of the model, e.g. `production`, to point at the version. The stage is created if it does not exist.
+	Stage *string `json:"stage,omitempty"`
+}
+
+// Webhook A webhook receives events when resources of an organization are created or updated.
+type Webhook struct {
+	Created time.Time `json:"created"`
+
+	// Events The types of events that are delivered. If empty, events of all types are delivered.
+	Events []EventType `json:"events"`
+	ID     int         `json:"id"`
+
+	// Name Name of the webhook.
+	Name string `json:"name"`
+
+	// Organization ID of the organization.
+	Organization int       `json:"organization"`
+	Updated      time.Time `json:"updated"`
+
+	// Url The URL to which events are posted.
+	URL string `json:"url"`
+}
+
+// WebhookDelivery A delivery of an event to a webhook.
+type WebhookDelivery struct {
+	// Attempts The number of attempts made to deliver the event.
+	Attempts int       `json:"attempts"`
+	Created  time.Time `json:"created"`
+
+	// Error The error of the most recent attempt, if it failed.
+	Error *string `json:"error,omitempty"`
+
+	// Event The type of an event; one of `model.created`, `model.updated`, `schema.created`, `version.created`, `version.updated`, `result.batch_created` or `stage.updated`. A `result.batch_created` event is emitted once for all results created by a request, with the number of results in `count` and the lowest and highest of their IDs in `id` and `lastId`.
+	Event EventType `json:"event"`
+	ID    int       `json:"id"`
+
+	// NextAttempt The time of the next attempt of a pending delivery.
+	NextAttempt time.Time `json:"nextAttempt"`
+
+	// Payload The delivered event.
+	Payload json.RawMessage `json:"payload"`
+
+	// ResponseStatus The HTTP status code returned by the webhook in the most recent attempt.
+	ResponseStatus *int `json:"responseStatus,omitempty"`
+
+	// Status The status of the delivery; one of `pending`, `succeeded` or `failed`.
+	Status  string    `json:"status"`
+	Updated time.Time `json:"updated"`
+
+	// Webhook ID of the webhook.
+	Webhook int `json:"webhook"`
+}
+
+// ParameterAlertRule defines model for AlertRule.
+type ParameterAlertRule = string
+
+// IdempotencyKey defines model for IdempotencyKey.
+type IdempotencyKey = string
+
+// IfMatch defines model for IfMatch.
+type IfMatch = string
+
+// IfNoneMatch defines model for IfNoneMatch.
+type IfNoneMatch = string
+
+// LabelSelector defines model for LabelSelector.
+type LabelSelector = string
+
+// LastEventID defines model for LastEventID.
+type LastEventID = int
+
 // ParameterModel defines model for Model.
 type ParameterModel = string
 
@@ -132,11 +511,20 @@ type ParameterResult = int
 // ParameterSchema defines model for Schema.
 type ParameterSchema = string
 
+// ParameterStage defines model for Stage.
+type ParameterStage = string
+
+// StageQuery defines model for StageQuery.
+type StageQuery = string
+
 // ParameterVersion defines model for Version.
 type ParameterVersion = string
 
-// ErrorResponse An error response.
-type ErrorResponse = Error
+// ParameterWebhook defines model for Webhook.
+type ParameterWebhook = string
+
+// ResultCreate A result to create.
+type ResultCreate = NewResult
 
 // OrganizationsCreateJSONBody defines parameters for OrganizationsCreate.
 type OrganizationsCreateJSONBody struct {
@@ -149,18 +537,162 @@ type ModelsCreateForOrganizationJSONBody struct {
 	// DefaultSchema ID of the schema to use for implicitly created model versions.
 	DefaultSchema *int `json:"defaultSchema,omitempty"`
 
+	// Description A human-readable description of the model.
+	Description *string `json:"description,omitempty"`
+
+	// Labels Free-form key-value pairs used to filter resources with label selectors.
+	Labels *Labels `json:"labels,omitempty"`
+
 	// Name The name of the model.
 	Name string `json:"name"`
 }
 
+// ModelsGetForOrganizationParams defines parameters for ModelsGetForOrganization.
+type ModelsGetForOrganizationParams struct {
+	// IfNoneMatch Entity tags of the resource, as returned in the ETag header. If the current entity tag of the resource is one of them, a 304 response without a body is returned instead of the resource.
+	IfNoneMatch *IfNoneMatch `json:"If-None-Match,omitempty"`
+}
+
+// ModelsPatchForOrganizationParams defines parameters for ModelsPatchForOrganization.
+type ModelsPatchForOrganizationParams struct {
+	// IfMatch Entity tags of the resource, as returned in the ETag header. The request only succeeds if the resource was not changed since, i.e. its current entity tag is one of them; otherwise it fails with a 412 response.
+	IfMatch *IfMatch `json:"If-Match,omitempty"`
+}
+
 // ModelsUpdateForOrganizationJSONBody defines parameters for ModelsUpdateForOrganization.
 type ModelsUpdateForOrganizationJSONBody struct {
 	// DefaultSchema ID of the schema to use for implicitly created model versions.
 	DefaultSchema *int `json:"defaultSchema,omitempty"`
 }
 
+// ModelsUpdateForOrganizationParams defines parameters for ModelsUpdateForOrganization.
+type ModelsUpdateForOrganizationParams struct {
+	// IfMatch Entity tags of the resource, as returned in the ETag header. The request only succeeds if the resource was not changed since, i.e. its current entity tag is one of them; otherwise it fails with a 412 response.
+	IfMatch *IfMatch `json:"If-Match,omitempty"`
+}
+
+// AlertRulesCreateForModelJSONBody defines parameters for AlertRulesCreateForModel.
+type AlertRulesCreateForModelJSONBody struct {
+	// Field The field whose drift to evaluate, e.g. `input.age`. Only valid for drift metrics. If omitted, the field that drifted most is used.
+	Field *string `json:"field,omitempty"`
+
+	// Metric The metric an alert rule is defined on; one of `accuracy`, `psi`, `kl` or `ks`. `accuracy` is the accuracy of the labelled results; `psi`, `kl` and `ks` measure the drift of the fields of the inputs and outputs.
+	Metric AlertMetric `json:"metric"`
+
+	// Name The name of the alert rule.
+	Name string `json:"name"`
+
+	// Operator The operator used to compare the value of the metric with the threshold; one of `<`, `<=`, `>` or `>=`.
+	Operator AlertOperator `json:"operator"`
+
+	// Stage The name of the deployment stage whose version to evaluate.
+	Stage string `json:"stage"`
+
+	// Threshold The value to compare the metric with.
+	Threshold float64 `json:"threshold"`
+
+	// Window The length of the window of results to evaluate, e.g. `24h`.
+	Window string `json:"window"`
+}
+
+// AlertRulesDeleteForModelParams defines parameters for AlertRulesDeleteForModel.
+type AlertRulesDeleteForModelParams struct {
+	// IfMatch Entity tags of the resource, as returned in the ETag header. The request only succeeds if the resource was not changed since, i.e. its current entity tag is one of them; otherwise it fails with a 412 response.
+	IfMatch *IfMatch `json:"If-Match,omitempty"`
+}
+
+// AlertRulesGetForModelParams defines parameters for AlertRulesGetForModel.
+type AlertRulesGetForModelParams struct {
+	// IfNoneMatch Entity tags of the resource, as returned in the ETag header. If the current entity tag of the resource is one of them, a 304 response without a body is returned instead of the resource.
+	IfNoneMatch *IfNoneMatch `json:"If-None-Match,omitempty"`
+}
+
+// ComparisonGetForModelParams defines parameters for ComparisonGetForModel.
+type ComparisonGetForModelParams struct {
+	// Champion The name of the champion version.
+	Champion string `form:"champion" json:"champion"`
+
+	// Challenger The name of the challenger version.
+	Challenger string `form:"challenger" json:"challenger"`
+
+	// LabelSelector A Kubernetes-style label selector to filter by labels, e.g. `region=eu,env in (prod,staging),!experimental`.
+	LabelSelector *LabelSelector `form:"labelSelector,omitempty" json:"labelSelector,omitempty"`
+}
+
+// MetricsGetForModelParams defines parameters for MetricsGetForModel.
+type MetricsGetForModelParams struct {
+	// Stage The name of the deployment stage whose version to use, e.g. `production`.
+	Stage StageQuery `form:"stage" json:"stage"`
+
+	// LabelSelector A Kubernetes-style label selector to filter by labels, e.g. `region=eu,env in (prod,staging),!experimental`.
+	LabelSelector *LabelSelector `form:"labelSelector,omitempty" json:"labelSelector,omitempty"`
+}
+
+// ResultsListForModelParams defines parameters for ResultsListForModel.
+type ResultsListForModelParams struct {
+	// Stage The name of the deployment stage whose version to use, e.g. `production`.
+	Stage StageQuery `form:"stage" json:"stage"`
+
+	// LabelSelector A Kubernetes-style label selector to filter by labels, e.g. `region=eu,env in (prod,staging),!experimental`.
+	LabelSelector *LabelSelector `form:"labelSelector,omitempty" json:"labelSelector,omitempty"`
+}
+
+// ResultsCreateForModelParams defines parameters for ResultsCreateForModel.
+type ResultsCreateForModelParams struct {
+	// Stage The name of the deployment stage whose version to use, e.g. `production`.
+	Stage StageQuery `form:"stage" json:"stage"`
+
+	// IdempotencyKey A unique key chosen by the client, e.g. a UUID, with which to retry the request safely. If a request with the same key was already completed successfully, its response is returned again without repeating the request. Keys expire after a day by default.
+	IdempotencyKey *IdempotencyKey `json:"Idempotency-Key,omitempty"`
+}
+
+// ResultsStreamForModelParams defines parameters for ResultsStreamForModel.
+type ResultsStreamForModelParams struct {
+	// Stage The name of the deployment stage whose version to use, e.g. `production`.
+	Stage StageQuery `form:"stage" json:"stage"`
+
+	// LastEventID The ID of the last event received from an earlier stream. Results created since are streamed first.
+	LastEventID *LastEventID `json:"Last-Event-ID,omitempty"`
+}
+
+// StagesGetForModelParams defines parameters for StagesGetForModel.
+type StagesGetForModelParams struct {
+	// IfNoneMatch Entity tags of the resource, as returned in the ETag header. If the current entity tag of the resource is one of them, a 304 response without a body is returned instead of the resource.
+	IfNoneMatch *IfNoneMatch `json:"If-None-Match,omitempty"`
+}
+
+// StagesPromoteForModelJSONBody defines parameters for StagesPromoteForModel.
+type StagesPromoteForModelJSONBody struct {
+	// Version The name of the version to promote.
+	Version string `json:"version"`
+}
+
+// VersionsListForModelParams defines parameters for VersionsListForModel.
+type VersionsListForModelParams struct {
+	// LabelSelector A Kubernetes-style label selector to filter by labels, e.g. `region=eu,env in (prod,staging),!experimental`.
+	LabelSelector *LabelSelector `form:"labelSelector,omitempty" json:"labelSelector,omitempty"`
+}
+
 // VersionsCreateForModelJSONBody defines parameters for VersionsCreateForModel.
 type VersionsCreateForModelJSONBody struct {
+	// ArtifactUri The URI of the artifact that implements this version of the model.
+	ArtifactURI *string `json:"artifactUri,omitempty"`
+
+	// Commit The commit SHA of the source code that produced this version of the model.
+	Commit *string `json:"commit,omitempty"`
+
+	// Dataset A reference to the dataset on which this version of the model was trained.
+	Dataset *string `json:"dataset,omitempty"`
+
+	// Description A human-readable description of the version.
+	Description *string `json:"description,omitempty"`
+
+	// Hyperparameters The hyperparameters with which this version of the model was trained.
+	Hyperparameters *json.RawMessage `json:"hyperparameters,omitempty"`
+
+	// Labels Free-form key-value pairs used to filter resources with label selectors.
+	Labels *Labels `json:"labels,omitempty"`
+
 	// Name The name of the version.
 	Name string `json:"name"`
 
@@ -168,19 +700,100 @@ type VersionsCreateForModelJSONBody struct {
 	Schema int `json:"schema"`
 }
 
-// ResultsCreateForVersionJSONBody defines parameters for ResultsCreateForVersion.
-type ResultsCreateForVersionJSONBody struct {
-	// Input The input given to the model to produce this result.
-	Input json.RawMessage `json:"input"`
+// VersionsGetForModelParams defines parameters for VersionsGetForModel.
+type VersionsGetForModelParams struct {
+	// IfNoneMatch Entity tags of the resource, as returned in the ETag header. If the current entity tag of the resource is one of them, a 304 response without a body is returned instead of the resource.
+	IfNoneMatch *IfNoneMatch `json:"If-None-Match,omitempty"`
+}
 
-	// Output The output produced by the model for the given input.
-	Output json.RawMessage `json:"output"`
+// VersionsPatchForModelParams defines parameters for VersionsPatchForModel.
+type VersionsPatchForModelParams struct {
+	// IfMatch Entity tags of the resource, as returned in the ETag header. The request only succeeds if the resource was not changed since, i.e. its current entity tag is one of them; otherwise it fails with a 412 response.
+	IfMatch *IfMatch `json:"If-Match,omitempty"`
+}
 
-	// Time The timestamp of when the result was produced. Defaults to the time that the model-tracking server receives the request.
-	Time *time.Time `json:"time,omitempty"`
+// VersionsUpdateForModelJSONBody defines parameters for VersionsUpdateForModel.
+type VersionsUpdateForModelJSONBody struct {
+	// ArtifactUri The URI of the artifact that implements this version of the model.
+	ArtifactURI *string `json:"artifactUri,omitempty"`
 
-	// TrueOutput The correct output that should be produced for the given input.
-	TrueOutput json.RawMessage `json:"trueOutput"`
+	// Commit The commit SHA of the source code that produced this version of the model.
+	Commit *string `json:"commit,omitempty"`
+
+	// Dataset A reference to the dataset on which this version of the model was trained.
+	Dataset *string `json:"dataset,omitempty"`
+
+	// Description A human-readable description of the version.
+	Description *string `json:"description,omitempty"`
+
+	// Hyperparameters The hyperparameters with which this version of the model was trained.
+	Hyperparameters *json.RawMessage `json:"hyperparameters,omitempty"`
+
+	// Labels Free-form key-value pairs used to filter resources with label selectors.
+	Labels *Labels `json:"labels,omitempty"`
+}
+
+// VersionsUpdateForModelParams defines parameters for VersionsUpdateForModel.
+type VersionsUpdateForModelParams struct {
+	// IfMatch Entity tags of the resource, as returned in the ETag header. The request only succeeds if the resource was not changed since, i.e. its current entity tag is one of them; otherwise it fails with a 412 response.
+	IfMatch *IfMatch `json:"If-Match,omitempty"`
+}
+
+// DriftGetForVersionParams defines parameters for DriftGetForVersion.
+type DriftGetForVersionParams struct {
+	// Reference The name of the reference version. Defaults to the version itself.
+	Reference *string `form:"reference,omitempty" json:"reference,omitempty"`
+
+	// ReferenceStart The inclusive start of the reference window. Unbounded if omitted.
+	ReferenceStart *time.Time `form:"referenceStart,omitempty" json:"referenceStart,omitempty"`
+
+	// ReferenceEnd The exclusive end of the reference window. Unbounded if omitted.
+	ReferenceEnd *time.Time `form:"referenceEnd,omitempty" json:"referenceEnd,omitempty"`
+
+	// Start The inclusive start of the current window. Unbounded if omitted.
+	Start *time.Time `form:"start,omitempty" json:"start,omitempty"`
+
+	// End The exclusive end of the current window. Unbounded if omitted.
+	End *time.Time `form:"end,omitempty" json:"end,omitempty"`
+
+	// Bins The number of bins used to discretize numeric fields.
+	Bins *int `form:"bins,omitempty" json:"bins,omitempty"`
+
+	// LabelSelector A Kubernetes-style label selector to filter by labels, e.g. `region=eu,env in (prod,staging),!experimental`.
+	LabelSelector *LabelSelector `form:"labelSelector,omitempty" json:"labelSelector,omitempty"`
+}
+
+// MetricsGetForVersionParams defines parameters for MetricsGetForVersion.
+type MetricsGetForVersionParams struct {
+	// LabelSelector A Kubernetes-style label selector to filter by labels, e.g. `region=eu,env in (prod,staging),!experimental`.
+	LabelSelector *LabelSelector `form:"labelSelector,omitempty" json:"labelSelector,omitempty"`
+}
+
+// ResultsListForVersionParams defines parameters for ResultsListForVersion.
+type ResultsListForVersionParams struct {
+	// LabelSelector A Kubernetes-style label selector to filter by labels, e.g. `region=eu,env in (prod,staging),!experimental`.
+	LabelSelector *LabelSelector `form:"labelSelector,omitempty" json:"labelSelector,omitempty"`
+}
+
+// ResultsCreateForVersionParams defines parameters for ResultsCreateForVersion.
+type ResultsCreateForVersionParams struct {
+	// IdempotencyKey A unique key chosen by the client, e.g. a UUID, with which to retry the request safely. If a request with the same key was already completed successfully, its response is returned again without repeating the request. Keys expire after a day by default.
+	IdempotencyKey *IdempotencyKey `json:"Idempotency-Key,omitempty"`
+}
+
+// ResultsCreateBulkForVersionJSONBody defines parameters for ResultsCreateBulkForVersion.
+type ResultsCreateBulkForVersionJSONBody = []NewResult
+
+// ResultsCreateBulkForVersionParams defines parameters for ResultsCreateBulkForVersion.
+type ResultsCreateBulkForVersionParams struct {
+	// IdempotencyKey A unique key chosen by the client, e.g. a UUID, with which to retry the request safely. If a request with the same key was already completed successfully, its response is returned again without repeating the request. Keys expire after a day by default.
+	IdempotencyKey *IdempotencyKey `json:"Idempotency-Key,omitempty"`
+}
+
+// ResultsStreamForVersionParams defines parameters for ResultsStreamForVersion.
+type ResultsStreamForVersionParams struct {
+	// LastEventID The ID of the last event received from an earlier stream. Results created since are streamed first.
+	LastEventID *LastEventID `json:"Last-Event-ID,omitempty"`
 }
 
 // SchemasCreateForOrganizationJSONBody defines parameters for SchemasCreateForOrganization.
@@ -195,24 +808,87 @@ type SchemasCreateForOrganizationJSONBody struct {
 	Output json.RawMessage `json:"output"`
 }
 
-// OrganizationsCreateJSONRequestBody defines body for OrganizationsCreate for application/json ContentType.
-type OrganizationsCreateJSONRequestBody OrganizationsCreateJSONBody
+// SchemasGetForOrganizationParams defines parameters for SchemasGetForOrganization.
+type SchemasGetForOrganizationParams struct {
+	// IfNoneMatch Entity tags of the resource, as returned in the ETag header. If the current entity tag of the resource is one of them, a 304 response without a body is returned instead of the resource.
+	IfNoneMatch *IfNoneMatch `json:"If-None-Match,omitempty"`
+}
 
-// ModelsCreateForOrganizationJSONRequestBody defines body for ModelsCreateForOrganization for application/json ContentType.
-type ModelsCreateForOrganizationJSONRequestBody ModelsCreateForOrganizationJSONBody
+// WebhooksCreateForOrganizationJSONBody defines parameters for WebhooksCreateForOrganization.
+type WebhooksCreateForOrganizationJSONBody struct {
+	// Events The types of events to deliver. If omitted or empty, events of all types are delivered.
+	Events *[]EventType `json:"events,omitempty"`
 
-// ModelsUpdateForOrganizationJSONRequestBody defines body for ModelsUpdateForOrganization for application/json ContentType.
-type ModelsUpdateForOrganizationJSONRequestBody ModelsUpdateForOrganizationJSONBody
+	// Name The name of the webhook.
+	Name string `json:"name"`
 
-// VersionsCreateForModelJSONRequestBody defines body for VersionsCreateForModel for application/json ContentType.
-type VersionsCreateForModelJSONRequestBody VersionsCreateForModelJSONBody
+	// Secret The secret used to sign payloads.
+	Secret string `json:"secret"`
 
-// ResultsCreateForVersionJSONRequestBody defines body for ResultsCreateForVersion for application/json ContentType.
-type ResultsCreateForVersionJSONRequestBody ResultsCreateForVersionJSONBody
+	// Url The HTTP or HTTPS URL to which to post events.
+	URL string `json:"url"`
+}
 
-// SchemasCreateForOrganizationJSONRequestBody defines body for SchemasCreateForOrganization for application/json ContentType.
+// WebhooksDeleteForOrganizationParams defines parameters for WebhooksDeleteForOrganization.
+type WebhooksDeleteForOrganizationParams struct {
+	// IfMatch Entity tags of the resource, as returned in the ETag header. The request only succeeds if the resource was not changed since, i.e. its current entity tag is one of them; otherwise it fails with a 412 response.
+	IfMatch *IfMatch `json:"If-Match,omitempty"`
+}
+
+// WebhooksGetForOrganizationParams defines parameters for WebhooksGetForOrganization.
+type WebhooksGetForOrganizationParams struct {
+	// IfNoneMatch Entity tags of the resource, as returned in the ETag header. If the current entity tag of the resource is one of them, a 304 response without a body is returned instead of the resource.
+	IfNoneMatch *IfNoneMatch `json:"If-None-Match,omitempty"`
+}
+
+// WebhooksDeliveriesForOrganizationParams defines parameters for WebhooksDeliveriesForOrganization.
+type WebhooksDeliveriesForOrganizationParams struct {
+	// Limit The maximum number of deliveries to list.
+	Limit *int `form:"limit,omitempty" json:"limit,omitempty"`
+}
+
+// OrganizationsCreateJSONRequestBody defines body for OrganizationsCreate for application/json ContentType.
+type OrganizationsCreateJSONRequestBody OrganizationsCreateJSONBody
+
+// ModelsCreateForOrganizationJSONRequestBody defines body for ModelsCreateForOrganization for application/json ContentType.
+type ModelsCreateForOrganizationJSONRequestBody ModelsCreateForOrganizationJSONBody
+
+// ModelsPatchForOrganizationJSONRequestBody defines body for ModelsPatchForOrganization for application/merge-patch+json ContentType.
+type ModelsPatchForOrganizationJSONRequestBody = ModelPatch
+
+// ModelsUpdateForOrganizationJSONRequestBody defines body for ModelsUpdateForOrganization for application/json ContentType.
+type ModelsUpdateForOrganizationJSONRequestBody ModelsUpdateForOrganizationJSONBody
+
+// AlertRulesCreateForModelJSONRequestBody defines body for AlertRulesCreateForModel for application/json ContentType.
+type AlertRulesCreateForModelJSONRequestBody AlertRulesCreateForModelJSONBody
+
+// ResultsCreateForModelJSONRequestBody defines body for ResultsCreateForModel for application/json ContentType.
+type ResultsCreateForModelJSONRequestBody = NewResult
+
+// StagesPromoteForModelJSONRequestBody defines body for StagesPromoteForModel for application/json ContentType.
+type StagesPromoteForModelJSONRequestBody StagesPromoteForModelJSONBody
+
+// VersionsCreateForModelJSONRequestBody defines body for VersionsCreateForModel for application/json ContentType.
+type VersionsCreateForModelJSONRequestBody VersionsCreateForModelJSONBody
+
+// VersionsPatchForModelJSONRequestBody defines body for VersionsPatchForModel for application/merge-patch+json ContentType.
+type VersionsPatchForModelJSONRequestBody = VersionPatch
+
+// VersionsUpdateForModelJSONRequestBody defines body for VersionsUpdateForModel for application/json ContentType.
+type VersionsUpdateForModelJSONRequestBody VersionsUpdateForModelJSONBody
+
+// ResultsCreateForVersionJSONRequestBody defines body for ResultsCreateForVersion for application/json ContentType.
+type ResultsCreateForVersionJSONRequestBody = NewResult
+
+// ResultsCreateBulkForVersionJSONRequestBody defines body for ResultsCreateBulkForVersion for application/json ContentType.
+type ResultsCreateBulkForVersionJSONRequestBody = ResultsCreateBulkForVersionJSONBody
+
+// SchemasCreateForOrganizationJSONRequestBody defines body for SchemasCreateForOrganization for application/json ContentType.
 type SchemasCreateForOrganizationJSONRequestBody SchemasCreateForOrganizationJSONBody
 
+// WebhooksCreateForOrganizationJSONRequestBody defines body for WebhooksCreateForOrganization for application/json ContentType.
+type WebhooksCreateForOrganizationJSONRequestBody WebhooksCreateForOrganizationJSONBody
+
 // RequestEditorFn  is the function signature for the RequestEditor callback function
 type RequestEditorFn func(ctx context.Context, req *http.Request) error
 
@@ -300,15 +976,68 @@ type ClientInterface interface {
 	ModelsCreateForOrganization(ctx context.Context, parameterOrganization ParameterOrganization, body ModelsCreateForOrganizationJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
 
 	// ModelsGetForOrganization request
-	ModelsGetForOrganization(ctx context.Context, parameterOrganization ParameterOrganization, parameterModel ParameterModel, reqEditors ...RequestEditorFn) (*http.Response, error)
+	ModelsGetForOrganization(ctx context.Context, parameterOrganization ParameterOrganization, parameterModel ParameterModel, params *ModelsGetForOrganizationParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ModelsPatchForOrganization request with any body
+	ModelsPatchForOrganizationWithBody(ctx context.Context, parameterOrganization ParameterOrganization, parameterModel ParameterModel, params *ModelsPatchForOrganizationParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	ModelsPatchForOrganization(ctx context.Context, parameterOrganization ParameterOrganization, parameterModel ParameterModel, params *ModelsPatchForOrganizationParams, body ModelsPatchForOrganizationJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
 
 	// ModelsUpdateForOrganization request with any body
-	ModelsUpdateForOrganizationWithBody(ctx context.Context, parameterOrganization ParameterOrganization, parameterModel ParameterModel, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+	ModelsUpdateForOrganizationWithBody(ctx context.Context, parameterOrganization ParameterOrganization, parameterModel ParameterModel, params *ModelsUpdateForOrganizationParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	ModelsUpdateForOrganization(ctx context.Context, parameterOrganization ParameterOrganization, parameterModel ParameterModel, params *ModelsUpdateForOrganizationParams, body ModelsUpdateForOrganizationJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
 
-	ModelsUpdateForOrganization(ctx context.Context, parameterOrganization ParameterOrganization, parameterModel ParameterModel, body ModelsUpdateForOrganizationJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+	// AlertRulesListForModel request
+	AlertRulesListForModel(ctx context.Context, parameterOrganization ParameterOrganization, parameterModel ParameterModel, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// AlertRulesCreateForModel request with any body
+	AlertRulesCreateForModelWithBody(ctx context.Context, parameterOrganization ParameterOrganization, parameterModel ParameterModel, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	AlertRulesCreateForModel(ctx context.Context, parameterOrganization ParameterOrganization, parameterModel ParameterModel, body AlertRulesCreateForModelJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// AlertRulesDeleteForModel request
+	AlertRulesDeleteForModel(ctx context.Context, parameterOrganization ParameterOrganization, parameterModel ParameterModel, parameterAlertRule ParameterAlertRule, params *AlertRulesDeleteForModelParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// AlertRulesGetForModel request
+	AlertRulesGetForModel(ctx context.Context, parameterOrganization ParameterOrganization, parameterModel ParameterModel, parameterAlertRule ParameterAlertRule, params *AlertRulesGetForModelParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ComparisonGetForModel request
+	ComparisonGetForModel(ctx context.Context, parameterOrganization ParameterOrganization, parameterModel ParameterModel, params *ComparisonGetForModelParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// MetricsGetForModel request
+	MetricsGetForModel(ctx context.Context, parameterOrganization ParameterOrganization, parameterModel ParameterModel, params *MetricsGetForModelParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ResultsListForModel request
+	ResultsListForModel(ctx context.Context, parameterOrganization ParameterOrganization, parameterModel ParameterModel, params *ResultsListForModelParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ResultsCreateForModel request with any body
+	ResultsCreateForModelWithBody(ctx context.Context, parameterOrganization ParameterOrganization, parameterModel ParameterModel, params *ResultsCreateForModelParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	ResultsCreateForModel(ctx context.Context, parameterOrganization ParameterOrganization, parameterModel ParameterModel, params *ResultsCreateForModelParams, body ResultsCreateForModelJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ResultsStreamForModel request
+	ResultsStreamForModel(ctx context.Context, parameterOrganization ParameterOrganization, parameterModel ParameterModel, params *ResultsStreamForModelParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// StagesListForModel request
+	StagesListForModel(ctx context.Context, parameterOrganization ParameterOrganization, parameterModel ParameterModel, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// StagesGetForModel request
+	StagesGetForModel(ctx context.Context, parameterOrganization ParameterOrganization, parameterModel ParameterModel, parameterStage ParameterStage, params *StagesGetForModelParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// StagesHistoryForModel request
+	StagesHistoryForModel(ctx context.Context, parameterOrganization ParameterOrganization, parameterModel ParameterModel, parameterStage ParameterStage, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// StagesPromoteForModel request with any body
+	StagesPromoteForModelWithBody(ctx context.Context, parameterOrganization ParameterOrganization, parameterModel ParameterModel, parameterStage ParameterStage, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	StagesPromoteForModel(ctx context.Context, parameterOrganization ParameterOrganization, parameterModel ParameterModel, parameterStage ParameterStage, body StagesPromoteForModelJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// StagesRollbackForModel request
+	StagesRollbackForModel(ctx context.Context, parameterOrganization ParameterOrganization, parameterModel ParameterModel, parameterStage ParameterStage, reqEditors ...RequestEditorFn) (*http.Response, error)
 
 	// VersionsListForModel request
-	VersionsListForModel(ctx context.Context, parameterOrganization ParameterOrganization, parameterModel ParameterModel, reqEditors ...RequestEditorFn) (*http.Response, error)
+	VersionsListForModel(ctx context.Context, parameterOrganization ParameterOrganization, parameterModel ParameterModel, params *VersionsListForModelParams, reqEditors ...RequestEditorFn) (*http.Response, error)
 
 	// VersionsCreateForModel request with any body
 	VersionsCreateForModelWithBody(ctx context.Context, parameterOrganization ParameterOrganization, parameterModel ParameterModel, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
@@ -316,15 +1045,39 @@ type ClientInterface interface {
 	VersionsCreateForModel(ctx context.Context, parameterOrganization ParameterOrganization, parameterModel ParameterModel, body VersionsCreateForModelJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
 
 	// VersionsGetForModel request
-	VersionsGetForModel(ctx context.Context, parameterOrganization ParameterOrganization, parameterModel ParameterModel, parameterVersion ParameterVersion, reqEditors ...RequestEditorFn) (*http.Response, error)
+	VersionsGetForModel(ctx context.Context, parameterOrganization ParameterOrganization, parameterModel ParameterModel, parameterVersion ParameterVersion, params *VersionsGetForModelParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// VersionsPatchForModel request with any body
+	VersionsPatchForModelWithBody(ctx context.Context, parameterOrganization ParameterOrganization, parameterModel ParameterModel, parameterVersion ParameterVersion, params *VersionsPatchForModelParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	VersionsPatchForModel(ctx context.Context, parameterOrganization ParameterOrganization, parameterModel ParameterModel, parameterVersion ParameterVersion, params *VersionsPatchForModelParams, body VersionsPatchForModelJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// VersionsUpdateForModel request with any body
+	VersionsUpdateForModelWithBody(ctx context.Context, parameterOrganization ParameterOrganization, parameterModel ParameterModel, parameterVersion ParameterVersion, params *VersionsUpdateForModelParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	VersionsUpdateForModel(ctx context.Context, parameterOrganization ParameterOrganization, parameterModel ParameterModel, parameterVersion ParameterVersion, params *VersionsUpdateForModelParams, body VersionsUpdateForModelJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DriftGetForVersion request
+	DriftGetForVersion(ctx context.Context, parameterOrganization ParameterOrganization, parameterModel ParameterModel, parameterVersion ParameterVersion, params *DriftGetForVersionParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// MetricsGetForVersion request
+	MetricsGetForVersion(ctx context.Context, parameterOrganization ParameterOrganization, parameterModel ParameterModel, parameterVersion ParameterVersion, params *MetricsGetForVersionParams, reqEditors ...RequestEditorFn) (*http.Response, error)
 
 	// ResultsListForVersion request
-	ResultsListForVersion(ctx context.Context, parameterOrganization ParameterOrganization, parameterModel ParameterModel, parameterVersion ParameterVersion, reqEditors ...RequestEditorFn) (*http.Response, error)
+	ResultsListForVersion(ctx context.Context, parameterOrganization ParameterOrganization, parameterModel ParameterModel, parameterVersion ParameterVersion, params *ResultsListForVersionParams, reqEditors ...RequestEditorFn) (*http.Response, error)
 
 	// ResultsCreateForVersion request with any body
-	ResultsCreateForVersionWithBody(ctx context.Context, parameterOrganization ParameterOrganization, parameterModel ParameterModel, parameterVersion ParameterVersion, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+	ResultsCreateForVersionWithBody(ctx context.Context, parameterOrganization ParameterOrganization, parameterModel ParameterModel, parameterVersion ParameterVersion, params *ResultsCreateForVersionParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	ResultsCreateForVersion(ctx context.Context, parameterOrganization ParameterOrganization, parameterModel ParameterModel, parameterVersion ParameterVersion, params *ResultsCreateForVersionParams, body ResultsCreateForVersionJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ResultsCreateBulkForVersion request with any body
+	ResultsCreateBulkForVersionWithBody(ctx context.Context, parameterOrganization ParameterOrganization, parameterModel ParameterModel, parameterVersion ParameterVersion, params *ResultsCreateBulkForVersionParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
 
-	ResultsCreateForVersion(ctx context.Context, parameterOrganization ParameterOrganization, parameterModel ParameterModel, parameterVersion ParameterVersion, body ResultsCreateForVersionJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+	ResultsCreateBulkForVersion(ctx context.Context, parameterOrganization ParameterOrganization, parameterModel ParameterModel, parameterVersion ParameterVersion, params *ResultsCreateBulkForVersionParams, body ResultsCreateBulkForVersionJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ResultsStreamForVersion request
+	ResultsStreamForVersion(ctx context.Context, parameterOrganization ParameterOrganization, parameterModel ParameterModel, parameterVersion ParameterVersion, params *ResultsStreamForVersionParams, reqEditors ...RequestEditorFn) (*http.Response, error)
 
 	// ResultsGetForVersion request
 	ResultsGetForVersion(ctx context.Context, parameterOrganization ParameterOrganization, parameterModel ParameterModel, parameterVersion ParameterVersion, parameterResult ParameterResult, reqEditors ...RequestEditorFn) (*http.Response, error)
@@ -338,7 +1091,24 @@ type ClientInterface interface {
 	SchemasCreateForOrganization(ctx context.Context, parameterOrganization ParameterOrganization, body SchemasCreateForOrganizationJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
 
 	// SchemasGetForOrganization request
-	SchemasGetForOrganization(ctx context.Context, parameterOrganization ParameterOrganization, parameterSchema ParameterSchema, reqEditors ...RequestEditorFn) (*http.Response, error)
+	SchemasGetForOrganization(ctx context.Context, parameterOrganization ParameterOrganization, parameterSchema ParameterSchema, params *SchemasGetForOrganizationParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// WebhooksListForOrganization request
+	WebhooksListForOrganization(ctx context.Context, parameterOrganization ParameterOrganization, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// WebhooksCreateForOrganization request with any body
+	WebhooksCreateForOrganizationWithBody(ctx context.Context, parameterOrganization ParameterOrganization, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	WebhooksCreateForOrganization(ctx context.Context, parameterOrganization ParameterOrganization, body WebhooksCreateForOrganizationJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// WebhooksDeleteForOrganization request
+	WebhooksDeleteForOrganization(ctx context.Context, parameterOrganization ParameterOrganization, parameterWebhook ParameterWebhook, params *WebhooksDeleteForOrganizationParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// WebhooksGetForOrganization request
+	WebhooksGetForOrganization(ctx context.Context, parameterOrganization ParameterOrganization, parameterWebhook ParameterWebhook, params *WebhooksGetForOrganizationParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// WebhooksDeliveriesForOrganization request
+	WebhooksDeliveriesForOrganization(ctx context.Context, parameterOrganization ParameterOrganization, parameterWebhook ParameterWebhook, params *WebhooksDeliveriesForOrganizationParams, reqEditors ...RequestEditorFn) (*http.Response, error)
 }
 
 func (c *Client) OrganizationsCreateWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
@@ -401,8 +1171,8 @@ func (c *Client) ModelsCreateForOrganization(ctx context.Context, parameterOrgan
 	return c.Client.Do(req)
 }
 
-func (c *Client) ModelsGetForOrganization(ctx context.Context, parameterOrganization ParameterOrganization, parameterModel ParameterModel, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewModelsGetForOrganizationRequest(c.Server, parameterOrganization, parameterModel)
+func (c *Client) ModelsGetForOrganization(ctx context.Context, parameterOrganization ParameterOrganization, parameterModel ParameterModel, params *ModelsGetForOrganizationParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewModelsGetForOrganizationRequest(c.Server, parameterOrganization, parameterModel, params)
 	if err != nil {
 		return nil, err
 	}
@@ -413,8 +1183,8 @@ func (c *Client) ModelsGetForOrganization(ctx context.Context, parameterOrganiza
 	return c.Client.Do(req)
 }
 
-func (c *Client) ModelsUpdateForOrganizationWithBody(ctx context.Context, parameterOrganization ParameterOrganization, parameterModel ParameterModel, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewModelsUpdateForOrganizationRequestWithBody(c.Server, parameterOrganization, parameterModel, contentType, body)
+func (c *Client) ModelsPatchForOrganizationWithBody(ctx context.Context, parameterOrganization ParameterOrganization, parameterModel ParameterModel, params *ModelsPatchForOrganizationParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewModelsPatchForOrganizationRequestWithBody(c.Server, parameterOrganization, parameterModel, params, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -425,8 +1195,8 @@ func (c *Client) ModelsUpdateForOrganizationWithBody(ctx context.Context, parame
 	return c.Client.Do(req)
 }
 
-func (c *Client) ModelsUpdateForOrganization(ctx context.Context, parameterOrganization ParameterOrganization, parameterModel ParameterModel, body ModelsUpdateForOrganizationJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewModelsUpdateForOrganizationRequest(c.Server, parameterOrganization, parameterModel, body)
+func (c *Client) ModelsPatchForOrganization(ctx context.Context, parameterOrganization ParameterOrganization, parameterModel ParameterModel, params *ModelsPatchForOrganizationParams, body ModelsPatchForOrganizationJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewModelsPatchForOrganizationRequest(c.Server, parameterOrganization, parameterModel, params, body)
 	if err != nil {
 		return nil, err
 	}
@@ -437,8 +1207,8 @@ func (c *Client) ModelsUpdateForOrganization(ctx context.Context, parameterOrgan
 	return c.Client.Do(req)
 }
 
-func (c *Client) VersionsListForModel(ctx context.Context, parameterOrganization ParameterOrganization, parameterModel ParameterModel, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewVersionsListForModelRequest(c.Server, parameterOrganization, parameterModel)
+func (c *Client) ModelsUpdateForOrganizationWithBody(ctx context.Context, parameterOrganization ParameterOrganization, parameterModel ParameterModel, params *ModelsUpdateForOrganizationParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewModelsUpdateForOrganizationRequestWithBody(c.Server, parameterOrganization, parameterModel, params, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -449,8 +1219,8 @@ func (c *Client) VersionsListForModel(ctx context.Context, parameterOrganization
 	return c.Client.Do(req)
 }
 
-func (c *Client) VersionsCreateForModelWithBody(ctx context.Context, parameterOrganization ParameterOrganization, parameterModel ParameterModel, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewVersionsCreateForModelRequestWithBody(c.Server, parameterOrganization, parameterModel, contentType, body)
+func (c *Client) ModelsUpdateForOrganization(ctx context.Context, parameterOrganization ParameterOrganization, parameterModel ParameterModel, params *ModelsUpdateForOrganizationParams, body ModelsUpdateForOrganizationJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewModelsUpdateForOrganizationRequest(c.Server, parameterOrganization, parameterModel, params, body)
 	if err != nil {
 		return nil, err
 	}
@@ -461,8 +1231,8 @@ func (c *Client) VersionsCreateForModelWithBody(ctx context.Context, parameterOr
 	return c.Client.Do(req)
 }
 
-func (c *Client) VersionsCreateForModel(ctx context.Context, parameterOrganization ParameterOrganization, parameterModel ParameterModel, body VersionsCreateForModelJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewVersionsCreateForModelRequest(c.Server, parameterOrganization, parameterModel, body)
+func (c *Client) AlertRulesListForModel(ctx context.Context, parameterOrganization ParameterOrganization, parameterModel ParameterModel, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewAlertRulesListForModelRequest(c.Server, parameterOrganization, parameterModel)
 	if err != nil {
 		return nil, err
 	}
@@ -473,8 +1243,8 @@ func (c *Client) VersionsCreateForModel(ctx context.Context, parameterOrganizati
 	return c.Client.Do(req)
 }
 
-func (c *Client) VersionsGetForModel(ctx context.Context, parameterOrganization ParameterOrganization, parameterModel ParameterModel, parameterVersion ParameterVersion, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewVersionsGetForModelRequest(c.Server, parameterOrganization, parameterModel, parameterVersion)
+func (c *Client) AlertRulesCreateForModelWithBody(ctx context.Context, parameterOrganization ParameterOrganization, parameterModel ParameterModel, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewAlertRulesCreateForModelRequestWithBody(c.Server, parameterOrganization, parameterModel, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -485,8 +1255,8 @@ func (c *Client) VersionsGetForModel(ctx context.Context, parameterOrganization
 	return c.Client.Do(req)
 }
 
-func (c *Client) ResultsListForVersion(ctx context.Context, parameterOrganization ParameterOrganization, parameterModel ParameterModel, parameterVersion ParameterVersion, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewResultsListForVersionRequest(c.Server, parameterOrganization, parameterModel, parameterVersion)
+func (c *Client) AlertRulesCreateForModel(ctx context.Context, parameterOrganization ParameterOrganization, parameterModel ParameterModel, body AlertRulesCreateForModelJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewAlertRulesCreateForModelRequest(c.Server, parameterOrganization, parameterModel, body)
 	if err != nil {
 		return nil, err
 	}
@@ -497,8 +1267,8 @@ func (c *Client) ResultsListForVersion(ctx context.Context, parameterOrganizatio
 	return c.Client.Do(req)
 }
 
-func (c *Client) ResultsCreateForVersionWithBody(ctx context.Context, parameterOrganization ParameterOrganization, parameterModel ParameterModel, parameterVersion ParameterVersion, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewResultsCreateForVersionRequestWithBody(c.Server, parameterOrganization, parameterModel, parameterVersion, contentType, body)
+func (c *Client) AlertRulesDeleteForModel(ctx context.Context, parameterOrganization ParameterOrganization, parameterModel ParameterModel, parameterAlertRule ParameterAlertRule, params *AlertRulesDeleteForModelParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewAlertRulesDeleteForModelRequest(c.Server, parameterOrganization, parameterModel, parameterAlertRule, params)
 	if err != nil {
 		return nil, err
 	}
@@ -509,8 +1279,8 @@ func (c *Client) ResultsCreateForVersionWithBody(ctx context.Context, parameterO
 	return c.Client.Do(req)
 }
 
-func (c *Client) ResultsCreateForVersion(ctx context.Context, parameterOrganization ParameterOrganization, parameterModel ParameterModel, parameterVersion ParameterVersion, body ResultsCreateForVersionJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewResultsCreateForVersionRequest(c.Server, parameterOrganization, parameterModel, parameterVersion, body)
+func (c *Client) AlertRulesGetForModel(ctx context.Context, parameterOrganization ParameterOrganization, parameterModel ParameterModel, parameterAlertRule ParameterAlertRule, params *AlertRulesGetForModelParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewAlertRulesGetForModelRequest(c.Server, parameterOrganization, parameterModel, parameterAlertRule, params)
 	if err != nil {
 		return nil, err
 	}
@@ -521,8 +1291,8 @@ func (c *Client) ResultsCreateForVersion(ctx context.Context, parameterOrganizat
 	return c.Client.Do(req)
 }
 
-func (c *Client) ResultsGetForVersion(ctx context.Context, parameterOrganization ParameterOrganization, parameterModel ParameterModel, parameterVersion ParameterVersion, parameterResult ParameterResult, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewResultsGetForVersionRequest(c.Server, parameterOrganization, parameterModel, parameterVersion, parameterResult)
+func (c *Client) ComparisonGetForModel(ctx context.Context, parameterOrganization ParameterOrganization, parameterModel ParameterModel, params *ComparisonGetForModelParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewComparisonGetForModelRequest(c.Server, parameterOrganization, parameterModel, params)
 	if err != nil {
 		return nil, err
 	}
@@ -533,8 +1303,8 @@ func (c *Client) ResultsGetForVersion(ctx context.Context, parameterOrganization
 	return c.Client.Do(req)
 }
 
-func (c *Client) SchemasListForOrganization(ctx context.Context, parameterOrganization ParameterOrganization, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewSchemasListForOrganizationRequest(c.Server, parameterOrganization)
+func (c *Client) MetricsGetForModel(ctx context.Context, parameterOrganization ParameterOrganization, parameterModel ParameterModel, params *MetricsGetForModelParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewMetricsGetForModelRequest(c.Server, parameterOrganization, parameterModel, params)
 	if err != nil {
 		return nil, err
 	}
@@ -545,8 +1315,8 @@ func (c *Client) SchemasListForOrganization(ctx context.Context, parameterOrgani
 	return c.Client.Do(req)
 }
 
-func (c *Client) SchemasCreateForOrganizationWithBody(ctx context.Context, parameterOrganization ParameterOrganization, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewSchemasCreateForOrganizationRequestWithBody(c.Server, parameterOrganization, contentType, body)
+func (c *Client) ResultsListForModel(ctx context.Context, parameterOrganization ParameterOrganization, parameterModel ParameterModel, params *ResultsListForModelParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewResultsListForModelRequest(c.Server, parameterOrganization, parameterModel, params)
 	if err != nil {
 		return nil, err
 	}
@@ -557,8 +1327,8 @@ func (c *Client) SchemasCreateForOrganizationWithBody(ctx context.Context, param
 	return c.Client.Do(req)
 }
 
-func (c *Client) SchemasCreateForOrganization(ctx context.Context, parameterOrganization ParameterOrganization, body SchemasCreateForOrganizationJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewSchemasCreateForOrganizationRequest(c.Server, parameterOrganization, body)
+func (c *Client) ResultsCreateForModelWithBody(ctx context.Context, parameterOrganization ParameterOrganization, parameterModel ParameterModel, params *ResultsCreateForModelParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewResultsCreateForModelRequestWithBody(c.Server, parameterOrganization, parameterModel, params, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -569,8 +1339,8 @@ func (c *Client) SchemasCreateForOrganization(ctx context.Context, parameterOrga
 	return c.Client.Do(req)
 }
 
-func (c *Client) SchemasGetForOrganization(ctx context.Context, parameterOrganization ParameterOrganization, parameterSchema ParameterSchema, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewSchemasGetForOrganizationRequest(c.Server, parameterOrganization, parameterSchema)
+func (c *Client) ResultsCreateForModel(ctx context.Context, parameterOrganization ParameterOrganization, parameterModel ParameterModel, params *ResultsCreateForModelParams, body ResultsCreateForModelJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewResultsCreateForModelRequest(c.Server, parameterOrganization, parameterModel, params, body)
 	if err != nil {
 		return nil, err
 	}
@@ -581,348 +1351,435 @@ func (c *Client) SchemasGetForOrganization(ctx context.Context, parameterOrganiz
 	return c.Client.Do(req)
 }
 
-// NewOrganizationsCreateRequest calls the generic OrganizationsCreate builder with application/json body
-func NewOrganizationsCreateRequest(server string, body OrganizationsCreateJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
+func (c *Client) ResultsStreamForModel(ctx context.Context, parameterOrganization ParameterOrganization, parameterModel ParameterModel, params *ResultsStreamForModelParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewResultsStreamForModelRequest(c.Server, parameterOrganization, parameterModel, params)
 	if err != nil {
 		return nil, err
 	}
-	bodyReader = bytes.NewReader(buf)
-	return NewOrganizationsCreateRequestWithBody(server, "application/json", bodyReader)
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// NewOrganizationsCreateRequestWithBody generates requests for OrganizationsCreate with any type of body
-func NewOrganizationsCreateRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
-	var err error
-
-	serverURL, err := url.Parse(server)
+func (c *Client) StagesListForModel(ctx context.Context, parameterOrganization ParameterOrganization, parameterModel ParameterModel, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewStagesListForModelRequest(c.Server, parameterOrganization, parameterModel)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/organizations")
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) StagesGetForModel(ctx context.Context, parameterOrganization ParameterOrganization, parameterModel ParameterModel, parameterStage ParameterStage, params *StagesGetForModelParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewStagesGetForModelRequest(c.Server, parameterOrganization, parameterModel, parameterStage, params)
 	if err != nil {
 		return nil, err
 	}
-
-	req, err := http.NewRequest("POST", queryURL.String(), body)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-
-	req.Header.Add("Content-Type", contentType)
-
-	return req, nil
+	return c.Client.Do(req)
 }
 
-// NewModelsListForOrganizationRequest generates requests for ModelsListForOrganization
-func NewModelsListForOrganizationRequest(server string, parameterOrganization ParameterOrganization) (*http.Request, error) {
-	var err error
-
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "organization", runtime.ParamLocationPath, parameterOrganization)
+func (c *Client) StagesHistoryForModel(ctx context.Context, parameterOrganization ParameterOrganization, parameterModel ParameterModel, parameterStage ParameterStage, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewStagesHistoryForModelRequest(c.Server, parameterOrganization, parameterModel, parameterStage)
 	if err != nil {
 		return nil, err
 	}
-
-	serverURL, err := url.Parse(server)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	operationPath := fmt.Sprintf("/organizations/%s/models", pathParam0)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
-	}
-
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) StagesPromoteForModelWithBody(ctx context.Context, parameterOrganization ParameterOrganization, parameterModel ParameterModel, parameterStage ParameterStage, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewStagesPromoteForModelRequestWithBody(c.Server, parameterOrganization, parameterModel, parameterStage, contentType, body)
 	if err != nil {
 		return nil, err
 	}
-
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-
-	return req, nil
+	return c.Client.Do(req)
 }
 
-// NewModelsCreateForOrganizationRequest calls the generic ModelsCreateForOrganization builder with application/json body
-func NewModelsCreateForOrganizationRequest(server string, parameterOrganization ParameterOrganization, body ModelsCreateForOrganizationJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
+func (c *Client) StagesPromoteForModel(ctx context.Context, parameterOrganization ParameterOrganization, parameterModel ParameterModel, parameterStage ParameterStage, body StagesPromoteForModelJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewStagesPromoteForModelRequest(c.Server, parameterOrganization, parameterModel, parameterStage, body)
 	if err != nil {
 		return nil, err
 	}
-	bodyReader = bytes.NewReader(buf)
-	return NewModelsCreateForOrganizationRequestWithBody(server, parameterOrganization, "application/json", bodyReader)
-}
-
-// NewModelsCreateForOrganizationRequestWithBody generates requests for ModelsCreateForOrganization with any type of body
-func NewModelsCreateForOrganizationRequestWithBody(server string, parameterOrganization ParameterOrganization, contentType string, body io.Reader) (*http.Request, error) {
-	var err error
-
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "organization", runtime.ParamLocationPath, parameterOrganization)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	serverURL, err := url.Parse(server)
+func (c *Client) StagesRollbackForModel(ctx context.Context, parameterOrganization ParameterOrganization, parameterModel ParameterModel, parameterStage ParameterStage, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewStagesRollbackForModelRequest(c.Server, parameterOrganization, parameterModel, parameterStage)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/organizations/%s/models", pathParam0)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) VersionsListForModel(ctx context.Context, parameterOrganization ParameterOrganization, parameterModel ParameterModel, params *VersionsListForModelParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewVersionsListForModelRequest(c.Server, parameterOrganization, parameterModel, params)
 	if err != nil {
 		return nil, err
 	}
-
-	req, err := http.NewRequest("POST", queryURL.String(), body)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-
-	req.Header.Add("Content-Type", contentType)
-
-	return req, nil
+	return c.Client.Do(req)
 }
 
-// NewModelsGetForOrganizationRequest generates requests for ModelsGetForOrganization
-func NewModelsGetForOrganizationRequest(server string, parameterOrganization ParameterOrganization, parameterModel ParameterModel) (*http.Request, error) {
-	var err error
-
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "organization", runtime.ParamLocationPath, parameterOrganization)
+func (c *Client) VersionsCreateForModelWithBody(ctx context.Context, parameterOrganization ParameterOrganization, parameterModel ParameterModel, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewVersionsCreateForModelRequestWithBody(c.Server, parameterOrganization, parameterModel, contentType, body)
 	if err != nil {
 		return nil, err
 	}
-
-	var pathParam1 string
-
-	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "model", runtime.ParamLocationPath, parameterModel)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	serverURL, err := url.Parse(server)
+func (c *Client) VersionsCreateForModel(ctx context.Context, parameterOrganization ParameterOrganization, parameterModel ParameterModel, body VersionsCreateForModelJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewVersionsCreateForModelRequest(c.Server, parameterOrganization, parameterModel, body)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/organizations/%s/models/%s", pathParam0, pathParam1)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) VersionsGetForModel(ctx context.Context, parameterOrganization ParameterOrganization, parameterModel ParameterModel, parameterVersion ParameterVersion, params *VersionsGetForModelParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewVersionsGetForModelRequest(c.Server, parameterOrganization, parameterModel, parameterVersion, params)
 	if err != nil {
 		return nil, err
 	}
-
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-
-	return req, nil
+	return c.Client.Do(req)
 }
 
-// NewModelsUpdateForOrganizationRequest calls the generic ModelsUpdateForOrganization builder with application/json body
-func NewModelsUpdateForOrganizationRequest(server string, parameterOrganization ParameterOrganization, parameterModel ParameterModel, body ModelsUpdateForOrganizationJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
+func (c *Client) VersionsPatchForModelWithBody(ctx context.Context, parameterOrganization ParameterOrganization, parameterModel ParameterModel, parameterVersion ParameterVersion, params *VersionsPatchForModelParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewVersionsPatchForModelRequestWithBody(c.Server, parameterOrganization, parameterModel, parameterVersion, params, contentType, body)
 	if err != nil {
 		return nil, err
 	}
-	bodyReader = bytes.NewReader(buf)
-	return NewModelsUpdateForOrganizationRequestWithBody(server, parameterOrganization, parameterModel, "application/json", bodyReader)
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// NewModelsUpdateForOrganizationRequestWithBody generates requests for ModelsUpdateForOrganization with any type of body
-func NewModelsUpdateForOrganizationRequestWithBody(server string, parameterOrganization ParameterOrganization, parameterModel ParameterModel, contentType string, body io.Reader) (*http.Request, error) {
-	var err error
-
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "organization", runtime.ParamLocationPath, parameterOrganization)
+func (c *Client) VersionsPatchForModel(ctx context.Context, parameterOrganization ParameterOrganization, parameterModel ParameterModel, parameterVersion ParameterVersion, params *VersionsPatchForModelParams, body VersionsPatchForModelJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewVersionsPatchForModelRequest(c.Server, parameterOrganization, parameterModel, parameterVersion, params, body)
 	if err != nil {
 		return nil, err
 	}
-
-	var pathParam1 string
-
-	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "model", runtime.ParamLocationPath, parameterModel)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	serverURL, err := url.Parse(server)
+func (c *Client) VersionsUpdateForModelWithBody(ctx context.Context, parameterOrganization ParameterOrganization, parameterModel ParameterModel, parameterVersion ParameterVersion, params *VersionsUpdateForModelParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewVersionsUpdateForModelRequestWithBody(c.Server, parameterOrganization, parameterModel, parameterVersion, params, contentType, body)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/organizations/%s/models/%s", pathParam0, pathParam1)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
-	}
-
-	queryURL, err := serverURL.Parse(operationPath)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	req, err := http.NewRequest("PUT", queryURL.String(), body)
+func (c *Client) VersionsUpdateForModel(ctx context.Context, parameterOrganization ParameterOrganization, parameterModel ParameterModel, parameterVersion ParameterVersion, params *VersionsUpdateForModelParams, body VersionsUpdateForModelJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewVersionsUpdateForModelRequest(c.Server, parameterOrganization, parameterModel, parameterVersion, params, body)
 	if err != nil {
 		return nil, err
 	}
-
-	req.Header.Add("Content-Type", contentType)
-
-	return req, nil
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// NewVersionsListForModelRequest generates requests for VersionsListForModel
-func NewVersionsListForModelRequest(server string, parameterOrganization ParameterOrganization, parameterModel ParameterModel) (*http.Request, error) {
-	var err error
-
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "organization", runtime.ParamLocationPath, parameterOrganization)
+func (c *Client) DriftGetForVersion(ctx context.Context, parameterOrganization ParameterOrganization, parameterModel ParameterModel, parameterVersion ParameterVersion, params *DriftGetForVersionParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDriftGetForVersionRequest(c.Server, parameterOrganization, parameterModel, parameterVersion, params)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	var pathParam1 string
-
-	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "model", runtime.ParamLocationPath, parameterModel)
+func (c *Client) MetricsGetForVersion(ctx context.Context, parameterOrganization ParameterOrganization, parameterModel ParameterModel, parameterVersion ParameterVersion, params *MetricsGetForVersionParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewMetricsGetForVersionRequest(c.Server, parameterOrganization, parameterModel, parameterVersion, params)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	serverURL, err := url.Parse(server)
+func (c *Client) ResultsListForVersion(ctx context.Context, parameterOrganization ParameterOrganization, parameterModel ParameterModel, parameterVersion ParameterVersion, params *ResultsListForVersionParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewResultsListForVersionRequest(c.Server, parameterOrganization, parameterModel, parameterVersion, params)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/organizations/%s/models/%s/versions", pathParam0, pathParam1)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) ResultsCreateForVersionWithBody(ctx context.Context, parameterOrganization ParameterOrganization, parameterModel ParameterModel, parameterVersion ParameterVersion, params *ResultsCreateForVersionParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewResultsCreateForVersionRequestWithBody(c.Server, parameterOrganization, parameterModel, parameterVersion, params, contentType, body)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
+func (c *Client) ResultsCreateForVersion(ctx context.Context, parameterOrganization ParameterOrganization, parameterModel ParameterModel, parameterVersion ParameterVersion, params *ResultsCreateForVersionParams, body ResultsCreateForVersionJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewResultsCreateForVersionRequest(c.Server, parameterOrganization, parameterModel, parameterVersion, params, body)
 	if err != nil {
 		return nil, err
 	}
-
-	return req, nil
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// NewVersionsCreateForModelRequest calls the generic VersionsCreateForModel builder with application/json body
-func NewVersionsCreateForModelRequest(server string, parameterOrganization ParameterOrganization, parameterModel ParameterModel, body VersionsCreateForModelJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
+func (c *Client) ResultsCreateBulkForVersionWithBody(ctx context.Context, parameterOrganization ParameterOrganization, parameterModel ParameterModel, parameterVersion ParameterVersion, params *ResultsCreateBulkForVersionParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewResultsCreateBulkForVersionRequestWithBody(c.Server, parameterOrganization, parameterModel, parameterVersion, params, contentType, body)
 	if err != nil {
 		return nil, err
 	}
-	bodyReader = bytes.NewReader(buf)
-	return NewVersionsCreateForModelRequestWithBody(server, parameterOrganization, parameterModel, "application/json", bodyReader)
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// NewVersionsCreateForModelRequestWithBody generates requests for VersionsCreateForModel with any type of body
-func NewVersionsCreateForModelRequestWithBody(server string, parameterOrganization ParameterOrganization, parameterModel ParameterModel, contentType string, body io.Reader) (*http.Request, error) {
-	var err error
-
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "organization", runtime.ParamLocationPath, parameterOrganization)
+func (c *Client) ResultsCreateBulkForVersion(ctx context.Context, parameterOrganization ParameterOrganization, parameterModel ParameterModel, parameterVersion ParameterVersion, params *ResultsCreateBulkForVersionParams, body ResultsCreateBulkForVersionJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewResultsCreateBulkForVersionRequest(c.Server, parameterOrganization, parameterModel, parameterVersion, params, body)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	var pathParam1 string
-
-	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "model", runtime.ParamLocationPath, parameterModel)
+func (c *Client) ResultsStreamForVersion(ctx context.Context, parameterOrganization ParameterOrganization, parameterModel ParameterModel, parameterVersion ParameterVersion, params *ResultsStreamForVersionParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewResultsStreamForVersionRequest(c.Server, parameterOrganization, parameterModel, parameterVersion, params)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	serverURL, err := url.Parse(server)
+func (c *Client) ResultsGetForVersion(ctx context.Context, parameterOrganization ParameterOrganization, parameterModel ParameterModel, parameterVersion ParameterVersion, parameterResult ParameterResult, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewResultsGetForVersionRequest(c.Server, parameterOrganization, parameterModel, parameterVersion, parameterResult)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/organizations/%s/models/%s/versions", pathParam0, pathParam1)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) SchemasListForOrganization(ctx context.Context, parameterOrganization ParameterOrganization, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewSchemasListForOrganizationRequest(c.Server, parameterOrganization)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	req, err := http.NewRequest("POST", queryURL.String(), body)
+func (c *Client) SchemasCreateForOrganizationWithBody(ctx context.Context, parameterOrganization ParameterOrganization, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewSchemasCreateForOrganizationRequestWithBody(c.Server, parameterOrganization, contentType, body)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	req.Header.Add("Content-Type", contentType)
+func (c *Client) SchemasCreateForOrganization(ctx context.Context, parameterOrganization ParameterOrganization, body SchemasCreateForOrganizationJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewSchemasCreateForOrganizationRequest(c.Server, parameterOrganization, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	return req, nil
+func (c *Client) SchemasGetForOrganization(ctx context.Context, parameterOrganization ParameterOrganization, parameterSchema ParameterSchema, params *SchemasGetForOrganizationParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewSchemasGetForOrganizationRequest(c.Server, parameterOrganization, parameterSchema, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// NewVersionsGetForModelRequest generates requests for VersionsGetForModel
-func NewVersionsGetForModelRequest(server string, parameterOrganization ParameterOrganization, parameterModel ParameterModel, parameterVersion ParameterVersion) (*http.Request, error) {
-	var err error
+func (c *Client) WebhooksListForOrganization(ctx context.Context, parameterOrganization ParameterOrganization, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewWebhooksListForOrganizationRequest(c.Server, parameterOrganization)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	var pathParam0 string
+func (c *Client) WebhooksCreateForOrganizationWithBody(ctx context.Context, parameterOrganization ParameterOrganization, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewWebhooksCreateForOrganizationRequestWithBody(c.Server, parameterOrganization, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "organization", runtime.ParamLocationPath, parameterOrganization)
+func (c *Client) WebhooksCreateForOrganization(ctx context.Context, parameterOrganization ParameterOrganization, body WebhooksCreateForOrganizationJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewWebhooksCreateForOrganizationRequest(c.Server, parameterOrganization, body)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	var pathParam1 string
+func (c *Client) WebhooksDeleteForOrganization(ctx context.Context, parameterOrganization ParameterOrganization, parameterWebhook ParameterWebhook, params *WebhooksDeleteForOrganizationParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewWebhooksDeleteForOrganizationRequest(c.Server, parameterOrganization, parameterWebhook, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "model", runtime.ParamLocationPath, parameterModel)
+func (c *Client) WebhooksGetForOrganization(ctx context.Context, parameterOrganization ParameterOrganization, parameterWebhook ParameterWebhook, params *WebhooksGetForOrganizationParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewWebhooksGetForOrganizationRequest(c.Server, parameterOrganization, parameterWebhook, params)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	var pathParam2 string
+func (c *Client) WebhooksDeliveriesForOrganization(ctx context.Context, parameterOrganization ParameterOrganization, parameterWebhook ParameterWebhook, params *WebhooksDeliveriesForOrganizationParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewWebhooksDeliveriesForOrganizationRequest(c.Server, parameterOrganization, parameterWebhook, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	pathParam2, err = runtime.StyleParamWithLocation("simple", false, "version", runtime.ParamLocationPath, parameterVersion)
+// NewOrganizationsCreateRequest calls the generic OrganizationsCreate builder with application/json body
+func NewOrganizationsCreateRequest(server string, body OrganizationsCreateJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
+	bodyReader = bytes.NewReader(buf)
+	return NewOrganizationsCreateRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewOrganizationsCreateRequestWithBody generates requests for OrganizationsCreate with any type of body
+func NewOrganizationsCreateRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
 
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/organizations/%s/models/%s/versions/%s", pathParam0, pathParam1, pathParam2)
+	operationPath := fmt.Sprintf("/organizations")
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -932,16 +1789,18 @@ func NewVersionsGetForModelRequest(server string, parameterOrganization Paramete
 		return nil, err
 	}
 
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	req, err := http.NewRequest("POST", queryURL.String(), body)
 	if err != nil {
 		return nil, err
 	}
 
+	req.Header.Add("Content-Type", contentType)
+
 	return req, nil
 }
 
-// NewResultsListForVersionRequest generates requests for ResultsListForVersion
-func NewResultsListForVersionRequest(server string, parameterOrganization ParameterOrganization, parameterModel ParameterModel, parameterVersion ParameterVersion) (*http.Request, error) {
+// NewModelsListForOrganizationRequest generates requests for ModelsListForOrganization
+func NewModelsListForOrganizationRequest(server string, parameterOrganization ParameterOrganization) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -951,26 +1810,12 @@ func NewResultsListForVersionRequest(server string, parameterOrganization Parame
 		return nil, err
 	}
 
-	var pathParam1 string
-
-	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "model", runtime.ParamLocationPath, parameterModel)
-	if err != nil {
-		return nil, err
-	}
-
-	var pathParam2 string
-
-	pathParam2, err = runtime.StyleParamWithLocation("simple", false, "version", runtime.ParamLocationPath, parameterVersion)
-	if err != nil {
-		return nil, err
-	}
-
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/organizations/%s/models/%s/versions/%s/results", pathParam0, pathParam1, pathParam2)
+	operationPath := fmt.Sprintf("/organizations/%s/models", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -988,19 +1833,19 @@ func NewResultsListForVersionRequest(server string, parameterOrganization Parame
 	return req, nil
 }
 
-// NewResultsCreateForVersionRequest calls the generic ResultsCreateForVersion builder with application/json body
-func NewResultsCreateForVersionRequest(server string, parameterOrganization ParameterOrganization, parameterModel ParameterModel, parameterVersion ParameterVersion, body ResultsCreateForVersionJSONRequestBody) (*http.Request, error) {
+// NewModelsCreateForOrganizationRequest calls the generic ModelsCreateForOrganization builder with application/json body
+func NewModelsCreateForOrganizationRequest(server string, parameterOrganization ParameterOrganization, body ModelsCreateForOrganizationJSONRequestBody) (*http.Request, error) {
 	var bodyReader io.Reader
 	buf, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
 	bodyReader = bytes.NewReader(buf)
-	return NewResultsCreateForVersionRequestWithBody(server, parameterOrganization, parameterModel, parameterVersion, "application/json", bodyReader)
+	return NewModelsCreateForOrganizationRequestWithBody(server, parameterOrganization, "application/json", bodyReader)
 }
 
-// NewResultsCreateForVersionRequestWithBody generates requests for ResultsCreateForVersion with any type of body
-func NewResultsCreateForVersionRequestWithBody(server string, parameterOrganization ParameterOrganization, parameterModel ParameterModel, parameterVersion ParameterVersion, contentType string, body io.Reader) (*http.Request, error) {
+// NewModelsCreateForOrganizationRequestWithBody generates requests for ModelsCreateForOrganization with any type of body
+func NewModelsCreateForOrganizationRequestWithBody(server string, parameterOrganization ParameterOrganization, contentType string, body io.Reader) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -1010,26 +1855,12 @@ func NewResultsCreateForVersionRequestWithBody(server string, parameterOrganizat
 		return nil, err
 	}
 
-	var pathParam1 string
-
-	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "model", runtime.ParamLocationPath, parameterModel)
-	if err != nil {
-		return nil, err
-	}
-
-	var pathParam2 string
-
-	pathParam2, err = runtime.StyleParamWithLocation("simple", false, "version", runtime.ParamLocationPath, parameterVersion)
-	if err != nil {
-		return nil, err
-	}
-
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/organizations/%s/models/%s/versions/%s/results", pathParam0, pathParam1, pathParam2)
+	operationPath := fmt.Sprintf("/organizations/%s/models", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -1049,8 +1880,8 @@ func NewResultsCreateForVersionRequestWithBody(server string, parameterOrganizat
 	return req, nil
 }
 
-// NewResultsGetForVersionRequest generates requests for ResultsGetForVersion
-func NewResultsGetForVersionRequest(server string, parameterOrganization ParameterOrganization, parameterModel ParameterModel, parameterVersion ParameterVersion, parameterResult ParameterResult) (*http.Request, error) {
+// NewModelsGetForOrganizationRequest generates requests for ModelsGetForOrganization
+func NewModelsGetForOrganizationRequest(server string, parameterOrganization ParameterOrganization, parameterModel ParameterModel, params *ModelsGetForOrganizationParams) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -1067,26 +1898,12 @@ func NewResultsGetForVersionRequest(server string, parameterOrganization Paramet
 		return nil, err
 	}
 
-	var pathParam2 string
-
-	pathParam2, err = runtime.StyleParamWithLocation("simple", false, "version", runtime.ParamLocationPath, parameterVersion)
-	if err != nil {
-		return nil, err
-	}
-
-	var pathParam3 string
-
-	pathParam3, err = runtime.StyleParamWithLocation("simple", false, "result", runtime.ParamLocationPath, parameterResult)
-	if err != nil {
-		return nil, err
-	}
-
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/organizations/%s/models/%s/versions/%s/results/%s", pathParam0, pathParam1, pathParam2, pathParam3)
+	operationPath := fmt.Sprintf("/organizations/%s/models/%s", pathParam0, pathParam1)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -1101,11 +1918,33 @@ func NewResultsGetForVersionRequest(server string, parameterOrganization Paramet
 		return nil, err
 	}
 
+	if params.IfNoneMatch != nil {
+		var headerParam0 string
+
+		headerParam0, err = runtime.StyleParamWithLocation("simple", false, "If-None-Match", runtime.ParamLocationHeader, *params.IfNoneMatch)
+		if err != nil {
+			return nil, err
+		}
+
+		req.Header.Set("If-None-Match", headerParam0)
+	}
+
 	return req, nil
 }
 
-// NewSchemasListForOrganizationRequest generates requests for SchemasListForOrganization
-func NewSchemasListForOrganizationRequest(server string, parameterOrganization ParameterOrganization) (*http.Request, error) {
+// NewModelsPatchForOrganizationRequest calls the generic ModelsPatchForOrganization builder with application/merge-patch+json body
+func NewModelsPatchForOrganizationRequest(server string, parameterOrganization ParameterOrganization, parameterModel ParameterModel, params *ModelsPatchForOrganizationParams, body ModelsPatchForOrganizationJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewModelsPatchForOrganizationRequestWithBody(server, parameterOrganization, parameterModel, params, "application/merge-patch+json", bodyReader)
+}
+
+// NewModelsPatchForOrganizationRequestWithBody generates requests for ModelsPatchForOrganization with any type of body
+func NewModelsPatchForOrganizationRequestWithBody(server string, parameterOrganization ParameterOrganization, parameterModel ParameterModel, params *ModelsPatchForOrganizationParams, contentType string, body io.Reader) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -1115,12 +1954,19 @@ func NewSchemasListForOrganizationRequest(server string, parameterOrganization P
 		return nil, err
 	}
 
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "model", runtime.ParamLocationPath, parameterModel)
+	if err != nil {
+		return nil, err
+	}
+
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/organizations/%s/schemas", pathParam0)
+	operationPath := fmt.Sprintf("/organizations/%s/models/%s", pathParam0, pathParam1)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -1130,27 +1976,40 @@ func NewSchemasListForOrganizationRequest(server string, parameterOrganization P
 		return nil, err
 	}
 
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	req, err := http.NewRequest("PATCH", queryURL.String(), body)
 	if err != nil {
 		return nil, err
 	}
 
+	req.Header.Add("Content-Type", contentType)
+
+	if params.IfMatch != nil {
+		var headerParam0 string
+
+		headerParam0, err = runtime.StyleParamWithLocation("simple", false, "If-Match", runtime.ParamLocationHeader, *params.IfMatch)
+		if err != nil {
+			return nil, err
+		}
+
+		req.Header.Set("If-Match", headerParam0)
+	}
+
 	return req, nil
 }
 
-// NewSchemasCreateForOrganizationRequest calls the generic SchemasCreateForOrganization builder with application/json body
-func NewSchemasCreateForOrganizationRequest(server string, parameterOrganization ParameterOrganization, body SchemasCreateForOrganizationJSONRequestBody) (*http.Request, error) {
+// NewModelsUpdateForOrganizationRequest calls the generic ModelsUpdateForOrganization builder with application/json body
+func NewModelsUpdateForOrganizationRequest(server string, parameterOrganization ParameterOrganization, parameterModel ParameterModel, params *ModelsUpdateForOrganizationParams, body ModelsUpdateForOrganizationJSONRequestBody) (*http.Request, error) {
 	var bodyReader io.Reader
 	buf, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
 	bodyReader = bytes.NewReader(buf)
-	return NewSchemasCreateForOrganizationRequestWithBody(server, parameterOrganization, "application/json", bodyReader)
+	return NewModelsUpdateForOrganizationRequestWithBody(server, parameterOrganization, parameterModel, params, "application/json", bodyReader)
 }
 
-// NewSchemasCreateForOrganizationRequestWithBody generates requests for SchemasCreateForOrganization with any type of body
-func NewSchemasCreateForOrganizationRequestWithBody(server string, parameterOrganization ParameterOrganization, contentType string, body io.Reader) (*http.Request, error) {
+// NewModelsUpdateForOrganizationRequestWithBody generates requests for ModelsUpdateForOrganization with any type of body
+func NewModelsUpdateForOrganizationRequestWithBody(server string, parameterOrganization ParameterOrganization, parameterModel ParameterModel, params *ModelsUpdateForOrganizationParams, contentType string, body io.Reader) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -1160,12 +2019,19 @@ func NewSchemasCreateForOrganizationRequestWithBody(server string, parameterOrga
 		return nil, err
 	}
 
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "model", runtime.ParamLocationPath, parameterModel)
+	if err != nil {
+		return nil, err
+	}
+
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/organizations/%s/schemas", pathParam0)
+	operationPath := fmt.Sprintf("/organizations/%s/models/%s", pathParam0, pathParam1)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -1175,18 +2041,29 @@ func NewSchemasCreateForOrganizationRequestWithBody(server string, parameterOrga
 		return nil, err
 	}
 
-	req, err := http.NewRequest("POST", queryURL.String(), body)
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
 	if err != nil {
 		return nil, err
 	}
 
 	req.Header.Add("Content-Type", contentType)
 
+	if params.IfMatch != nil {
+		var headerParam0 string
+
+		headerParam0, err = runtime.StyleParamWithLocation("simple", false, "If-Match", runtime.ParamLocationHeader, *params.IfMatch)
+		if err != nil {
+			return nil, err
+		}
+
+		req.Header.Set("If-Match", headerParam0)
+	}
+
 	return req, nil
 }
 
-// NewSchemasGetForOrganizationRequest generates requests for SchemasGetForOrganization
-func NewSchemasGetForOrganizationRequest(server string, parameterOrganization ParameterOrganization, parameterSchema ParameterSchema) (*http.Request, error) {
+// NewAlertRulesListForModelRequest generates requests for AlertRulesListForModel
+func NewAlertRulesListForModelRequest(server string, parameterOrganization ParameterOrganization, parameterModel ParameterModel) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -1198,7 +2075,7 @@ func NewSchemasGetForOrganizationRequest(server string, parameterOrganization Pa
 
 	var pathParam1 string
 
-	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "schema", runtime.ParamLocationPath, parameterSchema)
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "model", runtime.ParamLocationPath, parameterModel)
 	if err != nil {
 		return nil, err
 	}
@@ -1208,7 +2085,7 @@ func NewSchemasGetForOrganizationRequest(server string, parameterOrganization Pa
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/organizations/%s/schemas/%s", pathParam0, pathParam1)
+	operationPath := fmt.Sprintf("/organizations/%s/models/%s/alert-rules", pathParam0, pathParam1)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -1226,1459 +2103,7811 @@ func NewSchemasGetForOrganizationRequest(server string, parameterOrganization Pa
 	return req, nil
 }
 
-func (c *Client) applyEditors(ctx context.Context, req *http.Request, additionalEditors []RequestEditorFn) error {
-	for _, r := range c.RequestEditors {
-		if err := r(ctx, req); err != nil {
-			return err
-		}
-	}
-	for _, r := range additionalEditors {
-		if err := r(ctx, req); err != nil {
-			return err
-		}
+// NewAlertRulesCreateForModelRequest calls the generic AlertRulesCreateForModel builder with application/json body
+func NewAlertRulesCreateForModelRequest(server string, parameterOrganization ParameterOrganization, parameterModel ParameterModel, body AlertRulesCreateForModelJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
 	}
-	return nil
+	bodyReader = bytes.NewReader(buf)
+	return NewAlertRulesCreateForModelRequestWithBody(server, parameterOrganization, parameterModel, "application/json", bodyReader)
 }
 
-// ClientWithResponses builds on ClientInterface to offer response payloads
-type ClientWithResponses struct {
-	ClientInterface
-}
+// NewAlertRulesCreateForModelRequestWithBody generates requests for AlertRulesCreateForModel with any type of body
+func NewAlertRulesCreateForModelRequestWithBody(server string, parameterOrganization ParameterOrganization, parameterModel ParameterModel, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
 
-// NewClientWithResponses creates a new ClientWithResponses, which wraps
-// Client with return type handling
-func NewClientWithResponses(server string, opts ...ClientOption) (*ClientWithResponses, error) {
-	client, err := NewClient(server, opts...)
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "organization", runtime.ParamLocationPath, parameterOrganization)
 	if err != nil {
 		return nil, err
 	}
-	return &ClientWithResponses{client}, nil
-}
 
-// WithBaseURL overrides the baseURL.
-func WithBaseURL(baseURL string) ClientOption {
-	return func(c *Client) error {
-		newBaseURL, err := url.Parse(baseURL)
-		if err != nil {
-			return err
-		}
-		c.Server = newBaseURL.String()
-		return nil
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "model", runtime.ParamLocationPath, parameterModel)
+	if err != nil {
+		return nil, err
 	}
-}
 
-// ClientWithResponsesInterface is the interface specification for the client with responses above.
-type ClientWithResponsesInterface interface {
-	// OrganizationsCreate request with any body
-	OrganizationsCreateWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*OrganizationsCreateResponse, error)
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
 
-	OrganizationsCreateWithResponse(ctx context.Context, body OrganizationsCreateJSONRequestBody, reqEditors ...RequestEditorFn) (*OrganizationsCreateResponse, error)
+	operationPath := fmt.Sprintf("/organizations/%s/models/%s/alert-rules", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-	// ModelsListForOrganization request
-	ModelsListForOrganizationWithResponse(ctx context.Context, parameterOrganization ParameterOrganization, reqEditors ...RequestEditorFn) (*ModelsListForOrganizationResponse, error)
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
 
-	// ModelsCreateForOrganization request with any body
-	ModelsCreateForOrganizationWithBodyWithResponse(ctx context.Context, parameterOrganization ParameterOrganization, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ModelsCreateForOrganizationResponse, error)
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
 
-	ModelsCreateForOrganizationWithResponse(ctx context.Context, parameterOrganization ParameterOrganization, body ModelsCreateForOrganizationJSONRequestBody, reqEditors ...RequestEditorFn) (*ModelsCreateForOrganizationResponse, error)
+	req.Header.Add("Content-Type", contentType)
 
-	// ModelsGetForOrganization request
-	ModelsGetForOrganizationWithResponse(ctx context.Context, parameterOrganization ParameterOrganization, parameterModel ParameterModel, reqEditors ...RequestEditorFn) (*ModelsGetForOrganizationResponse, error)
+	return req, nil
+}
 
-	// ModelsUpdateForOrganization request with any body
-	ModelsUpdateForOrganizationWithBodyWithResponse(ctx context.Context, parameterOrganization ParameterOrganization, parameterModel ParameterModel, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ModelsUpdateForOrganizationResponse, error)
+// NewAlertRulesDeleteForModelRequest generates requests for AlertRulesDeleteForModel
+func NewAlertRulesDeleteForModelRequest(server string, parameterOrganization ParameterOrganization, parameterModel ParameterModel, parameterAlertRule ParameterAlertRule, params *AlertRulesDeleteForModelParams) (*http.Request, error) {
+	var err error
 
-	ModelsUpdateForOrganizationWithResponse(ctx context.Context, parameterOrganization ParameterOrganization, parameterModel ParameterModel, body ModelsUpdateForOrganizationJSONRequestBody, reqEditors ...RequestEditorFn) (*ModelsUpdateForOrganizationResponse, error)
+	var pathParam0 string
 
-	// VersionsListForModel request
-	VersionsListForModelWithResponse(ctx context.Context, parameterOrganization ParameterOrganization, parameterModel ParameterModel, reqEditors ...RequestEditorFn) (*VersionsListForModelResponse, error)
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "organization", runtime.ParamLocationPath, parameterOrganization)
+	if err != nil {
+		return nil, err
+	}
 
-	// VersionsCreateForModel request with any body
-	VersionsCreateForModelWithBodyWithResponse(ctx context.Context, parameterOrganization ParameterOrganization, parameterModel ParameterModel, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*VersionsCreateForModelResponse, error)
+	var pathParam1 string
 
-	VersionsCreateForModelWithResponse(ctx context.Context, parameterOrganization ParameterOrganization, parameterModel ParameterModel, body VersionsCreateForModelJSONRequestBody, reqEditors ...RequestEditorFn) (*VersionsCreateForModelResponse, error)
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "model", runtime.ParamLocationPath, parameterModel)
+	if err != nil {
+		return nil, err
+	}
 
-	// VersionsGetForModel request
-	VersionsGetForModelWithResponse(ctx context.Context, parameterOrganization ParameterOrganization, parameterModel ParameterModel, parameterVersion ParameterVersion, reqEditors ...RequestEditorFn) (*VersionsGetForModelResponse, error)
+	var pathParam2 string
 
-	// ResultsListForVersion request
-	ResultsListForVersionWithResponse(ctx context.Context, parameterOrganization ParameterOrganization, parameterModel ParameterModel, parameterVersion ParameterVersion, reqEditors ...RequestEditorFn) (*ResultsListForVersionResponse, error)
+	pathParam2, err = runtime.StyleParamWithLocation("simple", false, "alertRule", runtime.ParamLocationPath, parameterAlertRule)
+	if err != nil {
+		return nil, err
+	}
 
-	// ResultsCreateForVersion request with any body
-	ResultsCreateForVersionWithBodyWithResponse(ctx context.Context, parameterOrganization ParameterOrganization, parameterModel ParameterModel, parameterVersion ParameterVersion, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ResultsCreateForVersionResponse, error)
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
 
-	ResultsCreateForVersionWithResponse(ctx context.Context, parameterOrganization ParameterOrganization, parameterModel ParameterModel, parameterVersion ParameterVersion, body ResultsCreateForVersionJSONRequestBody, reqEditors ...RequestEditorFn) (*ResultsCreateForVersionResponse, error)
+	operationPath := fmt.Sprintf("/organizations/%s/models/%s/alert-rules/%s", pathParam0, pathParam1, pathParam2)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-	// ResultsGetForVersion request
-	ResultsGetForVersionWithResponse(ctx context.Context, parameterOrganization ParameterOrganization, parameterModel ParameterModel, parameterVersion ParameterVersion, parameterResult ParameterResult, reqEditors ...RequestEditorFn) (*ResultsGetForVersionResponse, error)
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
 
-	// SchemasListForOrganization request
-	SchemasListForOrganizationWithResponse(ctx context.Context, parameterOrganization ParameterOrganization, reqEditors ...RequestEditorFn) (*SchemasListForOrganizationResponse, error)
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
 
-	// SchemasCreateForOrganization request with any body
-	SchemasCreateForOrganizationWithBodyWithResponse(ctx context.Context, parameterOrganization ParameterOrganization, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*SchemasCreateForOrganizationResponse, error)
+	if params.IfMatch != nil {
+		var headerParam0 string
 
-	SchemasCreateForOrganizationWithResponse(ctx context.Context, parameterOrganization ParameterOrganization, body SchemasCreateForOrganizationJSONRequestBody, reqEditors ...RequestEditorFn) (*SchemasCreateForOrganizationResponse, error)
+		headerParam0, err = runtime.StyleParamWithLocation("simple", false, "If-Match", runtime.ParamLocationHeader, *params.IfMatch)
+		if err != nil {
+			return nil, err
+		}
 
-	// SchemasGetForOrganization request
-	SchemasGetForOrganizationWithResponse(ctx context.Context, parameterOrganization ParameterOrganization, parameterSchema ParameterSchema, reqEditors ...RequestEditorFn) (*SchemasGetForOrganizationResponse, error)
-}
+		req.Header.Set("If-Match", headerParam0)
+	}
 
-type OrganizationsCreateResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON201      *Organization
-	JSON401      *Error
-	JSON403      *Error
-	JSON422      *Error
-	JSON500      *Error
+	return req, nil
 }
 
-// Status returns HTTPResponse.Status
-func (r OrganizationsCreateResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
-	}
-	return http.StatusText(0)
-}
+// NewAlertRulesGetForModelRequest generates requests for AlertRulesGetForModel
+func NewAlertRulesGetForModelRequest(server string, parameterOrganization ParameterOrganization, parameterModel ParameterModel, parameterAlertRule ParameterAlertRule, params *AlertRulesGetForModelParams) (*http.Request, error) {
+	var err error
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r OrganizationsCreateResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "organization", runtime.ParamLocationPath, parameterOrganization)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type ModelsListForOrganizationResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *[]Model
-	JSON401      *Error
-	JSON403      *Error
-	JSON404      *Error
-	JSON500      *Error
-}
+	var pathParam1 string
 
-// Status returns HTTPResponse.Status
-func (r ModelsListForOrganizationResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "model", runtime.ParamLocationPath, parameterModel)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r ModelsListForOrganizationResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	var pathParam2 string
+
+	pathParam2, err = runtime.StyleParamWithLocation("simple", false, "alertRule", runtime.ParamLocationPath, parameterAlertRule)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type ModelsCreateForOrganizationResponse struct {
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/organizations/%s/models/%s/alert-rules/%s", pathParam0, pathParam1, pathParam2)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if params.IfNoneMatch != nil {
+		var headerParam0 string
+
+		headerParam0, err = runtime.StyleParamWithLocation("simple", false, "If-None-Match", runtime.ParamLocationHeader, *params.IfNoneMatch)
+		if err != nil {
+			return nil, err
+		}
+
+		req.Header.Set("If-None-Match", headerParam0)
+	}
+
+	return req, nil
+}
+
+// NewComparisonGetForModelRequest generates requests for ComparisonGetForModel
+func NewComparisonGetForModelRequest(server string, parameterOrganization ParameterOrganization, parameterModel ParameterModel, params *ComparisonGetForModelParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "organization", runtime.ParamLocationPath, parameterOrganization)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "model", runtime.ParamLocationPath, parameterModel)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/organizations/%s/models/%s/comparison", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	queryValues := queryURL.Query()
+
+	if queryFrag, err := runtime.StyleParamWithLocation("form", true, "champion", runtime.ParamLocationQuery, params.Champion); err != nil {
+		return nil, err
+	} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+		return nil, err
+	} else {
+		for k, v := range parsed {
+			for _, v2 := range v {
+				queryValues.Add(k, v2)
+			}
+		}
+	}
+
+	if queryFrag, err := runtime.StyleParamWithLocation("form", true, "challenger", runtime.ParamLocationQuery, params.Challenger); err != nil {
+		return nil, err
+	} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+		return nil, err
+	} else {
+		for k, v := range parsed {
+			for _, v2 := range v {
+				queryValues.Add(k, v2)
+			}
+		}
+	}
+
+	if params.LabelSelector != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "labelSelector", runtime.ParamLocationQuery, *params.LabelSelector); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
+	queryURL.RawQuery = queryValues.Encode()
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewMetricsGetForModelRequest generates requests for MetricsGetForModel
+func NewMetricsGetForModelRequest(server string, parameterOrganization ParameterOrganization, parameterModel ParameterModel, params *MetricsGetForModelParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "organization", runtime.ParamLocationPath, parameterOrganization)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "model", runtime.ParamLocationPath, parameterModel)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/organizations/%s/models/%s/metrics", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	queryValues := queryURL.Query()
+
+	if queryFrag, err := runtime.StyleParamWithLocation("form", true, "stage", runtime.ParamLocationQuery, params.Stage); err != nil {
+		return nil, err
+	} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+		return nil, err
+	} else {
+		for k, v := range parsed {
+			for _, v2 := range v {
+				queryValues.Add(k, v2)
+			}
+		}
+	}
+
+	if params.LabelSelector != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "labelSelector", runtime.ParamLocationQuery, *params.LabelSelector); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
+	queryURL.RawQuery = queryValues.Encode()
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewResultsListForModelRequest generates requests for ResultsListForModel
+func NewResultsListForModelRequest(server string, parameterOrganization ParameterOrganization, parameterModel ParameterModel, params *ResultsListForModelParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "organization", runtime.ParamLocationPath, parameterOrganization)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "model", runtime.ParamLocationPath, parameterModel)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/organizations/%s/models/%s/results", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	queryValues := queryURL.Query()
+
+	if queryFrag, err := runtime.StyleParamWithLocation("form", true, "stage", runtime.ParamLocationQuery, params.Stage); err != nil {
+		return nil, err
+	} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+		return nil, err
+	} else {
+		for k, v := range parsed {
+			for _, v2 := range v {
+				queryValues.Add(k, v2)
+			}
+		}
+	}
+
+	if params.LabelSelector != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "labelSelector", runtime.ParamLocationQuery, *params.LabelSelector); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
+	queryURL.RawQuery = queryValues.Encode()
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewResultsCreateForModelRequest calls the generic ResultsCreateForModel builder with application/json body
+func NewResultsCreateForModelRequest(server string, parameterOrganization ParameterOrganization, parameterModel ParameterModel, params *ResultsCreateForModelParams, body ResultsCreateForModelJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewResultsCreateForModelRequestWithBody(server, parameterOrganization, parameterModel, params, "application/json", bodyReader)
+}
+
+// NewResultsCreateForModelRequestWithBody generates requests for ResultsCreateForModel with any type of body
+func NewResultsCreateForModelRequestWithBody(server string, parameterOrganization ParameterOrganization, parameterModel ParameterModel, params *ResultsCreateForModelParams, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "organization", runtime.ParamLocationPath, parameterOrganization)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "model", runtime.ParamLocationPath, parameterModel)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/organizations/%s/models/%s/results", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	queryValues := queryURL.Query()
+
+	if queryFrag, err := runtime.StyleParamWithLocation("form", true, "stage", runtime.ParamLocationQuery, params.Stage); err != nil {
+		return nil, err
+	} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+		return nil, err
+	} else {
+		for k, v := range parsed {
+			for _, v2 := range v {
+				queryValues.Add(k, v2)
+			}
+		}
+	}
+
+	queryURL.RawQuery = queryValues.Encode()
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	if params.IdempotencyKey != nil {
+		var headerParam0 string
+
+		headerParam0, err = runtime.StyleParamWithLocation("simple", false, "Idempotency-Key", runtime.ParamLocationHeader, *params.IdempotencyKey)
+		if err != nil {
+			return nil, err
+		}
+
+		req.Header.Set("Idempotency-Key", headerParam0)
+	}
+
+	return req, nil
+}
+
+// NewResultsStreamForModelRequest generates requests for ResultsStreamForModel
+func NewResultsStreamForModelRequest(server string, parameterOrganization ParameterOrganization, parameterModel ParameterModel, params *ResultsStreamForModelParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "organization", runtime.ParamLocationPath, parameterOrganization)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "model", runtime.ParamLocationPath, parameterModel)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/organizations/%s/models/%s/results/stream", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	queryValues := queryURL.Query()
+
+	if queryFrag, err := runtime.StyleParamWithLocation("form", true, "stage", runtime.ParamLocationQuery, params.Stage); err != nil {
+		return nil, err
+	} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+		return nil, err
+	} else {
+		for k, v := range parsed {
+			for _, v2 := range v {
+				queryValues.Add(k, v2)
+			}
+		}
+	}
+
+	queryURL.RawQuery = queryValues.Encode()
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if params.LastEventID != nil {
+		var headerParam0 string
+
+		headerParam0, err = runtime.StyleParamWithLocation("simple", false, "Last-Event-ID", runtime.ParamLocationHeader, *params.LastEventID)
+		if err != nil {
+			return nil, err
+		}
+
+		req.Header.Set("Last-Event-ID", headerParam0)
+	}
+
+	return req, nil
+}
+
+// NewStagesListForModelRequest generates requests for StagesListForModel
+func NewStagesListForModelRequest(server string, parameterOrganization ParameterOrganization, parameterModel ParameterModel) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "organization", runtime.ParamLocationPath, parameterOrganization)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "model", runtime.ParamLocationPath, parameterModel)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/organizations/%s/models/%s/stages", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewStagesGetForModelRequest generates requests for StagesGetForModel
+func NewStagesGetForModelRequest(server string, parameterOrganization ParameterOrganization, parameterModel ParameterModel, parameterStage ParameterStage, params *StagesGetForModelParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "organization", runtime.ParamLocationPath, parameterOrganization)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "model", runtime.ParamLocationPath, parameterModel)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam2 string
+
+	pathParam2, err = runtime.StyleParamWithLocation("simple", false, "stage", runtime.ParamLocationPath, parameterStage)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/organizations/%s/models/%s/stages/%s", pathParam0, pathParam1, pathParam2)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if params.IfNoneMatch != nil {
+		var headerParam0 string
+
+		headerParam0, err = runtime.StyleParamWithLocation("simple", false, "If-None-Match", runtime.ParamLocationHeader, *params.IfNoneMatch)
+		if err != nil {
+			return nil, err
+		}
+
+		req.Header.Set("If-None-Match", headerParam0)
+	}
+
+	return req, nil
+}
+
+// NewStagesHistoryForModelRequest generates requests for StagesHistoryForModel
+func NewStagesHistoryForModelRequest(server string, parameterOrganization ParameterOrganization, parameterModel ParameterModel, parameterStage ParameterStage) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "organization", runtime.ParamLocationPath, parameterOrganization)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "model", runtime.ParamLocationPath, parameterModel)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam2 string
+
+	pathParam2, err = runtime.StyleParamWithLocation("simple", false, "stage", runtime.ParamLocationPath, parameterStage)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/organizations/%s/models/%s/stages/%s/history", pathParam0, pathParam1, pathParam2)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewStagesPromoteForModelRequest calls the generic StagesPromoteForModel builder with application/json body
+func NewStagesPromoteForModelRequest(server string, parameterOrganization ParameterOrganization, parameterModel ParameterModel, parameterStage ParameterStage, body StagesPromoteForModelJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewStagesPromoteForModelRequestWithBody(server, parameterOrganization, parameterModel, parameterStage, "application/json", bodyReader)
+}
+
+// NewStagesPromoteForModelRequestWithBody generates requests for StagesPromoteForModel with any type of body
+func NewStagesPromoteForModelRequestWithBody(server string, parameterOrganization ParameterOrganization, parameterModel ParameterModel, parameterStage ParameterStage, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "organization", runtime.ParamLocationPath, parameterOrganization)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "model", runtime.ParamLocationPath, parameterModel)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam2 string
+
+	pathParam2, err = runtime.StyleParamWithLocation("simple", false, "stage", runtime.ParamLocationPath, parameterStage)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/organizations/%s/models/%s/stages/%s/promote", pathParam0, pathParam1, pathParam2)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewStagesRollbackForModelRequest generates requests for StagesRollbackForModel
+func NewStagesRollbackForModelRequest(server string, parameterOrganization ParameterOrganization, parameterModel ParameterModel, parameterStage ParameterStage) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "organization", runtime.ParamLocationPath, parameterOrganization)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "model", runtime.ParamLocationPath, parameterModel)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam2 string
+
+	pathParam2, err = runtime.StyleParamWithLocation("simple", false, "stage", runtime.ParamLocationPath, parameterStage)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/organizations/%s/models/%s/stages/%s/rollback", pathParam0, pathParam1, pathParam2)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewVersionsListForModelRequest generates requests for VersionsListForModel
+func NewVersionsListForModelRequest(server string, parameterOrganization ParameterOrganization, parameterModel ParameterModel, params *VersionsListForModelParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "organization", runtime.ParamLocationPath, parameterOrganization)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "model", runtime.ParamLocationPath, parameterModel)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/organizations/%s/models/%s/versions", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	queryValues := queryURL.Query()
+
+	if params.LabelSelector != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "labelSelector", runtime.ParamLocationQuery, *params.LabelSelector); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
+	queryURL.RawQuery = queryValues.Encode()
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewVersionsCreateForModelRequest calls the generic VersionsCreateForModel builder with application/json body
+func NewVersionsCreateForModelRequest(server string, parameterOrganization ParameterOrganization, parameterModel ParameterModel, body VersionsCreateForModelJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewVersionsCreateForModelRequestWithBody(server, parameterOrganization, parameterModel, "application/json", bodyReader)
+}
+
+// NewVersionsCreateForModelRequestWithBody generates requests for VersionsCreateForModel with any type of body
+func NewVersionsCreateForModelRequestWithBody(server string, parameterOrganization ParameterOrganization, parameterModel ParameterModel, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "organization", runtime.ParamLocationPath, parameterOrganization)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "model", runtime.ParamLocationPath, parameterModel)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/organizations/%s/models/%s/versions", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewVersionsGetForModelRequest generates requests for VersionsGetForModel
+func NewVersionsGetForModelRequest(server string, parameterOrganization ParameterOrganization, parameterModel ParameterModel, parameterVersion ParameterVersion, params *VersionsGetForModelParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "organization", runtime.ParamLocationPath, parameterOrganization)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "model", runtime.ParamLocationPath, parameterModel)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam2 string
+
+	pathParam2, err = runtime.StyleParamWithLocation("simple", false, "version", runtime.ParamLocationPath, parameterVersion)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/organizations/%s/models/%s/versions/%s", pathParam0, pathParam1, pathParam2)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if params.IfNoneMatch != nil {
+		var headerParam0 string
+
+		headerParam0, err = runtime.StyleParamWithLocation("simple", false, "If-None-Match", runtime.ParamLocationHeader, *params.IfNoneMatch)
+		if err != nil {
+			return nil, err
+		}
+
+		req.Header.Set("If-None-Match", headerParam0)
+	}
+
+	return req, nil
+}
+
+// NewVersionsPatchForModelRequest calls the generic VersionsPatchForModel builder with application/merge-patch+json body
+func NewVersionsPatchForModelRequest(server string, parameterOrganization ParameterOrganization, parameterModel ParameterModel, parameterVersion ParameterVersion, params *VersionsPatchForModelParams, body VersionsPatchForModelJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewVersionsPatchForModelRequestWithBody(server, parameterOrganization, parameterModel, parameterVersion, params, "application/merge-patch+json", bodyReader)
+}
+
+// NewVersionsPatchForModelRequestWithBody generates requests for VersionsPatchForModel with any type of body
+func NewVersionsPatchForModelRequestWithBody(server string, parameterOrganization ParameterOrganization, parameterModel ParameterModel, parameterVersion ParameterVersion, params *VersionsPatchForModelParams, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "organization", runtime.ParamLocationPath, parameterOrganization)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "model", runtime.ParamLocationPath, parameterModel)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam2 string
+
+	pathParam2, err = runtime.StyleParamWithLocation("simple", false, "version", runtime.ParamLocationPath, parameterVersion)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/organizations/%s/models/%s/versions/%s", pathParam0, pathParam1, pathParam2)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PATCH", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	if params.IfMatch != nil {
+		var headerParam0 string
+
+		headerParam0, err = runtime.StyleParamWithLocation("simple", false, "If-Match", runtime.ParamLocationHeader, *params.IfMatch)
+		if err != nil {
+			return nil, err
+		}
+
+		req.Header.Set("If-Match", headerParam0)
+	}
+
+	return req, nil
+}
+
+// NewVersionsUpdateForModelRequest calls the generic VersionsUpdateForModel builder with application/json body
+func NewVersionsUpdateForModelRequest(server string, parameterOrganization ParameterOrganization, parameterModel ParameterModel, parameterVersion ParameterVersion, params *VersionsUpdateForModelParams, body VersionsUpdateForModelJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewVersionsUpdateForModelRequestWithBody(server, parameterOrganization, parameterModel, parameterVersion, params, "application/json", bodyReader)
+}
+
+// NewVersionsUpdateForModelRequestWithBody generates requests for VersionsUpdateForModel with any type of body
+func NewVersionsUpdateForModelRequestWithBody(server string, parameterOrganization ParameterOrganization, parameterModel ParameterModel, parameterVersion ParameterVersion, params *VersionsUpdateForModelParams, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "organization", runtime.ParamLocationPath, parameterOrganization)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "model", runtime.ParamLocationPath, parameterModel)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam2 string
+
+	pathParam2, err = runtime.StyleParamWithLocation("simple", false, "version", runtime.ParamLocationPath, parameterVersion)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/organizations/%s/models/%s/versions/%s", pathParam0, pathParam1, pathParam2)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	if params.IfMatch != nil {
+		var headerParam0 string
+
+		headerParam0, err = runtime.StyleParamWithLocation("simple", false, "If-Match", runtime.ParamLocationHeader, *params.IfMatch)
+		if err != nil {
+			return nil, err
+		}
+
+		req.Header.Set("If-Match", headerParam0)
+	}
+
+	return req, nil
+}
+
+// NewDriftGetForVersionRequest generates requests for DriftGetForVersion
+func NewDriftGetForVersionRequest(server string, parameterOrganization ParameterOrganization, parameterModel ParameterModel, parameterVersion ParameterVersion, params *DriftGetForVersionParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "organization", runtime.ParamLocationPath, parameterOrganization)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "model", runtime.ParamLocationPath, parameterModel)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam2 string
+
+	pathParam2, err = runtime.StyleParamWithLocation("simple", false, "version", runtime.ParamLocationPath, parameterVersion)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/organizations/%s/models/%s/versions/%s/drift", pathParam0, pathParam1, pathParam2)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	queryValues := queryURL.Query()
+
+	if params.Reference != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "reference", runtime.ParamLocationQuery, *params.Reference); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
+	if params.ReferenceStart != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "referenceStart", runtime.ParamLocationQuery, *params.ReferenceStart); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
+	if params.ReferenceEnd != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "referenceEnd", runtime.ParamLocationQuery, *params.ReferenceEnd); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
+	if params.Start != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "start", runtime.ParamLocationQuery, *params.Start); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
+	if params.End != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "end", runtime.ParamLocationQuery, *params.End); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
+	if params.Bins != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "bins", runtime.ParamLocationQuery, *params.Bins); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
+	if params.LabelSelector != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "labelSelector", runtime.ParamLocationQuery, *params.LabelSelector); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
+	queryURL.RawQuery = queryValues.Encode()
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewMetricsGetForVersionRequest generates requests for MetricsGetForVersion
+func NewMetricsGetForVersionRequest(server string, parameterOrganization ParameterOrganization, parameterModel ParameterModel, parameterVersion ParameterVersion, params *MetricsGetForVersionParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "organization", runtime.ParamLocationPath, parameterOrganization)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "model", runtime.ParamLocationPath, parameterModel)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam2 string
+
+	pathParam2, err = runtime.StyleParamWithLocation("simple", false, "version", runtime.ParamLocationPath, parameterVersion)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/organizations/%s/models/%s/versions/%s/metrics", pathParam0, pathParam1, pathParam2)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	queryValues := queryURL.Query()
+
+	if params.LabelSelector != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "labelSelector", runtime.ParamLocationQuery, *params.LabelSelector); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
+	queryURL.RawQuery = queryValues.Encode()
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewResultsListForVersionRequest generates requests for ResultsListForVersion
+func NewResultsListForVersionRequest(server string, parameterOrganization ParameterOrganization, parameterModel ParameterModel, parameterVersion ParameterVersion, params *ResultsListForVersionParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "organization", runtime.ParamLocationPath, parameterOrganization)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "model", runtime.ParamLocationPath, parameterModel)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam2 string
+
+	pathParam2, err = runtime.StyleParamWithLocation("simple", false, "version", runtime.ParamLocationPath, parameterVersion)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/organizations/%s/models/%s/versions/%s/results", pathParam0, pathParam1, pathParam2)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	queryValues := queryURL.Query()
+
+	if params.LabelSelector != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "labelSelector", runtime.ParamLocationQuery, *params.LabelSelector); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
+	queryURL.RawQuery = queryValues.Encode()
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewResultsCreateForVersionRequest calls the generic ResultsCreateForVersion builder with application/json body
+func NewResultsCreateForVersionRequest(server string, parameterOrganization ParameterOrganization, parameterModel ParameterModel, parameterVersion ParameterVersion, params *ResultsCreateForVersionParams, body ResultsCreateForVersionJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewResultsCreateForVersionRequestWithBody(server, parameterOrganization, parameterModel, parameterVersion, params, "application/json", bodyReader)
+}
+
+// NewResultsCreateForVersionRequestWithBody generates requests for ResultsCreateForVersion with any type of body
+func NewResultsCreateForVersionRequestWithBody(server string, parameterOrganization ParameterOrganization, parameterModel ParameterModel, parameterVersion ParameterVersion, params *ResultsCreateForVersionParams, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "organization", runtime.ParamLocationPath, parameterOrganization)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "model", runtime.ParamLocationPath, parameterModel)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam2 string
+
+	pathParam2, err = runtime.StyleParamWithLocation("simple", false, "version", runtime.ParamLocationPath, parameterVersion)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/organizations/%s/models/%s/versions/%s/results", pathParam0, pathParam1, pathParam2)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	if params.IdempotencyKey != nil {
+		var headerParam0 string
+
+		headerParam0, err = runtime.StyleParamWithLocation("simple", false, "Idempotency-Key", runtime.ParamLocationHeader, *params.IdempotencyKey)
+		if err != nil {
+			return nil, err
+		}
+
+		req.Header.Set("Idempotency-Key", headerParam0)
+	}
+
+	return req, nil
+}
+
+// NewResultsCreateBulkForVersionRequest calls the generic ResultsCreateBulkForVersion builder with application/json body
+func NewResultsCreateBulkForVersionRequest(server string, parameterOrganization ParameterOrganization, parameterModel ParameterModel, parameterVersion ParameterVersion, params *ResultsCreateBulkForVersionParams, body ResultsCreateBulkForVersionJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewResultsCreateBulkForVersionRequestWithBody(server, parameterOrganization, parameterModel, parameterVersion, params, "application/json", bodyReader)
+}
+
+// NewResultsCreateBulkForVersionRequestWithBody generates requests for ResultsCreateBulkForVersion with any type of body
+func NewResultsCreateBulkForVersionRequestWithBody(server string, parameterOrganization ParameterOrganization, parameterModel ParameterModel, parameterVersion ParameterVersion, params *ResultsCreateBulkForVersionParams, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "organization", runtime.ParamLocationPath, parameterOrganization)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "model", runtime.ParamLocationPath, parameterModel)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam2 string
+
+	pathParam2, err = runtime.StyleParamWithLocation("simple", false, "version", runtime.ParamLocationPath, parameterVersion)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/organizations/%s/models/%s/versions/%s/results/bulk", pathParam0, pathParam1, pathParam2)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	if params.IdempotencyKey != nil {
+		var headerParam0 string
+
+		headerParam0, err = runtime.StyleParamWithLocation("simple", false, "Idempotency-Key", runtime.ParamLocationHeader, *params.IdempotencyKey)
+		if err != nil {
+			return nil, err
+		}
+
+		req.Header.Set("Idempotency-Key", headerParam0)
+	}
+
+	return req, nil
+}
+
+// NewResultsStreamForVersionRequest generates requests for ResultsStreamForVersion
+func NewResultsStreamForVersionRequest(server string, parameterOrganization ParameterOrganization, parameterModel ParameterModel, parameterVersion ParameterVersion, params *ResultsStreamForVersionParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "organization", runtime.ParamLocationPath, parameterOrganization)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "model", runtime.ParamLocationPath, parameterModel)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam2 string
+
+	pathParam2, err = runtime.StyleParamWithLocation("simple", false, "version", runtime.ParamLocationPath, parameterVersion)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/organizations/%s/models/%s/versions/%s/results/stream", pathParam0, pathParam1, pathParam2)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if params.LastEventID != nil {
+		var headerParam0 string
+
+		headerParam0, err = runtime.StyleParamWithLocation("simple", false, "Last-Event-ID", runtime.ParamLocationHeader, *params.LastEventID)
+		if err != nil {
+			return nil, err
+		}
+
+		req.Header.Set("Last-Event-ID", headerParam0)
+	}
+
+	return req, nil
+}
+
+// NewResultsGetForVersionRequest generates requests for ResultsGetForVersion
+func NewResultsGetForVersionRequest(server string, parameterOrganization ParameterOrganization, parameterModel ParameterModel, parameterVersion ParameterVersion, parameterResult ParameterResult) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "organization", runtime.ParamLocationPath, parameterOrganization)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "model", runtime.ParamLocationPath, parameterModel)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam2 string
+
+	pathParam2, err = runtime.StyleParamWithLocation("simple", false, "version", runtime.ParamLocationPath, parameterVersion)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam3 string
+
+	pathParam3, err = runtime.StyleParamWithLocation("simple", false, "result", runtime.ParamLocationPath, parameterResult)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/organizations/%s/models/%s/versions/%s/results/%s", pathParam0, pathParam1, pathParam2, pathParam3)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewSchemasListForOrganizationRequest generates requests for SchemasListForOrganization
+func NewSchemasListForOrganizationRequest(server string, parameterOrganization ParameterOrganization) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "organization", runtime.ParamLocationPath, parameterOrganization)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/organizations/%s/schemas", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewSchemasCreateForOrganizationRequest calls the generic SchemasCreateForOrganization builder with application/json body
+func NewSchemasCreateForOrganizationRequest(server string, parameterOrganization ParameterOrganization, body SchemasCreateForOrganizationJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewSchemasCreateForOrganizationRequestWithBody(server, parameterOrganization, "application/json", bodyReader)
+}
+
+// NewSchemasCreateForOrganizationRequestWithBody generates requests for SchemasCreateForOrganization with any type of body
+func NewSchemasCreateForOrganizationRequestWithBody(server string, parameterOrganization ParameterOrganization, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "organization", runtime.ParamLocationPath, parameterOrganization)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/organizations/%s/schemas", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewSchemasGetForOrganizationRequest generates requests for SchemasGetForOrganization
+func NewSchemasGetForOrganizationRequest(server string, parameterOrganization ParameterOrganization, parameterSchema ParameterSchema, params *SchemasGetForOrganizationParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "organization", runtime.ParamLocationPath, parameterOrganization)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "schema", runtime.ParamLocationPath, parameterSchema)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/organizations/%s/schemas/%s", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if params.IfNoneMatch != nil {
+		var headerParam0 string
+
+		headerParam0, err = runtime.StyleParamWithLocation("simple", false, "If-None-Match", runtime.ParamLocationHeader, *params.IfNoneMatch)
+		if err != nil {
+			return nil, err
+		}
+
+		req.Header.Set("If-None-Match", headerParam0)
+	}
+
+	return req, nil
+}
+
+// NewWebhooksListForOrganizationRequest generates requests for WebhooksListForOrganization
+func NewWebhooksListForOrganizationRequest(server string, parameterOrganization ParameterOrganization) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "organization", runtime.ParamLocationPath, parameterOrganization)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/organizations/%s/webhooks", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewWebhooksCreateForOrganizationRequest calls the generic WebhooksCreateForOrganization builder with application/json body
+func NewWebhooksCreateForOrganizationRequest(server string, parameterOrganization ParameterOrganization, body WebhooksCreateForOrganizationJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewWebhooksCreateForOrganizationRequestWithBody(server, parameterOrganization, "application/json", bodyReader)
+}
+
+// NewWebhooksCreateForOrganizationRequestWithBody generates requests for WebhooksCreateForOrganization with any type of body
+func NewWebhooksCreateForOrganizationRequestWithBody(server string, parameterOrganization ParameterOrganization, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "organization", runtime.ParamLocationPath, parameterOrganization)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/organizations/%s/webhooks", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewWebhooksDeleteForOrganizationRequest generates requests for WebhooksDeleteForOrganization
+func NewWebhooksDeleteForOrganizationRequest(server string, parameterOrganization ParameterOrganization, parameterWebhook ParameterWebhook, params *WebhooksDeleteForOrganizationParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "organization", runtime.ParamLocationPath, parameterOrganization)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "webhook", runtime.ParamLocationPath, parameterWebhook)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/organizations/%s/webhooks/%s", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if params.IfMatch != nil {
+		var headerParam0 string
+
+		headerParam0, err = runtime.StyleParamWithLocation("simple", false, "If-Match", runtime.ParamLocationHeader, *params.IfMatch)
+		if err != nil {
+			return nil, err
+		}
+
+		req.Header.Set("If-Match", headerParam0)
+	}
+
+	return req, nil
+}
+
+// NewWebhooksGetForOrganizationRequest generates requests for WebhooksGetForOrganization
+func NewWebhooksGetForOrganizationRequest(server string, parameterOrganization ParameterOrganization, parameterWebhook ParameterWebhook, params *WebhooksGetForOrganizationParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "organization", runtime.ParamLocationPath, parameterOrganization)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "webhook", runtime.ParamLocationPath, parameterWebhook)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/organizations/%s/webhooks/%s", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if params.IfNoneMatch != nil {
+		var headerParam0 string
+
+		headerParam0, err = runtime.StyleParamWithLocation("simple", false, "If-None-Match", runtime.ParamLocationHeader, *params.IfNoneMatch)
+		if err != nil {
+			return nil, err
+		}
+
+		req.Header.Set("If-None-Match", headerParam0)
+	}
+
+	return req, nil
+}
+
+// NewWebhooksDeliveriesForOrganizationRequest generates requests for WebhooksDeliveriesForOrganization
+func NewWebhooksDeliveriesForOrganizationRequest(server string, parameterOrganization ParameterOrganization, parameterWebhook ParameterWebhook, params *WebhooksDeliveriesForOrganizationParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "organization", runtime.ParamLocationPath, parameterOrganization)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "webhook", runtime.ParamLocationPath, parameterWebhook)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/organizations/%s/webhooks/%s/deliveries", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	queryValues := queryURL.Query()
+
+	if params.Limit != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
+	queryURL.RawQuery = queryValues.Encode()
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+func (c *Client) applyEditors(ctx context.Context, req *http.Request, additionalEditors []RequestEditorFn) error {
+	for _, r := range c.RequestEditors {
+		if err := r(ctx, req); err != nil {
+			return err
+		}
+	}
+	for _, r := range additionalEditors {
+		if err := r(ctx, req); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ClientWithResponses builds on ClientInterface to offer response payloads
+type ClientWithResponses struct {
+	ClientInterface
+}
+
+// NewClientWithResponses creates a new ClientWithResponses, which wraps
+// Client with return type handling
+func NewClientWithResponses(server string, opts ...ClientOption) (*ClientWithResponses, error) {
+	client, err := NewClient(server, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &ClientWithResponses{client}, nil
+}
+
+// WithBaseURL overrides the baseURL.
+func WithBaseURL(baseURL string) ClientOption {
+	return func(c *Client) error {
+		newBaseURL, err := url.Parse(baseURL)
+		if err != nil {
+			return err
+		}
+		c.Server = newBaseURL.String()
+		return nil
+	}
+}
+
+// ClientWithResponsesInterface is the interface specification for the client with responses above.
+type ClientWithResponsesInterface interface {
+	// OrganizationsCreate request with any body
+	OrganizationsCreateWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*OrganizationsCreateResponse, error)
+
+	OrganizationsCreateWithResponse(ctx context.Context, body OrganizationsCreateJSONRequestBody, reqEditors ...RequestEditorFn) (*OrganizationsCreateResponse, error)
+
+	// ModelsListForOrganization request
+	ModelsListForOrganizationWithResponse(ctx context.Context, parameterOrganization ParameterOrganization, reqEditors ...RequestEditorFn) (*ModelsListForOrganizationResponse, error)
+
+	// ModelsCreateForOrganization request with any body
+	ModelsCreateForOrganizationWithBodyWithResponse(ctx context.Context, parameterOrganization ParameterOrganization, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ModelsCreateForOrganizationResponse, error)
+
+	ModelsCreateForOrganizationWithResponse(ctx context.Context, parameterOrganization ParameterOrganization, body ModelsCreateForOrganizationJSONRequestBody, reqEditors ...RequestEditorFn) (*ModelsCreateForOrganizationResponse, error)
+
+	// ModelsGetForOrganization request
+	ModelsGetForOrganizationWithResponse(ctx context.Context, parameterOrganization ParameterOrganization, parameterModel ParameterModel, params *ModelsGetForOrganizationParams, reqEditors ...RequestEditorFn) (*ModelsGetForOrganizationResponse, error)
+
+	// ModelsPatchForOrganization request with any body
+	ModelsPatchForOrganizationWithBodyWithResponse(ctx context.Context, parameterOrganization ParameterOrganization, parameterModel ParameterModel, params *ModelsPatchForOrganizationParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ModelsPatchForOrganizationResponse, error)
+
+	ModelsPatchForOrganizationWithResponse(ctx context.Context, parameterOrganization ParameterOrganization, parameterModel ParameterModel, params *ModelsPatchForOrganizationParams, body ModelsPatchForOrganizationJSONRequestBody, reqEditors ...RequestEditorFn) (*ModelsPatchForOrganizationResponse, error)
+
+	// ModelsUpdateForOrganization request with any body
+	ModelsUpdateForOrganizationWithBodyWithResponse(ctx context.Context, parameterOrganization ParameterOrganization, parameterModel ParameterModel, params *ModelsUpdateForOrganizationParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ModelsUpdateForOrganizationResponse, error)
+
+	ModelsUpdateForOrganizationWithResponse(ctx context.Context, parameterOrganization ParameterOrganization, parameterModel ParameterModel, params *ModelsUpdateForOrganizationParams, body ModelsUpdateForOrganizationJSONRequestBody, reqEditors ...RequestEditorFn) (*ModelsUpdateForOrganizationResponse, error)
+
+	// AlertRulesListForModel request
+	AlertRulesListForModelWithResponse(ctx context.Context, parameterOrganization ParameterOrganization, parameterModel ParameterModel, reqEditors ...RequestEditorFn) (*AlertRulesListForModelResponse, error)
+
+	// AlertRulesCreateForModel request with any body
+	AlertRulesCreateForModelWithBodyWithResponse(ctx context.Context, parameterOrganization ParameterOrganization, parameterModel ParameterModel, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*AlertRulesCreateForModelResponse, error)
+
+	AlertRulesCreateForModelWithResponse(ctx context.Context, parameterOrganization ParameterOrganization, parameterModel ParameterModel, body AlertRulesCreateForModelJSONRequestBody, reqEditors ...RequestEditorFn) (*AlertRulesCreateForModelResponse, error)
+
+	// AlertRulesDeleteForModel request
+	AlertRulesDeleteForModelWithResponse(ctx context.Context, parameterOrganization ParameterOrganization, parameterModel ParameterModel, parameterAlertRule ParameterAlertRule, params *AlertRulesDeleteForModelParams, reqEditors ...RequestEditorFn) (*AlertRulesDeleteForModelResponse, error)
+
+	// AlertRulesGetForModel request
+	AlertRulesGetForModelWithResponse(ctx context.Context, parameterOrganization ParameterOrganization, parameterModel ParameterModel, parameterAlertRule ParameterAlertRule, params *AlertRulesGetForModelParams, reqEditors ...RequestEditorFn) (*AlertRulesGetForModelResponse, error)
+
+	// ComparisonGetForModel request
+	ComparisonGetForModelWithResponse(ctx context.Context, parameterOrganization ParameterOrganization, parameterModel ParameterModel, params *ComparisonGetForModelParams, reqEditors ...RequestEditorFn) (*ComparisonGetForModelResponse, error)
+
+	// MetricsGetForModel request
+	MetricsGetForModelWithResponse(ctx context.Context, parameterOrganization ParameterOrganization, parameterModel ParameterModel, params *MetricsGetForModelParams, reqEditors ...RequestEditorFn) (*MetricsGetForModelResponse, error)
+
+	// ResultsListForModel request
+	ResultsListForModelWithResponse(ctx context.Context, parameterOrganization ParameterOrganization, parameterModel ParameterModel, params *ResultsListForModelParams, reqEditors ...RequestEditorFn) (*ResultsListForModelResponse, error)
+
+	// ResultsCreateForModel request with any body
+	ResultsCreateForModelWithBodyWithResponse(ctx context.Context, parameterOrganization ParameterOrganization, parameterModel ParameterModel, params *ResultsCreateForModelParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ResultsCreateForModelResponse, error)
+
+	ResultsCreateForModelWithResponse(ctx context.Context, parameterOrganization ParameterOrganization, parameterModel ParameterModel, params *ResultsCreateForModelParams, body ResultsCreateForModelJSONRequestBody, reqEditors ...RequestEditorFn) (*ResultsCreateForModelResponse, error)
+
+	// ResultsStreamForModel request
+	ResultsStreamForModelWithResponse(ctx context.Context, parameterOrganization ParameterOrganization, parameterModel ParameterModel, params *ResultsStreamForModelParams, reqEditors ...RequestEditorFn) (*ResultsStreamForModelResponse, error)
+
+	// StagesListForModel request
+	StagesListForModelWithResponse(ctx context.Context, parameterOrganization ParameterOrganization, parameterModel ParameterModel, reqEditors ...RequestEditorFn) (*StagesListForModelResponse, error)
+
+	// StagesGetForModel request
+	StagesGetForModelWithResponse(ctx context.Context, parameterOrganization ParameterOrganization, parameterModel ParameterModel, parameterStage ParameterStage, params *StagesGetForModelParams, reqEditors ...RequestEditorFn) (*StagesGetForModelResponse, error)
+
+	// StagesHistoryForModel request
+	StagesHistoryForModelWithResponse(ctx context.Context, parameterOrganization ParameterOrganization, parameterModel ParameterModel, parameterStage ParameterStage, reqEditors ...RequestEditorFn) (*StagesHistoryForModelResponse, error)
+
+	// StagesPromoteForModel request with any body
+	StagesPromoteForModelWithBodyWithResponse(ctx context.Context, parameterOrganization ParameterOrganization, parameterModel ParameterModel, parameterStage ParameterStage, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*StagesPromoteForModelResponse, error)
+
+	StagesPromoteForModelWithResponse(ctx context.Context, parameterOrganization ParameterOrganization, parameterModel ParameterModel, parameterStage ParameterStage, body StagesPromoteForModelJSONRequestBody, reqEditors ...RequestEditorFn) (*StagesPromoteForModelResponse, error)
+
+	// StagesRollbackForModel request
+	StagesRollbackForModelWithResponse(ctx context.Context, parameterOrganization ParameterOrganization, parameterModel ParameterModel, parameterStage ParameterStage, reqEditors ...RequestEditorFn) (*StagesRollbackForModelResponse, error)
+
+	// VersionsListForModel request
+	VersionsListForModelWithResponse(ctx context.Context, parameterOrganization ParameterOrganization, parameterModel ParameterModel, params *VersionsListForModelParams, reqEditors ...RequestEditorFn) (*VersionsListForModelResponse, error)
+
+	// VersionsCreateForModel request with any body
+	VersionsCreateForModelWithBodyWithResponse(ctx context.Context, parameterOrganization ParameterOrganization, parameterModel ParameterModel, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*VersionsCreateForModelResponse, error)
+
+	VersionsCreateForModelWithResponse(ctx context.Context, parameterOrganization ParameterOrganization, parameterModel ParameterModel, body VersionsCreateForModelJSONRequestBody, reqEditors ...RequestEditorFn) (*VersionsCreateForModelResponse, error)
+
+	// VersionsGetForModel request
+	VersionsGetForModelWithResponse(ctx context.Context, parameterOrganization ParameterOrganization, parameterModel ParameterModel, parameterVersion ParameterVersion, params *VersionsGetForModelParams, reqEditors ...RequestEditorFn) (*VersionsGetForModelResponse, error)
+
+	// VersionsPatchForModel request with any body
+	VersionsPatchForModelWithBodyWithResponse(ctx context.Context, parameterOrganization ParameterOrganization, parameterModel ParameterModel, parameterVersion ParameterVersion, params *VersionsPatchForModelParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*VersionsPatchForModelResponse, error)
+
+	VersionsPatchForModelWithResponse(ctx context.Context, parameterOrganization ParameterOrganization, parameterModel ParameterModel, parameterVersion ParameterVersion, params *VersionsPatchForModelParams, body VersionsPatchForModelJSONRequestBody, reqEditors ...RequestEditorFn) (*VersionsPatchForModelResponse, error)
+
+	// VersionsUpdateForModel request with any body
+	VersionsUpdateForModelWithBodyWithResponse(ctx context.Context, parameterOrganization ParameterOrganization, parameterModel ParameterModel, parameterVersion ParameterVersion, params *VersionsUpdateForModelParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*VersionsUpdateForModelResponse, error)
+
+	VersionsUpdateForModelWithResponse(ctx context.Context, parameterOrganization ParameterOrganization, parameterModel ParameterModel, parameterVersion ParameterVersion, params *VersionsUpdateForModelParams, body VersionsUpdateForModelJSONRequestBody, reqEditors ...RequestEditorFn) (*VersionsUpdateForModelResponse, error)
+
+	// DriftGetForVersion request
+	DriftGetForVersionWithResponse(ctx context.Context, parameterOrganization ParameterOrganization, parameterModel ParameterModel, parameterVersion ParameterVersion, params *DriftGetForVersionParams, reqEditors ...RequestEditorFn) (*DriftGetForVersionResponse, error)
+
+	// MetricsGetForVersion request
+	MetricsGetForVersionWithResponse(ctx context.Context, parameterOrganization ParameterOrganization, parameterModel ParameterModel, parameterVersion ParameterVersion, params *MetricsGetForVersionParams, reqEditors ...RequestEditorFn) (*MetricsGetForVersionResponse, error)
+
+	// ResultsListForVersion request
+	ResultsListForVersionWithResponse(ctx context.Context, parameterOrganization ParameterOrganization, parameterModel ParameterModel, parameterVersion ParameterVersion, params *ResultsListForVersionParams, reqEditors ...RequestEditorFn) (*ResultsListForVersionResponse, error)
+
+	// ResultsCreateForVersion request with any body
+	ResultsCreateForVersionWithBodyWithResponse(ctx context.Context, parameterOrganization ParameterOrganization, parameterModel ParameterModel, parameterVersion ParameterVersion, params *ResultsCreateForVersionParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ResultsCreateForVersionResponse, error)
+
+	ResultsCreateForVersionWithResponse(ctx context.Context, parameterOrganization ParameterOrganization, parameterModel ParameterModel, parameterVersion ParameterVersion, params *ResultsCreateForVersionParams, body ResultsCreateForVersionJSONRequestBody, reqEditors ...RequestEditorFn) (*ResultsCreateForVersionResponse, error)
+
+	// ResultsCreateBulkForVersion request with any body
+	ResultsCreateBulkForVersionWithBodyWithResponse(ctx context.Context, parameterOrganization ParameterOrganization, parameterModel ParameterModel, parameterVersion ParameterVersion, params *ResultsCreateBulkForVersionParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ResultsCreateBulkForVersionResponse, error)
+
+	ResultsCreateBulkForVersionWithResponse(ctx context.Context, parameterOrganization ParameterOrganization, parameterModel ParameterModel, parameterVersion ParameterVersion, params *ResultsCreateBulkForVersionParams, body ResultsCreateBulkForVersionJSONRequestBody, reqEditors ...RequestEditorFn) (*ResultsCreateBulkForVersionResponse, error)
+
+	// ResultsStreamForVersion request
+	ResultsStreamForVersionWithResponse(ctx context.Context, parameterOrganization ParameterOrganization, parameterModel ParameterModel, parameterVersion ParameterVersion, params *ResultsStreamForVersionParams, reqEditors ...RequestEditorFn) (*ResultsStreamForVersionResponse, error)
+
+	// ResultsGetForVersion request
+	ResultsGetForVersionWithResponse(ctx context.Context, parameterOrganization ParameterOrganization, parameterModel ParameterModel, parameterVersion ParameterVersion, parameterResult ParameterResult, reqEditors ...RequestEditorFn) (*ResultsGetForVersionResponse, error)
+
+	// SchemasListForOrganization request
+	SchemasListForOrganizationWithResponse(ctx context.Context, parameterOrganization ParameterOrganization, reqEditors ...RequestEditorFn) (*SchemasListForOrganizationResponse, error)
+
+	// SchemasCreateForOrganization request with any body
+	SchemasCreateForOrganizationWithBodyWithResponse(ctx context.Context, parameterOrganization ParameterOrganization, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*SchemasCreateForOrganizationResponse, error)
+
+	SchemasCreateForOrganizationWithResponse(ctx context.Context, parameterOrganization ParameterOrganization, body SchemasCreateForOrganizationJSONRequestBody, reqEditors ...RequestEditorFn) (*SchemasCreateForOrganizationResponse, error)
+
+	// SchemasGetForOrganization request
+	SchemasGetForOrganizationWithResponse(ctx context.Context, parameterOrganization ParameterOrganization, parameterSchema ParameterSchema, params *SchemasGetForOrganizationParams, reqEditors ...RequestEditorFn) (*SchemasGetForOrganizationResponse, error)
+
+	// WebhooksListForOrganization request
+	WebhooksListForOrganizationWithResponse(ctx context.Context, parameterOrganization ParameterOrganization, reqEditors ...RequestEditorFn) (*WebhooksListForOrganizationResponse, error)
+
+	// WebhooksCreateForOrganization request with any body
+	WebhooksCreateForOrganizationWithBodyWithResponse(ctx context.Context, parameterOrganization ParameterOrganization, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*WebhooksCreateForOrganizationResponse, error)
+
+	WebhooksCreateForOrganizationWithResponse(ctx context.Context, parameterOrganization ParameterOrganization, body WebhooksCreateForOrganizationJSONRequestBody, reqEditors ...RequestEditorFn) (*WebhooksCreateForOrganizationResponse, error)
+
+	// WebhooksDeleteForOrganization request
+	WebhooksDeleteForOrganizationWithResponse(ctx context.Context, parameterOrganization ParameterOrganization, parameterWebhook ParameterWebhook, params *WebhooksDeleteForOrganizationParams, reqEditors ...RequestEditorFn) (*WebhooksDeleteForOrganizationResponse, error)
+
+	// WebhooksGetForOrganization request
+	WebhooksGetForOrganizationWithResponse(ctx context.Context, parameterOrganization ParameterOrganization, parameterWebhook ParameterWebhook, params *WebhooksGetForOrganizationParams, reqEditors ...RequestEditorFn) (*WebhooksGetForOrganizationResponse, error)
+
+	// WebhooksDeliveriesForOrganization request
+	WebhooksDeliveriesForOrganizationWithResponse(ctx context.Context, parameterOrganization ParameterOrganization, parameterWebhook ParameterWebhook, params *WebhooksDeliveriesForOrganizationParams, reqEditors ...RequestEditorFn) (*WebhooksDeliveriesForOrganizationResponse, error)
+}
+
+type OrganizationsCreateResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *Organization
+	JSON401      *Problem
+	JSON403      *Problem
+	JSON422      *Problem
+	JSON500      *Problem
+}
+
+// Status returns HTTPResponse.Status
+func (r OrganizationsCreateResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r OrganizationsCreateResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ModelsListForOrganizationResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]Model
+	JSON401      *Problem
+	JSON403      *Problem
+	JSON404      *Problem
+	JSON500      *Problem
+}
+
+// Status returns HTTPResponse.Status
+func (r ModelsListForOrganizationResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ModelsListForOrganizationResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ModelsCreateForOrganizationResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *Model
+	JSON401      *Problem
+	JSON403      *Problem
+	JSON422      *Problem
+	JSON500      *Problem
+}
+
+// Status returns HTTPResponse.Status
+func (r ModelsCreateForOrganizationResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ModelsCreateForOrganizationResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ModelsGetForOrganizationResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Model
+	JSON401      *Problem
+	JSON403      *Problem
+	JSON404      *Problem
+	JSON500      *Problem
+}
+
+// Status returns HTTPResponse.Status
+func (r ModelsGetForOrganizationResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ModelsGetForOrganizationResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ModelsPatchForOrganizationResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Model
+	JSON400      *Problem
+	JSON401      *Problem
+	JSON403      *Problem
+	JSON404      *Problem
+	JSON412      *Problem
+	JSON422      *Problem
+	JSON500      *Problem
+}
+
+// Status returns HTTPResponse.Status
+func (r ModelsPatchForOrganizationResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ModelsPatchForOrganizationResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ModelsUpdateForOrganizationResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Model
+	JSON401      *Problem
+	JSON403      *Problem
+	JSON412      *Problem
+	JSON422      *Problem
+	JSON500      *Problem
+}
+
+// Status returns HTTPResponse.Status
+func (r ModelsUpdateForOrganizationResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ModelsUpdateForOrganizationResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type AlertRulesListForModelResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]AlertRule
+	JSON401      *Problem
+	JSON403      *Problem
+	JSON404      *Problem
+	JSON500      *Problem
+}
+
+// Status returns HTTPResponse.Status
+func (r AlertRulesListForModelResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r AlertRulesListForModelResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type AlertRulesCreateForModelResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *AlertRule
+	JSON401      *Problem
+	JSON403      *Problem
+	JSON404      *Problem
+	JSON422      *Problem
+	JSON500      *Problem
+}
+
+// Status returns HTTPResponse.Status
+func (r AlertRulesCreateForModelResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r AlertRulesCreateForModelResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type AlertRulesDeleteForModelResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON401      *Problem
+	JSON403      *Problem
+	JSON404      *Problem
+	JSON412      *Problem
+	JSON500      *Problem
+}
+
+// Status returns HTTPResponse.Status
+func (r AlertRulesDeleteForModelResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r AlertRulesDeleteForModelResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type AlertRulesGetForModelResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *AlertRule
+	JSON401      *Problem
+	JSON403      *Problem
+	JSON404      *Problem
+	JSON500      *Problem
+}
+
+// Status returns HTTPResponse.Status
+func (r AlertRulesGetForModelResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r AlertRulesGetForModelResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ComparisonGetForModelResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Comparison
+	JSON401      *Problem
+	JSON403      *Problem
+	JSON404      *Problem
+	JSON422      *Problem
+	JSON500      *Problem
+}
+
+// Status returns HTTPResponse.Status
+func (r ComparisonGetForModelResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ComparisonGetForModelResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type MetricsGetForModelResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Metrics
+	JSON401      *Problem
+	JSON403      *Problem
+	JSON404      *Problem
+	JSON422      *Problem
+	JSON500      *Problem
+}
+
+// Status returns HTTPResponse.Status
+func (r MetricsGetForModelResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r MetricsGetForModelResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ResultsListForModelResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]Result
+	JSON401      *Problem
+	JSON403      *Problem
+	JSON404      *Problem
+	JSON500      *Problem
+}
+
+// Status returns HTTPResponse.Status
+func (r ResultsListForModelResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ResultsListForModelResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ResultsCreateForModelResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *Result
+	JSON202      *NewResult
+	JSON401      *Problem
+	JSON403      *Problem
+	JSON404      *Problem
+	JSON409      *Problem
+	JSON422      *Problem
+	JSON500      *Problem
+	JSON503      *Problem
+}
+
+// Status returns HTTPResponse.Status
+func (r ResultsCreateForModelResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ResultsCreateForModelResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ResultsStreamForModelResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON401      *Problem
+	JSON403      *Problem
+	JSON404      *Problem
+	JSON500      *Problem
+	JSON501      *Problem
+}
+
+// Status returns HTTPResponse.Status
+func (r ResultsStreamForModelResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ResultsStreamForModelResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type StagesListForModelResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]Stage
+	JSON401      *Problem
+	JSON403      *Problem
+	JSON404      *Problem
+	JSON500      *Problem
+}
+
+// Status returns HTTPResponse.Status
+func (r StagesListForModelResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r StagesListForModelResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type StagesGetForModelResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Stage
+	JSON401      *Problem
+	JSON403      *Problem
+	JSON404      *Problem
+	JSON500      *Problem
+}
+
+// Status returns HTTPResponse.Status
+func (r StagesGetForModelResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r StagesGetForModelResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type StagesHistoryForModelResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]StageHistoryEntry
+	JSON401      *Problem
+	JSON403      *Problem
+	JSON404      *Problem
+	JSON500      *Problem
+}
+
+// Status returns HTTPResponse.Status
+func (r StagesHistoryForModelResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r StagesHistoryForModelResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type StagesPromoteForModelResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Stage
+	JSON401      *Problem
+	JSON403      *Problem
+	JSON404      *Problem
+	JSON422      *Problem
+	JSON500      *Problem
+}
+
+// Status returns HTTPResponse.Status
+func (r StagesPromoteForModelResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r StagesPromoteForModelResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type StagesRollbackForModelResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Stage
+	JSON401      *Problem
+	JSON403      *Problem
+	JSON404      *Problem
+	JSON409      *Problem
+	JSON500      *Problem
+}
+
+// Status returns HTTPResponse.Status
+func (r StagesRollbackForModelResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r StagesRollbackForModelResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type VersionsListForModelResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]Version
+	JSON401      *Problem
+	JSON403      *Problem
+	JSON404      *Problem
+	JSON500      *Problem
+}
+
+// Status returns HTTPResponse.Status
+func (r VersionsListForModelResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r VersionsListForModelResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type VersionsCreateForModelResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *Version
+	JSON401      *Problem
+	JSON403      *Problem
+	JSON422      *Problem
+	JSON500      *Problem
+}
+
+// Status returns HTTPResponse.Status
+func (r VersionsCreateForModelResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r VersionsCreateForModelResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type VersionsGetForModelResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Version
+	JSON401      *Problem
+	JSON403      *Problem
+	JSON404      *Problem
+	JSON500      *Problem
+}
+
+// Status returns HTTPResponse.Status
+func (r VersionsGetForModelResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r VersionsGetForModelResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type VersionsPatchForModelResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Version
+	JSON400      *Problem
+	JSON401      *Problem
+	JSON403      *Problem
+	JSON404      *Problem
+	JSON412      *Problem
+	JSON422      *Problem
+	JSON500      *Problem
+}
+
+// Status returns HTTPResponse.Status
+func (r VersionsPatchForModelResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r VersionsPatchForModelResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type VersionsUpdateForModelResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Version
+	JSON401      *Problem
+	JSON403      *Problem
+	JSON404      *Problem
+	JSON412      *Problem
+	JSON422      *Problem
+	JSON500      *Problem
+}
+
+// Status returns HTTPResponse.Status
+func (r VersionsUpdateForModelResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r VersionsUpdateForModelResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DriftGetForVersionResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *DriftReport
+	JSON401      *Problem
+	JSON403      *Problem
+	JSON404      *Problem
+	JSON422      *Problem
+	JSON500      *Problem
+}
+
+// Status returns HTTPResponse.Status
+func (r DriftGetForVersionResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DriftGetForVersionResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type MetricsGetForVersionResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Metrics
+	JSON401      *Problem
+	JSON403      *Problem
+	JSON404      *Problem
+	JSON422      *Problem
+	JSON500      *Problem
+}
+
+// Status returns HTTPResponse.Status
+func (r MetricsGetForVersionResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r MetricsGetForVersionResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ResultsListForVersionResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]Result
+	JSON401      *Problem
+	JSON403      *Problem
+	JSON404      *Problem
+	JSON500      *Problem
+}
+
+// Status returns HTTPResponse.Status
+func (r ResultsListForVersionResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ResultsListForVersionResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ResultsCreateForVersionResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *Result
+	JSON202      *NewResult
+	JSON401      *Problem
+	JSON403      *Problem
+	JSON409      *Problem
+	JSON422      *Problem
+	JSON500      *Problem
+	JSON503      *Problem
+}
+
+// Status returns HTTPResponse.Status
+func (r ResultsCreateForVersionResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ResultsCreateForVersionResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ResultsCreateBulkForVersionResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *BulkResults
+	JSON401      *Problem
+	JSON403      *Problem
+	JSON404      *Problem
+	JSON409      *Problem
+	JSON413      *Problem
+	JSON422      *Problem
+	JSON500      *Problem
+}
+
+// Status returns HTTPResponse.Status
+func (r ResultsCreateBulkForVersionResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ResultsCreateBulkForVersionResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ResultsStreamForVersionResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON401      *Problem
+	JSON403      *Problem
+	JSON404      *Problem
+	JSON500      *Problem
+	JSON501      *Problem
+}
+
+// Status returns HTTPResponse.Status
+func (r ResultsStreamForVersionResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ResultsStreamForVersionResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ResultsGetForVersionResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Result
+	JSON401      *Problem
+	JSON403      *Problem
+	JSON404      *Problem
+	JSON500      *Problem
+}
+
+// Status returns HTTPResponse.Status
+func (r ResultsGetForVersionResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ResultsGetForVersionResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type SchemasListForOrganizationResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]Schema
+	JSON401      *Problem
+	JSON403      *Problem
+	JSON404      *Problem
+	JSON500      *Problem
+}
+
+// Status returns HTTPResponse.Status
+func (r SchemasListForOrganizationResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r SchemasListForOrganizationResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type SchemasCreateForOrganizationResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON201      *Model
-	JSON401      *Error
-	JSON403      *Error
-	JSON422      *Error
-	JSON500      *Error
+	JSON201      *Schema
+	JSON401      *Problem
+	JSON403      *Problem
+	JSON422      *Problem
+	JSON500      *Problem
+}
+
+// Status returns HTTPResponse.Status
+func (r SchemasCreateForOrganizationResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r SchemasCreateForOrganizationResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type SchemasGetForOrganizationResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Schema
+	JSON401      *Problem
+	JSON403      *Problem
+	JSON404      *Problem
+	JSON500      *Problem
+}
+
+// Status returns HTTPResponse.Status
+func (r SchemasGetForOrganizationResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r SchemasGetForOrganizationResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type WebhooksListForOrganizationResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]Webhook
+	JSON401      *Problem
+	JSON403      *Problem
+	JSON404      *Problem
+	JSON500      *Problem
+}
+
+// Status returns HTTPResponse.Status
+func (r WebhooksListForOrganizationResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r WebhooksListForOrganizationResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type WebhooksCreateForOrganizationResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *Webhook
+	JSON401      *Problem
+	JSON403      *Problem
+	JSON404      *Problem
+	JSON422      *Problem
+	JSON500      *Problem
+}
+
+// Status returns HTTPResponse.Status
+func (r WebhooksCreateForOrganizationResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r WebhooksCreateForOrganizationResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type WebhooksDeleteForOrganizationResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON401      *Problem
+	JSON403      *Problem
+	JSON404      *Problem
+	JSON412      *Problem
+	JSON500      *Problem
+}
+
+// Status returns HTTPResponse.Status
+func (r WebhooksDeleteForOrganizationResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r WebhooksDeleteForOrganizationResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type WebhooksGetForOrganizationResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Webhook
+	JSON401      *Problem
+	JSON403      *Problem
+	JSON404      *Problem
+	JSON500      *Problem
+}
+
+// Status returns HTTPResponse.Status
+func (r WebhooksGetForOrganizationResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r WebhooksGetForOrganizationResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type WebhooksDeliveriesForOrganizationResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]WebhookDelivery
+	JSON401      *Problem
+	JSON403      *Problem
+	JSON404      *Problem
+	JSON422      *Problem
+	JSON500      *Problem
+}
+
+// Status returns HTTPResponse.Status
+func (r WebhooksDeliveriesForOrganizationResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r WebhooksDeliveriesForOrganizationResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// OrganizationsCreateWithBodyWithResponse request with arbitrary body returning *OrganizationsCreateResponse
+func (c *ClientWithResponses) OrganizationsCreateWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*OrganizationsCreateResponse, error) {
+	rsp, err := c.OrganizationsCreateWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseOrganizationsCreateResponse(rsp)
+}
+
+func (c *ClientWithResponses) OrganizationsCreateWithResponse(ctx context.Context, body OrganizationsCreateJSONRequestBody, reqEditors ...RequestEditorFn) (*OrganizationsCreateResponse, error) {
+	rsp, err := c.OrganizationsCreate(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseOrganizationsCreateResponse(rsp)
+}
+
+// ModelsListForOrganizationWithResponse request returning *ModelsListForOrganizationResponse
+func (c *ClientWithResponses) ModelsListForOrganizationWithResponse(ctx context.Context, parameterOrganization ParameterOrganization, reqEditors ...RequestEditorFn) (*ModelsListForOrganizationResponse, error) {
+	rsp, err := c.ModelsListForOrganization(ctx, parameterOrganization, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseModelsListForOrganizationResponse(rsp)
+}
+
+// ModelsCreateForOrganizationWithBodyWithResponse request with arbitrary body returning *ModelsCreateForOrganizationResponse
+func (c *ClientWithResponses) ModelsCreateForOrganizationWithBodyWithResponse(ctx context.Context, parameterOrganization ParameterOrganization, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ModelsCreateForOrganizationResponse, error) {
+	rsp, err := c.ModelsCreateForOrganizationWithBody(ctx, parameterOrganization, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseModelsCreateForOrganizationResponse(rsp)
+}
+
+func (c *ClientWithResponses) ModelsCreateForOrganizationWithResponse(ctx context.Context, parameterOrganization ParameterOrganization, body ModelsCreateForOrganizationJSONRequestBody, reqEditors ...RequestEditorFn) (*ModelsCreateForOrganizationResponse, error) {
+	rsp, err := c.ModelsCreateForOrganization(ctx, parameterOrganization, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseModelsCreateForOrganizationResponse(rsp)
+}
+
+// ModelsGetForOrganizationWithResponse request returning *ModelsGetForOrganizationResponse
+func (c *ClientWithResponses) ModelsGetForOrganizationWithResponse(ctx context.Context, parameterOrganization ParameterOrganization, parameterModel ParameterModel, params *ModelsGetForOrganizationParams, reqEditors ...RequestEditorFn) (*ModelsGetForOrganizationResponse, error) {
+	rsp, err := c.ModelsGetForOrganization(ctx, parameterOrganization, parameterModel, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseModelsGetForOrganizationResponse(rsp)
+}
+
+// ModelsPatchForOrganizationWithBodyWithResponse request with arbitrary body returning *ModelsPatchForOrganizationResponse
+func (c *ClientWithResponses) ModelsPatchForOrganizationWithBodyWithResponse(ctx context.Context, parameterOrganization ParameterOrganization, parameterModel ParameterModel, params *ModelsPatchForOrganizationParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ModelsPatchForOrganizationResponse, error) {
+	rsp, err := c.ModelsPatchForOrganizationWithBody(ctx, parameterOrganization, parameterModel, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseModelsPatchForOrganizationResponse(rsp)
+}
+
+func (c *ClientWithResponses) ModelsPatchForOrganizationWithResponse(ctx context.Context, parameterOrganization ParameterOrganization, parameterModel ParameterModel, params *ModelsPatchForOrganizationParams, body ModelsPatchForOrganizationJSONRequestBody, reqEditors ...RequestEditorFn) (*ModelsPatchForOrganizationResponse, error) {
+	rsp, err := c.ModelsPatchForOrganization(ctx, parameterOrganization, parameterModel, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseModelsPatchForOrganizationResponse(rsp)
+}
+
+// ModelsUpdateForOrganizationWithBodyWithResponse request with arbitrary body returning *ModelsUpdateForOrganizationResponse
+func (c *ClientWithResponses) ModelsUpdateForOrganizationWithBodyWithResponse(ctx context.Context, parameterOrganization ParameterOrganization, parameterModel ParameterModel, params *ModelsUpdateForOrganizationParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ModelsUpdateForOrganizationResponse, error) {
+	rsp, err := c.ModelsUpdateForOrganizationWithBody(ctx, parameterOrganization, parameterModel, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseModelsUpdateForOrganizationResponse(rsp)
+}
+
+func (c *ClientWithResponses) ModelsUpdateForOrganizationWithResponse(ctx context.Context, parameterOrganization ParameterOrganization, parameterModel ParameterModel, params *ModelsUpdateForOrganizationParams, body ModelsUpdateForOrganizationJSONRequestBody, reqEditors ...RequestEditorFn) (*ModelsUpdateForOrganizationResponse, error) {
+	rsp, err := c.ModelsUpdateForOrganization(ctx, parameterOrganization, parameterModel, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseModelsUpdateForOrganizationResponse(rsp)
+}
+
+// AlertRulesListForModelWithResponse request returning *AlertRulesListForModelResponse
+func (c *ClientWithResponses) AlertRulesListForModelWithResponse(ctx context.Context, parameterOrganization ParameterOrganization, parameterModel ParameterModel, reqEditors ...RequestEditorFn) (*AlertRulesListForModelResponse, error) {
+	rsp, err := c.AlertRulesListForModel(ctx, parameterOrganization, parameterModel, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAlertRulesListForModelResponse(rsp)
+}
+
+// AlertRulesCreateForModelWithBodyWithResponse request with arbitrary body returning *AlertRulesCreateForModelResponse
+func (c *ClientWithResponses) AlertRulesCreateForModelWithBodyWithResponse(ctx context.Context, parameterOrganization ParameterOrganization, parameterModel ParameterModel, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*AlertRulesCreateForModelResponse, error) {
+	rsp, err := c.AlertRulesCreateForModelWithBody(ctx, parameterOrganization, parameterModel, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAlertRulesCreateForModelResponse(rsp)
+}
+
+func (c *ClientWithResponses) AlertRulesCreateForModelWithResponse(ctx context.Context, parameterOrganization ParameterOrganization, parameterModel ParameterModel, body AlertRulesCreateForModelJSONRequestBody, reqEditors ...RequestEditorFn) (*AlertRulesCreateForModelResponse, error) {
+	rsp, err := c.AlertRulesCreateForModel(ctx, parameterOrganization, parameterModel, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAlertRulesCreateForModelResponse(rsp)
+}
+
+// AlertRulesDeleteForModelWithResponse request returning *AlertRulesDeleteForModelResponse
+func (c *ClientWithResponses) AlertRulesDeleteForModelWithResponse(ctx context.Context, parameterOrganization ParameterOrganization, parameterModel ParameterModel, parameterAlertRule ParameterAlertRule, params *AlertRulesDeleteForModelParams, reqEditors ...RequestEditorFn) (*AlertRulesDeleteForModelResponse, error) {
+	rsp, err := c.AlertRulesDeleteForModel(ctx, parameterOrganization, parameterModel, parameterAlertRule, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAlertRulesDeleteForModelResponse(rsp)
+}
+
+// AlertRulesGetForModelWithResponse request returning *AlertRulesGetForModelResponse
+func (c *ClientWithResponses) AlertRulesGetForModelWithResponse(ctx context.Context, parameterOrganization ParameterOrganization, parameterModel ParameterModel, parameterAlertRule ParameterAlertRule, params *AlertRulesGetForModelParams, reqEditors ...RequestEditorFn) (*AlertRulesGetForModelResponse, error) {
+	rsp, err := c.AlertRulesGetForModel(ctx, parameterOrganization, parameterModel, parameterAlertRule, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAlertRulesGetForModelResponse(rsp)
+}
+
+// ComparisonGetForModelWithResponse request returning *ComparisonGetForModelResponse
+func (c *ClientWithResponses) ComparisonGetForModelWithResponse(ctx context.Context, parameterOrganization ParameterOrganization, parameterModel ParameterModel, params *ComparisonGetForModelParams, reqEditors ...RequestEditorFn) (*ComparisonGetForModelResponse, error) {
+	rsp, err := c.ComparisonGetForModel(ctx, parameterOrganization, parameterModel, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseComparisonGetForModelResponse(rsp)
+}
+
+// MetricsGetForModelWithResponse request returning *MetricsGetForModelResponse
+func (c *ClientWithResponses) MetricsGetForModelWithResponse(ctx context.Context, parameterOrganization ParameterOrganization, parameterModel ParameterModel, params *MetricsGetForModelParams, reqEditors ...RequestEditorFn) (*MetricsGetForModelResponse, error) {
+	rsp, err := c.MetricsGetForModel(ctx, parameterOrganization, parameterModel, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseMetricsGetForModelResponse(rsp)
+}
+
+// ResultsListForModelWithResponse request returning *ResultsListForModelResponse
+func (c *ClientWithResponses) ResultsListForModelWithResponse(ctx context.Context, parameterOrganization ParameterOrganization, parameterModel ParameterModel, params *ResultsListForModelParams, reqEditors ...RequestEditorFn) (*ResultsListForModelResponse, error) {
+	rsp, err := c.ResultsListForModel(ctx, parameterOrganization, parameterModel, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseResultsListForModelResponse(rsp)
+}
+
+// ResultsCreateForModelWithBodyWithResponse request with arbitrary body returning *ResultsCreateForModelResponse
+func (c *ClientWithResponses) ResultsCreateForModelWithBodyWithResponse(ctx context.Context, parameterOrganization ParameterOrganization, parameterModel ParameterModel, params *ResultsCreateForModelParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ResultsCreateForModelResponse, error) {
+	rsp, err := c.ResultsCreateForModelWithBody(ctx, parameterOrganization, parameterModel, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseResultsCreateForModelResponse(rsp)
+}
+
+func (c *ClientWithResponses) ResultsCreateForModelWithResponse(ctx context.Context, parameterOrganization ParameterOrganization, parameterModel ParameterModel, params *ResultsCreateForModelParams, body ResultsCreateForModelJSONRequestBody, reqEditors ...RequestEditorFn) (*ResultsCreateForModelResponse, error) {
+	rsp, err := c.ResultsCreateForModel(ctx, parameterOrganization, parameterModel, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseResultsCreateForModelResponse(rsp)
+}
+
+// ResultsStreamForModelWithResponse request returning *ResultsStreamForModelResponse
+func (c *ClientWithResponses) ResultsStreamForModelWithResponse(ctx context.Context, parameterOrganization ParameterOrganization, parameterModel ParameterModel, params *ResultsStreamForModelParams, reqEditors ...RequestEditorFn) (*ResultsStreamForModelResponse, error) {
+	rsp, err := c.ResultsStreamForModel(ctx, parameterOrganization, parameterModel, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseResultsStreamForModelResponse(rsp)
+}
+
+// StagesListForModelWithResponse request returning *StagesListForModelResponse
+func (c *ClientWithResponses) StagesListForModelWithResponse(ctx context.Context, parameterOrganization ParameterOrganization, parameterModel ParameterModel, reqEditors ...RequestEditorFn) (*StagesListForModelResponse, error) {
+	rsp, err := c.StagesListForModel(ctx, parameterOrganization, parameterModel, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseStagesListForModelResponse(rsp)
+}
+
+// StagesGetForModelWithResponse request returning *StagesGetForModelResponse
+func (c *ClientWithResponses) StagesGetForModelWithResponse(ctx context.Context, parameterOrganization ParameterOrganization, parameterModel ParameterModel, parameterStage ParameterStage, params *StagesGetForModelParams, reqEditors ...RequestEditorFn) (*StagesGetForModelResponse, error) {
+	rsp, err := c.StagesGetForModel(ctx, parameterOrganization, parameterModel, parameterStage, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseStagesGetForModelResponse(rsp)
+}
+
+// StagesHistoryForModelWithResponse request returning *StagesHistoryForModelResponse
+func (c *ClientWithResponses) StagesHistoryForModelWithResponse(ctx context.Context, parameterOrganization ParameterOrganization, parameterModel ParameterModel, parameterStage ParameterStage, reqEditors ...RequestEditorFn) (*StagesHistoryForModelResponse, error) {
+	rsp, err := c.StagesHistoryForModel(ctx, parameterOrganization, parameterModel, parameterStage, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseStagesHistoryForModelResponse(rsp)
+}
+
+// StagesPromoteForModelWithBodyWithResponse request with arbitrary body returning *StagesPromoteForModelResponse
+func (c *ClientWithResponses) StagesPromoteForModelWithBodyWithResponse(ctx context.Context, parameterOrganization ParameterOrganization, parameterModel ParameterModel, parameterStage ParameterStage, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*StagesPromoteForModelResponse, error) {
+	rsp, err := c.StagesPromoteForModelWithBody(ctx, parameterOrganization, parameterModel, parameterStage, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseStagesPromoteForModelResponse(rsp)
+}
+
+func (c *ClientWithResponses) StagesPromoteForModelWithResponse(ctx context.Context, parameterOrganization ParameterOrganization, parameterModel ParameterModel, parameterStage ParameterStage, body StagesPromoteForModelJSONRequestBody, reqEditors ...RequestEditorFn) (*StagesPromoteForModelResponse, error) {
+	rsp, err := c.StagesPromoteForModel(ctx, parameterOrganization, parameterModel, parameterStage, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseStagesPromoteForModelResponse(rsp)
+}
+
+// StagesRollbackForModelWithResponse request returning *StagesRollbackForModelResponse
+func (c *ClientWithResponses) StagesRollbackForModelWithResponse(ctx context.Context, parameterOrganization ParameterOrganization, parameterModel ParameterModel, parameterStage ParameterStage, reqEditors ...RequestEditorFn) (*StagesRollbackForModelResponse, error) {
+	rsp, err := c.StagesRollbackForModel(ctx, parameterOrganization, parameterModel, parameterStage, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseStagesRollbackForModelResponse(rsp)
+}
+
+// VersionsListForModelWithResponse request returning *VersionsListForModelResponse
+func (c *ClientWithResponses) VersionsListForModelWithResponse(ctx context.Context, parameterOrganization ParameterOrganization, parameterModel ParameterModel, params *VersionsListForModelParams, reqEditors ...RequestEditorFn) (*VersionsListForModelResponse, error) {
+	rsp, err := c.VersionsListForModel(ctx, parameterOrganization, parameterModel, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseVersionsListForModelResponse(rsp)
+}
+
+// VersionsCreateForModelWithBodyWithResponse request with arbitrary body returning *VersionsCreateForModelResponse
+func (c *ClientWithResponses) VersionsCreateForModelWithBodyWithResponse(ctx context.Context, parameterOrganization ParameterOrganization, parameterModel ParameterModel, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*VersionsCreateForModelResponse, error) {
+	rsp, err := c.VersionsCreateForModelWithBody(ctx, parameterOrganization, parameterModel, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseVersionsCreateForModelResponse(rsp)
+}
+
+func (c *ClientWithResponses) VersionsCreateForModelWithResponse(ctx context.Context, parameterOrganization ParameterOrganization, parameterModel ParameterModel, body VersionsCreateForModelJSONRequestBody, reqEditors ...RequestEditorFn) (*VersionsCreateForModelResponse, error) {
+	rsp, err := c.VersionsCreateForModel(ctx, parameterOrganization, parameterModel, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseVersionsCreateForModelResponse(rsp)
+}
+
+// VersionsGetForModelWithResponse request returning *VersionsGetForModelResponse
+func (c *ClientWithResponses) VersionsGetForModelWithResponse(ctx context.Context, parameterOrganization ParameterOrganization, parameterModel ParameterModel, parameterVersion ParameterVersion, params *VersionsGetForModelParams, reqEditors ...RequestEditorFn) (*VersionsGetForModelResponse, error) {
+	rsp, err := c.VersionsGetForModel(ctx, parameterOrganization, parameterModel, parameterVersion, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseVersionsGetForModelResponse(rsp)
+}
+
+// VersionsPatchForModelWithBodyWithResponse request with arbitrary body returning *VersionsPatchForModelResponse
+func (c *ClientWithResponses) VersionsPatchForModelWithBodyWithResponse(ctx context.Context, parameterOrganization ParameterOrganization, parameterModel ParameterModel, parameterVersion ParameterVersion, params *VersionsPatchForModelParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*VersionsPatchForModelResponse, error) {
+	rsp, err := c.VersionsPatchForModelWithBody(ctx, parameterOrganization, parameterModel, parameterVersion, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseVersionsPatchForModelResponse(rsp)
+}
+
+func (c *ClientWithResponses) VersionsPatchForModelWithResponse(ctx context.Context, parameterOrganization ParameterOrganization, parameterModel ParameterModel, parameterVersion ParameterVersion, params *VersionsPatchForModelParams, body VersionsPatchForModelJSONRequestBody, reqEditors ...RequestEditorFn) (*VersionsPatchForModelResponse, error) {
+	rsp, err := c.VersionsPatchForModel(ctx, parameterOrganization, parameterModel, parameterVersion, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseVersionsPatchForModelResponse(rsp)
+}
+
+// VersionsUpdateForModelWithBodyWithResponse request with arbitrary body returning *VersionsUpdateForModelResponse
+func (c *ClientWithResponses) VersionsUpdateForModelWithBodyWithResponse(ctx context.Context, parameterOrganization ParameterOrganization, parameterModel ParameterModel, parameterVersion ParameterVersion, params *VersionsUpdateForModelParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*VersionsUpdateForModelResponse, error) {
+	rsp, err := c.VersionsUpdateForModelWithBody(ctx, parameterOrganization, parameterModel, parameterVersion, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseVersionsUpdateForModelResponse(rsp)
+}
+
+func (c *ClientWithResponses) VersionsUpdateForModelWithResponse(ctx context.Context, parameterOrganization ParameterOrganization, parameterModel ParameterModel, parameterVersion ParameterVersion, params *VersionsUpdateForModelParams, body VersionsUpdateForModelJSONRequestBody, reqEditors ...RequestEditorFn) (*VersionsUpdateForModelResponse, error) {
+	rsp, err := c.VersionsUpdateForModel(ctx, parameterOrganization, parameterModel, parameterVersion, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseVersionsUpdateForModelResponse(rsp)
+}
+
+// DriftGetForVersionWithResponse request returning *DriftGetForVersionResponse
+func (c *ClientWithResponses) DriftGetForVersionWithResponse(ctx context.Context, parameterOrganization ParameterOrganization, parameterModel ParameterModel, parameterVersion ParameterVersion, params *DriftGetForVersionParams, reqEditors ...RequestEditorFn) (*DriftGetForVersionResponse, error) {
+	rsp, err := c.DriftGetForVersion(ctx, parameterOrganization, parameterModel, parameterVersion, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDriftGetForVersionResponse(rsp)
+}
+
+// MetricsGetForVersionWithResponse request returning *MetricsGetForVersionResponse
+func (c *ClientWithResponses) MetricsGetForVersionWithResponse(ctx context.Context, parameterOrganization ParameterOrganization, parameterModel ParameterModel, parameterVersion ParameterVersion, params *MetricsGetForVersionParams, reqEditors ...RequestEditorFn) (*MetricsGetForVersionResponse, error) {
+	rsp, err := c.MetricsGetForVersion(ctx, parameterOrganization, parameterModel, parameterVersion, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseMetricsGetForVersionResponse(rsp)
+}
+
+// ResultsListForVersionWithResponse request returning *ResultsListForVersionResponse
+func (c *ClientWithResponses) ResultsListForVersionWithResponse(ctx context.Context, parameterOrganization ParameterOrganization, parameterModel ParameterModel, parameterVersion ParameterVersion, params *ResultsListForVersionParams, reqEditors ...RequestEditorFn) (*ResultsListForVersionResponse, error) {
+	rsp, err := c.ResultsListForVersion(ctx, parameterOrganization, parameterModel, parameterVersion, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseResultsListForVersionResponse(rsp)
+}
+
+// ResultsCreateForVersionWithBodyWithResponse request with arbitrary body returning *ResultsCreateForVersionResponse
+func (c *ClientWithResponses) ResultsCreateForVersionWithBodyWithResponse(ctx context.Context, parameterOrganization ParameterOrganization, parameterModel ParameterModel, parameterVersion ParameterVersion, params *ResultsCreateForVersionParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ResultsCreateForVersionResponse, error) {
+	rsp, err := c.ResultsCreateForVersionWithBody(ctx, parameterOrganization, parameterModel, parameterVersion, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseResultsCreateForVersionResponse(rsp)
+}
+
+func (c *ClientWithResponses) ResultsCreateForVersionWithResponse(ctx context.Context, parameterOrganization ParameterOrganization, parameterModel ParameterModel, parameterVersion ParameterVersion, params *ResultsCreateForVersionParams, body ResultsCreateForVersionJSONRequestBody, reqEditors ...RequestEditorFn) (*ResultsCreateForVersionResponse, error) {
+	rsp, err := c.ResultsCreateForVersion(ctx, parameterOrganization, parameterModel, parameterVersion, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseResultsCreateForVersionResponse(rsp)
+}
+
+// ResultsCreateBulkForVersionWithBodyWithResponse request with arbitrary body returning *ResultsCreateBulkForVersionResponse
+func (c *ClientWithResponses) ResultsCreateBulkForVersionWithBodyWithResponse(ctx context.Context, parameterOrganization ParameterOrganization, parameterModel ParameterModel, parameterVersion ParameterVersion, params *ResultsCreateBulkForVersionParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ResultsCreateBulkForVersionResponse, error) {
+	rsp, err := c.ResultsCreateBulkForVersionWithBody(ctx, parameterOrganization, parameterModel, parameterVersion, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseResultsCreateBulkForVersionResponse(rsp)
+}
+
+func (c *ClientWithResponses) ResultsCreateBulkForVersionWithResponse(ctx context.Context, parameterOrganization ParameterOrganization, parameterModel ParameterModel, parameterVersion ParameterVersion, params *ResultsCreateBulkForVersionParams, body ResultsCreateBulkForVersionJSONRequestBody, reqEditors ...RequestEditorFn) (*ResultsCreateBulkForVersionResponse, error) {
+	rsp, err := c.ResultsCreateBulkForVersion(ctx, parameterOrganization, parameterModel, parameterVersion, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseResultsCreateBulkForVersionResponse(rsp)
+}
+
+// ResultsStreamForVersionWithResponse request returning *ResultsStreamForVersionResponse
+func (c *ClientWithResponses) ResultsStreamForVersionWithResponse(ctx context.Context, parameterOrganization ParameterOrganization, parameterModel ParameterModel, parameterVersion ParameterVersion, params *ResultsStreamForVersionParams, reqEditors ...RequestEditorFn) (*ResultsStreamForVersionResponse, error) {
+	rsp, err := c.ResultsStreamForVersion(ctx, parameterOrganization, parameterModel, parameterVersion, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseResultsStreamForVersionResponse(rsp)
+}
+
+// ResultsGetForVersionWithResponse request returning *ResultsGetForVersionResponse
+func (c *ClientWithResponses) ResultsGetForVersionWithResponse(ctx context.Context, parameterOrganization ParameterOrganization, parameterModel ParameterModel, parameterVersion ParameterVersion, parameterResult ParameterResult, reqEditors ...RequestEditorFn) (*ResultsGetForVersionResponse, error) {
+	rsp, err := c.ResultsGetForVersion(ctx, parameterOrganization, parameterModel, parameterVersion, parameterResult, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseResultsGetForVersionResponse(rsp)
+}
+
+// SchemasListForOrganizationWithResponse request returning *SchemasListForOrganizationResponse
+func (c *ClientWithResponses) SchemasListForOrganizationWithResponse(ctx context.Context, parameterOrganization ParameterOrganization, reqEditors ...RequestEditorFn) (*SchemasListForOrganizationResponse, error) {
+	rsp, err := c.SchemasListForOrganization(ctx, parameterOrganization, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseSchemasListForOrganizationResponse(rsp)
+}
+
+// SchemasCreateForOrganizationWithBodyWithResponse request with arbitrary body returning *SchemasCreateForOrganizationResponse
+func (c *ClientWithResponses) SchemasCreateForOrganizationWithBodyWithResponse(ctx context.Context, parameterOrganization ParameterOrganization, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*SchemasCreateForOrganizationResponse, error) {
+	rsp, err := c.SchemasCreateForOrganizationWithBody(ctx, parameterOrganization, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseSchemasCreateForOrganizationResponse(rsp)
+}
+
+func (c *ClientWithResponses) SchemasCreateForOrganizationWithResponse(ctx context.Context, parameterOrganization ParameterOrganization, body SchemasCreateForOrganizationJSONRequestBody, reqEditors ...RequestEditorFn) (*SchemasCreateForOrganizationResponse, error) {
+	rsp, err := c.SchemasCreateForOrganization(ctx, parameterOrganization, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseSchemasCreateForOrganizationResponse(rsp)
+}
+
+// SchemasGetForOrganizationWithResponse request returning *SchemasGetForOrganizationResponse
+func (c *ClientWithResponses) SchemasGetForOrganizationWithResponse(ctx context.Context, parameterOrganization ParameterOrganization, parameterSchema ParameterSchema, params *SchemasGetForOrganizationParams, reqEditors ...RequestEditorFn) (*SchemasGetForOrganizationResponse, error) {
+	rsp, err := c.SchemasGetForOrganization(ctx, parameterOrganization, parameterSchema, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseSchemasGetForOrganizationResponse(rsp)
+}
+
+// WebhooksListForOrganizationWithResponse request returning *WebhooksListForOrganizationResponse
+func (c *ClientWithResponses) WebhooksListForOrganizationWithResponse(ctx context.Context, parameterOrganization ParameterOrganization, reqEditors ...RequestEditorFn) (*WebhooksListForOrganizationResponse, error) {
+	rsp, err := c.WebhooksListForOrganization(ctx, parameterOrganization, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseWebhooksListForOrganizationResponse(rsp)
+}
+
+// WebhooksCreateForOrganizationWithBodyWithResponse request with arbitrary body returning *WebhooksCreateForOrganizationResponse
+func (c *ClientWithResponses) WebhooksCreateForOrganizationWithBodyWithResponse(ctx context.Context, parameterOrganization ParameterOrganization, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*WebhooksCreateForOrganizationResponse, error) {
+	rsp, err := c.WebhooksCreateForOrganizationWithBody(ctx, parameterOrganization, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseWebhooksCreateForOrganizationResponse(rsp)
+}
+
+func (c *ClientWithResponses) WebhooksCreateForOrganizationWithResponse(ctx context.Context, parameterOrganization ParameterOrganization, body WebhooksCreateForOrganizationJSONRequestBody, reqEditors ...RequestEditorFn) (*WebhooksCreateForOrganizationResponse, error) {
+	rsp, err := c.WebhooksCreateForOrganization(ctx, parameterOrganization, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseWebhooksCreateForOrganizationResponse(rsp)
+}
+
+// WebhooksDeleteForOrganizationWithResponse request returning *WebhooksDeleteForOrganizationResponse
+func (c *ClientWithResponses) WebhooksDeleteForOrganizationWithResponse(ctx context.Context, parameterOrganization ParameterOrganization, parameterWebhook ParameterWebhook, params *WebhooksDeleteForOrganizationParams, reqEditors ...RequestEditorFn) (*WebhooksDeleteForOrganizationResponse, error) {
+	rsp, err := c.WebhooksDeleteForOrganization(ctx, parameterOrganization, parameterWebhook, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseWebhooksDeleteForOrganizationResponse(rsp)
+}
+
+// WebhooksGetForOrganizationWithResponse request returning *WebhooksGetForOrganizationResponse
+func (c *ClientWithResponses) WebhooksGetForOrganizationWithResponse(ctx context.Context, parameterOrganization ParameterOrganization, parameterWebhook ParameterWebhook, params *WebhooksGetForOrganizationParams, reqEditors ...RequestEditorFn) (*WebhooksGetForOrganizationResponse, error) {
+	rsp, err := c.WebhooksGetForOrganization(ctx, parameterOrganization, parameterWebhook, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseWebhooksGetForOrganizationResponse(rsp)
+}
+
+// WebhooksDeliveriesForOrganizationWithResponse request returning *WebhooksDeliveriesForOrganizationResponse
+func (c *ClientWithResponses) WebhooksDeliveriesForOrganizationWithResponse(ctx context.Context, parameterOrganization ParameterOrganization, parameterWebhook ParameterWebhook, params *WebhooksDeliveriesForOrganizationParams, reqEditors ...RequestEditorFn) (*WebhooksDeliveriesForOrganizationResponse, error) {
+	rsp, err := c.WebhooksDeliveriesForOrganization(ctx, parameterOrganization, parameterWebhook, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseWebhooksDeliveriesForOrganizationResponse(rsp)
+}
+
+// ParseOrganizationsCreateResponse parses an HTTP response from a OrganizationsCreateWithResponse call
+func ParseOrganizationsCreateResponse(rsp *http.Response) (*OrganizationsCreateResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &OrganizationsCreateResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest Organization
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 422:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON422 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseModelsListForOrganizationResponse parses an HTTP response from a ModelsListForOrganizationWithResponse call
+func ParseModelsListForOrganizationResponse(rsp *http.Response) (*ModelsListForOrganizationResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ModelsListForOrganizationResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []Model
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseModelsCreateForOrganizationResponse parses an HTTP response from a ModelsCreateForOrganizationWithResponse call
+func ParseModelsCreateForOrganizationResponse(rsp *http.Response) (*ModelsCreateForOrganizationResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ModelsCreateForOrganizationResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest Model
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 422:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON422 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseModelsGetForOrganizationResponse parses an HTTP response from a ModelsGetForOrganizationWithResponse call
+func ParseModelsGetForOrganizationResponse(rsp *http.Response) (*ModelsGetForOrganizationResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ModelsGetForOrganizationResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Model
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseModelsPatchForOrganizationResponse parses an HTTP response from a ModelsPatchForOrganizationWithResponse call
+func ParseModelsPatchForOrganizationResponse(rsp *http.Response) (*ModelsPatchForOrganizationResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ModelsPatchForOrganizationResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Model
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 412:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON412 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 422:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON422 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseModelsUpdateForOrganizationResponse parses an HTTP response from a ModelsUpdateForOrganizationWithResponse call
+func ParseModelsUpdateForOrganizationResponse(rsp *http.Response) (*ModelsUpdateForOrganizationResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ModelsUpdateForOrganizationResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Model
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 412:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON412 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 422:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON422 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseAlertRulesListForModelResponse parses an HTTP response from a AlertRulesListForModelWithResponse call
+func ParseAlertRulesListForModelResponse(rsp *http.Response) (*AlertRulesListForModelResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &AlertRulesListForModelResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []AlertRule
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseAlertRulesCreateForModelResponse parses an HTTP response from a AlertRulesCreateForModelWithResponse call
+func ParseAlertRulesCreateForModelResponse(rsp *http.Response) (*AlertRulesCreateForModelResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &AlertRulesCreateForModelResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest AlertRule
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 422:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON422 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseAlertRulesDeleteForModelResponse parses an HTTP response from a AlertRulesDeleteForModelWithResponse call
+func ParseAlertRulesDeleteForModelResponse(rsp *http.Response) (*AlertRulesDeleteForModelResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &AlertRulesDeleteForModelResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 412:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON412 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseAlertRulesGetForModelResponse parses an HTTP response from a AlertRulesGetForModelWithResponse call
+func ParseAlertRulesGetForModelResponse(rsp *http.Response) (*AlertRulesGetForModelResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &AlertRulesGetForModelResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest AlertRule
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseComparisonGetForModelResponse parses an HTTP response from a ComparisonGetForModelWithResponse call
+func ParseComparisonGetForModelResponse(rsp *http.Response) (*ComparisonGetForModelResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ComparisonGetForModelResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Comparison
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 422:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON422 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseMetricsGetForModelResponse parses an HTTP response from a MetricsGetForModelWithResponse call
+func ParseMetricsGetForModelResponse(rsp *http.Response) (*MetricsGetForModelResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &MetricsGetForModelResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Metrics
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 422:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON422 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseResultsListForModelResponse parses an HTTP response from a ResultsListForModelWithResponse call
+func ParseResultsListForModelResponse(rsp *http.Response) (*ResultsListForModelResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ResultsListForModelResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []Result
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseResultsCreateForModelResponse parses an HTTP response from a ResultsCreateForModelWithResponse call
+func ParseResultsCreateForModelResponse(rsp *http.Response) (*ResultsCreateForModelResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ResultsCreateForModelResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest Result
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 202:
+		var dest NewResult
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON202 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 422:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON422 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 503:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON503 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseResultsStreamForModelResponse parses an HTTP response from a ResultsStreamForModelWithResponse call
+func ParseResultsStreamForModelResponse(rsp *http.Response) (*ResultsStreamForModelResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ResultsStreamForModelResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 501:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON501 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseStagesListForModelResponse parses an HTTP response from a StagesListForModelWithResponse call
+func ParseStagesListForModelResponse(rsp *http.Response) (*StagesListForModelResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &StagesListForModelResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []Stage
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseStagesGetForModelResponse parses an HTTP response from a StagesGetForModelWithResponse call
+func ParseStagesGetForModelResponse(rsp *http.Response) (*StagesGetForModelResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &StagesGetForModelResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Stage
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseStagesHistoryForModelResponse parses an HTTP response from a StagesHistoryForModelWithResponse call
+func ParseStagesHistoryForModelResponse(rsp *http.Response) (*StagesHistoryForModelResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &StagesHistoryForModelResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []StageHistoryEntry
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseStagesPromoteForModelResponse parses an HTTP response from a StagesPromoteForModelWithResponse call
+func ParseStagesPromoteForModelResponse(rsp *http.Response) (*StagesPromoteForModelResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &StagesPromoteForModelResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Stage
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 422:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON422 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseStagesRollbackForModelResponse parses an HTTP response from a StagesRollbackForModelWithResponse call
+func ParseStagesRollbackForModelResponse(rsp *http.Response) (*StagesRollbackForModelResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &StagesRollbackForModelResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Stage
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseVersionsListForModelResponse parses an HTTP response from a VersionsListForModelWithResponse call
+func ParseVersionsListForModelResponse(rsp *http.Response) (*VersionsListForModelResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &VersionsListForModelResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []Version
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseVersionsCreateForModelResponse parses an HTTP response from a VersionsCreateForModelWithResponse call
+func ParseVersionsCreateForModelResponse(rsp *http.Response) (*VersionsCreateForModelResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &VersionsCreateForModelResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest Version
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 422:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON422 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseVersionsGetForModelResponse parses an HTTP response from a VersionsGetForModelWithResponse call
+func ParseVersionsGetForModelResponse(rsp *http.Response) (*VersionsGetForModelResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &VersionsGetForModelResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Version
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseVersionsPatchForModelResponse parses an HTTP response from a VersionsPatchForModelWithResponse call
+func ParseVersionsPatchForModelResponse(rsp *http.Response) (*VersionsPatchForModelResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &VersionsPatchForModelResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Version
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 412:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON412 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 422:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON422 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseVersionsUpdateForModelResponse parses an HTTP response from a VersionsUpdateForModelWithResponse call
+func ParseVersionsUpdateForModelResponse(rsp *http.Response) (*VersionsUpdateForModelResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &VersionsUpdateForModelResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Version
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 412:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON412 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 422:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON422 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseDriftGetForVersionResponse parses an HTTP response from a DriftGetForVersionWithResponse call
+func ParseDriftGetForVersionResponse(rsp *http.Response) (*DriftGetForVersionResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DriftGetForVersionResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest DriftReport
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 422:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON422 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseMetricsGetForVersionResponse parses an HTTP response from a MetricsGetForVersionWithResponse call
+func ParseMetricsGetForVersionResponse(rsp *http.Response) (*MetricsGetForVersionResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &MetricsGetForVersionResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Metrics
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 422:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON422 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseResultsListForVersionResponse parses an HTTP response from a ResultsListForVersionWithResponse call
+func ParseResultsListForVersionResponse(rsp *http.Response) (*ResultsListForVersionResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ResultsListForVersionResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []Result
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseResultsCreateForVersionResponse parses an HTTP response from a ResultsCreateForVersionWithResponse call
+func ParseResultsCreateForVersionResponse(rsp *http.Response) (*ResultsCreateForVersionResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ResultsCreateForVersionResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest Result
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 202:
+		var dest NewResult
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON202 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 422:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON422 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 503:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON503 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseResultsCreateBulkForVersionResponse parses an HTTP response from a ResultsCreateBulkForVersionWithResponse call
+func ParseResultsCreateBulkForVersionResponse(rsp *http.Response) (*ResultsCreateBulkForVersionResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ResultsCreateBulkForVersionResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest BulkResults
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 413:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON413 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 422:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON422 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseResultsStreamForVersionResponse parses an HTTP response from a ResultsStreamForVersionWithResponse call
+func ParseResultsStreamForVersionResponse(rsp *http.Response) (*ResultsStreamForVersionResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ResultsStreamForVersionResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 501:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON501 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseResultsGetForVersionResponse parses an HTTP response from a ResultsGetForVersionWithResponse call
+func ParseResultsGetForVersionResponse(rsp *http.Response) (*ResultsGetForVersionResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ResultsGetForVersionResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Result
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseSchemasListForOrganizationResponse parses an HTTP response from a SchemasListForOrganizationWithResponse call
+func ParseSchemasListForOrganizationResponse(rsp *http.Response) (*SchemasListForOrganizationResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &SchemasListForOrganizationResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []Schema
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseSchemasCreateForOrganizationResponse parses an HTTP response from a SchemasCreateForOrganizationWithResponse call
+func ParseSchemasCreateForOrganizationResponse(rsp *http.Response) (*SchemasCreateForOrganizationResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &SchemasCreateForOrganizationResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest Schema
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 422:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON422 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseSchemasGetForOrganizationResponse parses an HTTP response from a SchemasGetForOrganizationWithResponse call
+func ParseSchemasGetForOrganizationResponse(rsp *http.Response) (*SchemasGetForOrganizationResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &SchemasGetForOrganizationResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Schema
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseWebhooksListForOrganizationResponse parses an HTTP response from a WebhooksListForOrganizationWithResponse call
+func ParseWebhooksListForOrganizationResponse(rsp *http.Response) (*WebhooksListForOrganizationResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &WebhooksListForOrganizationResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []Webhook
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseWebhooksCreateForOrganizationResponse parses an HTTP response from a WebhooksCreateForOrganizationWithResponse call
+func ParseWebhooksCreateForOrganizationResponse(rsp *http.Response) (*WebhooksCreateForOrganizationResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &WebhooksCreateForOrganizationResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest Webhook
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 422:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON422 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseWebhooksDeleteForOrganizationResponse parses an HTTP response from a WebhooksDeleteForOrganizationWithResponse call
+func ParseWebhooksDeleteForOrganizationResponse(rsp *http.Response) (*WebhooksDeleteForOrganizationResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &WebhooksDeleteForOrganizationResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 412:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON412 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseWebhooksGetForOrganizationResponse parses an HTTP response from a WebhooksGetForOrganizationWithResponse call
+func ParseWebhooksGetForOrganizationResponse(rsp *http.Response) (*WebhooksGetForOrganizationResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &WebhooksGetForOrganizationResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Webhook
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseWebhooksDeliveriesForOrganizationResponse parses an HTTP response from a WebhooksDeliveriesForOrganizationWithResponse call
+func ParseWebhooksDeliveriesForOrganizationResponse(rsp *http.Response) (*WebhooksDeliveriesForOrganizationResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &WebhooksDeliveriesForOrganizationResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []WebhookDelivery
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 422:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON422 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ServerInterface represents all server handlers.
+type ServerInterface interface {
+	// Create an organization
+	// (POST /organizations)
+	OrganizationsCreate(w http.ResponseWriter, r *http.Request)
+	// List organization models
+	// (GET /organizations/{organization}/models)
+	ModelsListForOrganization(w http.ResponseWriter, r *http.Request, parameterOrganization ParameterOrganization)
+	// Create an organization model
+	// (POST /organizations/{organization}/models)
+	ModelsCreateForOrganization(w http.ResponseWriter, r *http.Request, parameterOrganization ParameterOrganization)
+	// Get organization model
+	// (GET /organizations/{organization}/models/{model})
+	ModelsGetForOrganization(w http.ResponseWriter, r *http.Request, parameterOrganization ParameterOrganization, parameterModel ParameterModel, params ModelsGetForOrganizationParams)
+	// Patch an organization model
+	// (PATCH /organizations/{organization}/models/{model})
+	ModelsPatchForOrganization(w http.ResponseWriter, r *http.Request, parameterOrganization ParameterOrganization, parameterModel ParameterModel, params ModelsPatchForOrganizationParams)
+	// Update an organization model
+	// (PUT /organizations/{organization}/models/{model})
+	ModelsUpdateForOrganization(w http.ResponseWriter, r *http.Request, parameterOrganization ParameterOrganization, parameterModel ParameterModel, params ModelsUpdateForOrganizationParams)
+	// List alert rules
+	// (GET /organizations/{organization}/models/{model}/alert-rules)
+	AlertRulesListForModel(w http.ResponseWriter, r *http.Request, parameterOrganization ParameterOrganization, parameterModel ParameterModel)
+	// Create an alert rule
+	// (POST /organizations/{organization}/models/{model}/alert-rules)
+	AlertRulesCreateForModel(w http.ResponseWriter, r *http.Request, parameterOrganization ParameterOrganization, parameterModel ParameterModel)
+	// Delete an alert rule
+	// (DELETE /organizations/{organization}/models/{model}/alert-rules/{alertRule})
+	AlertRulesDeleteForModel(w http.ResponseWriter, r *http.Request, parameterOrganization ParameterOrganization, parameterModel ParameterModel, parameterAlertRule ParameterAlertRule, params AlertRulesDeleteForModelParams)
+	// Get an alert rule
+	// (GET /organizations/{organization}/models/{model}/alert-rules/{alertRule})
+	AlertRulesGetForModel(w http.ResponseWriter, r *http.Request, parameterOrganization ParameterOrganization, parameterModel ParameterModel, parameterAlertRule ParameterAlertRule, params AlertRulesGetForModelParams)
+	// Compare model versions
+	// (GET /organizations/{organization}/models/{model}/comparison)
+	ComparisonGetForModel(w http.ResponseWriter, r *http.Request, parameterOrganization ParameterOrganization, parameterModel ParameterModel, params ComparisonGetForModelParams)
+	// Get metrics for a stage
+	// (GET /organizations/{organization}/models/{model}/metrics)
+	MetricsGetForModel(w http.ResponseWriter, r *http.Request, parameterOrganization ParameterOrganization, parameterModel ParameterModel, params MetricsGetForModelParams)
+	// List results for a stage
+	// (GET /organizations/{organization}/models/{model}/results)
+	ResultsListForModel(w http.ResponseWriter, r *http.Request, parameterOrganization ParameterOrganization, parameterModel ParameterModel, params ResultsListForModelParams)
+	// Create a result for a stage
+	// (POST /organizations/{organization}/models/{model}/results)
+	ResultsCreateForModel(w http.ResponseWriter, r *http.Request, parameterOrganization ParameterOrganization, parameterModel ParameterModel, params ResultsCreateForModelParams)
+	// Stream results for a stage
+	// (GET /organizations/{organization}/models/{model}/results/stream)
+	ResultsStreamForModel(w http.ResponseWriter, r *http.Request, parameterOrganization ParameterOrganization, parameterModel ParameterModel, params ResultsStreamForModelParams)
+	// List model stages
+	// (GET /organizations/{organization}/models/{model}/stages)
+	StagesListForModel(w http.ResponseWriter, r *http.Request, parameterOrganization ParameterOrganization, parameterModel ParameterModel)
+	// Get model stage
+	// (GET /organizations/{organization}/models/{model}/stages/{stage})
+	StagesGetForModel(w http.ResponseWriter, r *http.Request, parameterOrganization ParameterOrganization, parameterModel ParameterModel, parameterStage ParameterStage, params StagesGetForModelParams)
+	// List model stage history
+	// (GET /organizations/{organization}/models/{model}/stages/{stage}/history)
+	StagesHistoryForModel(w http.ResponseWriter, r *http.Request, parameterOrganization ParameterOrganization, parameterModel ParameterModel, parameterStage ParameterStage)
+	// Promote a version to a model stage
+	// (POST /organizations/{organization}/models/{model}/stages/{stage}/promote)
+	StagesPromoteForModel(w http.ResponseWriter, r *http.Request, parameterOrganization ParameterOrganization, parameterModel ParameterModel, parameterStage ParameterStage)
+	// Roll back a model stage
+	// (POST /organizations/{organization}/models/{model}/stages/{stage}/rollback)
+	StagesRollbackForModel(w http.ResponseWriter, r *http.Request, parameterOrganization ParameterOrganization, parameterModel ParameterModel, parameterStage ParameterStage)
+	// List model versions
+	// (GET /organizations/{organization}/models/{model}/versions)
+	VersionsListForModel(w http.ResponseWriter, r *http.Request, parameterOrganization ParameterOrganization, parameterModel ParameterModel, params VersionsListForModelParams)
+	// Create a model version
+	// (POST /organizations/{organization}/models/{model}/versions)
+	VersionsCreateForModel(w http.ResponseWriter, r *http.Request, parameterOrganization ParameterOrganization, parameterModel ParameterModel)
+	// Get model version
+	// (GET /organizations/{organization}/models/{model}/versions/{version})
+	VersionsGetForModel(w http.ResponseWriter, r *http.Request, parameterOrganization ParameterOrganization, parameterModel ParameterModel, parameterVersion ParameterVersion, params VersionsGetForModelParams)
+	// Patch a model version
+	// (PATCH /organizations/{organization}/models/{model}/versions/{version})
+	VersionsPatchForModel(w http.ResponseWriter, r *http.Request, parameterOrganization ParameterOrganization, parameterModel ParameterModel, parameterVersion ParameterVersion, params VersionsPatchForModelParams)
+	// Update a model version
+	// (PUT /organizations/{organization}/models/{model}/versions/{version})
+	VersionsUpdateForModel(w http.ResponseWriter, r *http.Request, parameterOrganization ParameterOrganization, parameterModel ParameterModel, parameterVersion ParameterVersion, params VersionsUpdateForModelParams)
+	// Get a drift report
+	// (GET /organizations/{organization}/models/{model}/versions/{version}/drift)
+	DriftGetForVersion(w http.ResponseWriter, r *http.Request, parameterOrganization ParameterOrganization, parameterModel ParameterModel, parameterVersion ParameterVersion, params DriftGetForVersionParams)
+	// Get version metrics
+	// (GET /organizations/{organization}/models/{model}/versions/{version}/metrics)
+	MetricsGetForVersion(w http.ResponseWriter, r *http.Request, parameterOrganization ParameterOrganization, parameterModel ParameterModel, parameterVersion ParameterVersion, params MetricsGetForVersionParams)
+	// List results
+	// (GET /organizations/{organization}/models/{model}/versions/{version}/results)
+	ResultsListForVersion(w http.ResponseWriter, r *http.Request, parameterOrganization ParameterOrganization, parameterModel ParameterModel, parameterVersion ParameterVersion, params ResultsListForVersionParams)
+	// Create a model result
+	// (POST /organizations/{organization}/models/{model}/versions/{version}/results)
+	ResultsCreateForVersion(w http.ResponseWriter, r *http.Request, parameterOrganization ParameterOrganization, parameterModel ParameterModel, parameterVersion ParameterVersion, params ResultsCreateForVersionParams)
+	// Create model results in bulk
+	// (POST /organizations/{organization}/models/{model}/versions/{version}/results/bulk)
+	ResultsCreateBulkForVersion(w http.ResponseWriter, r *http.Request, parameterOrganization ParameterOrganization, parameterModel ParameterModel, parameterVersion ParameterVersion, params ResultsCreateBulkForVersionParams)
+	// Stream results
+	// (GET /organizations/{organization}/models/{model}/versions/{version}/results/stream)
+	ResultsStreamForVersion(w http.ResponseWriter, r *http.Request, parameterOrganization ParameterOrganization, parameterModel ParameterModel, parameterVersion ParameterVersion, params ResultsStreamForVersionParams)
+	// Get a result
+	// (GET /organizations/{organization}/models/{model}/versions/{version}/results/{result})
+	ResultsGetForVersion(w http.ResponseWriter, r *http.Request, parameterOrganization ParameterOrganization, parameterModel ParameterModel, parameterVersion ParameterVersion, parameterResult ParameterResult)
+	// List organization schemas
+	// (GET /organizations/{organization}/schemas)
+	SchemasListForOrganization(w http.ResponseWriter, r *http.Request, parameterOrganization ParameterOrganization)
+	// Create an organization schema
+	// (POST /organizations/{organization}/schemas)
+	SchemasCreateForOrganization(w http.ResponseWriter, r *http.Request, parameterOrganization ParameterOrganization)
+	// Get organization schema
+	// (GET /organizations/{organization}/schemas/{schema})
+	SchemasGetForOrganization(w http.ResponseWriter, r *http.Request, parameterOrganization ParameterOrganization, parameterSchema ParameterSchema, params SchemasGetForOrganizationParams)
+	// List webhooks
+	// (GET /organizations/{organization}/webhooks)
+	WebhooksListForOrganization(w http.ResponseWriter, r *http.Request, parameterOrganization ParameterOrganization)
+	// Create a webhook
+	// (POST /organizations/{organization}/webhooks)
+	WebhooksCreateForOrganization(w http.ResponseWriter, r *http.Request, parameterOrganization ParameterOrganization)
+	// Delete a webhook
+	// (DELETE /organizations/{organization}/webhooks/{webhook})
+	WebhooksDeleteForOrganization(w http.ResponseWriter, r *http.Request, parameterOrganization ParameterOrganization, parameterWebhook ParameterWebhook, params WebhooksDeleteForOrganizationParams)
+	// Get a webhook
+	// (GET /organizations/{organization}/webhooks/{webhook})
+	WebhooksGetForOrganization(w http.ResponseWriter, r *http.Request, parameterOrganization ParameterOrganization, parameterWebhook ParameterWebhook, params WebhooksGetForOrganizationParams)
+	// List webhook deliveries
+	// (GET /organizations/{organization}/webhooks/{webhook}/deliveries)
+	WebhooksDeliveriesForOrganization(w http.ResponseWriter, r *http.Request, parameterOrganization ParameterOrganization, parameterWebhook ParameterWebhook, params WebhooksDeliveriesForOrganizationParams)
+}
+
+// ServerInterfaceWrapper converts contexts to parameters.
+type ServerInterfaceWrapper struct {
+	Handler            ServerInterface
+	HandlerMiddlewares []MiddlewareFunc
+	ErrorHandlerFunc   func(w http.ResponseWriter, r *http.Request, err error)
+}
+
+type MiddlewareFunc func(http.Handler) http.Handler
+
+// OrganizationsCreate operation middleware
+func (siw *ServerInterfaceWrapper) OrganizationsCreate(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var handler http.Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.OrganizationsCreate(w, r)
+	})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// ModelsListForOrganization operation middleware
+func (siw *ServerInterfaceWrapper) ModelsListForOrganization(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "organization" -------------
+	var parameterOrganization ParameterOrganization
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "organization", runtime.ParamLocationPath, chi.URLParam(r, "organization"), &parameterOrganization)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "organization", Err: err})
+		return
+	}
+
+	var handler http.Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ModelsListForOrganization(w, r, parameterOrganization)
+	})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// ModelsCreateForOrganization operation middleware
+func (siw *ServerInterfaceWrapper) ModelsCreateForOrganization(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "organization" -------------
+	var parameterOrganization ParameterOrganization
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "organization", runtime.ParamLocationPath, chi.URLParam(r, "organization"), &parameterOrganization)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "organization", Err: err})
+		return
+	}
+
+	var handler http.Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ModelsCreateForOrganization(w, r, parameterOrganization)
+	})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// ModelsGetForOrganization operation middleware
+func (siw *ServerInterfaceWrapper) ModelsGetForOrganization(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "organization" -------------
+	var parameterOrganization ParameterOrganization
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "organization", runtime.ParamLocationPath, chi.URLParam(r, "organization"), &parameterOrganization)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "organization", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "model" -------------
+	var parameterModel ParameterModel
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "model", runtime.ParamLocationPath, chi.URLParam(r, "model"), &parameterModel)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "model", Err: err})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ModelsGetForOrganizationParams
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "If-None-Match" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("If-None-Match")]; found {
+		var IfNoneMatch IfNoneMatch
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "If-None-Match", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithLocation("simple", false, "If-None-Match", runtime.ParamLocationHeader, valueList[0], &IfNoneMatch)
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "If-None-Match", Err: err})
+			return
+		}
+
+		params.IfNoneMatch = &IfNoneMatch
+
+	}
+
+	var handler http.Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ModelsGetForOrganization(w, r, parameterOrganization, parameterModel, params)
+	})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// ModelsPatchForOrganization operation middleware
+func (siw *ServerInterfaceWrapper) ModelsPatchForOrganization(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "organization" -------------
+	var parameterOrganization ParameterOrganization
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "organization", runtime.ParamLocationPath, chi.URLParam(r, "organization"), &parameterOrganization)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "organization", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "model" -------------
+	var parameterModel ParameterModel
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "model", runtime.ParamLocationPath, chi.URLParam(r, "model"), &parameterModel)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "model", Err: err})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ModelsPatchForOrganizationParams
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "If-Match" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("If-Match")]; found {
+		var IfMatch IfMatch
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "If-Match", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithLocation("simple", false, "If-Match", runtime.ParamLocationHeader, valueList[0], &IfMatch)
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "If-Match", Err: err})
+			return
+		}
+
+		params.IfMatch = &IfMatch
+
+	}
+
+	var handler http.Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ModelsPatchForOrganization(w, r, parameterOrganization, parameterModel, params)
+	})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// ModelsUpdateForOrganization operation middleware
+func (siw *ServerInterfaceWrapper) ModelsUpdateForOrganization(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "organization" -------------
+	var parameterOrganization ParameterOrganization
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "organization", runtime.ParamLocationPath, chi.URLParam(r, "organization"), &parameterOrganization)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "organization", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "model" -------------
+	var parameterModel ParameterModel
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "model", runtime.ParamLocationPath, chi.URLParam(r, "model"), &parameterModel)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "model", Err: err})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ModelsUpdateForOrganizationParams
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "If-Match" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("If-Match")]; found {
+		var IfMatch IfMatch
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "If-Match", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithLocation("simple", false, "If-Match", runtime.ParamLocationHeader, valueList[0], &IfMatch)
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "If-Match", Err: err})
+			return
+		}
+
+		params.IfMatch = &IfMatch
+
+	}
+
+	var handler http.Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ModelsUpdateForOrganization(w, r, parameterOrganization, parameterModel, params)
+	})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// AlertRulesListForModel operation middleware
+func (siw *ServerInterfaceWrapper) AlertRulesListForModel(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "organization" -------------
+	var parameterOrganization ParameterOrganization
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "organization", runtime.ParamLocationPath, chi.URLParam(r, "organization"), &parameterOrganization)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "organization", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "model" -------------
+	var parameterModel ParameterModel
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "model", runtime.ParamLocationPath, chi.URLParam(r, "model"), &parameterModel)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "model", Err: err})
+		return
+	}
+
+	var handler http.Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.AlertRulesListForModel(w, r, parameterOrganization, parameterModel)
+	})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// AlertRulesCreateForModel operation middleware
+func (siw *ServerInterfaceWrapper) AlertRulesCreateForModel(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "organization" -------------
+	var parameterOrganization ParameterOrganization
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "organization", runtime.ParamLocationPath, chi.URLParam(r, "organization"), &parameterOrganization)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "organization", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "model" -------------
+	var parameterModel ParameterModel
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "model", runtime.ParamLocationPath, chi.URLParam(r, "model"), &parameterModel)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "model", Err: err})
+		return
+	}
+
+	var handler http.Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.AlertRulesCreateForModel(w, r, parameterOrganization, parameterModel)
+	})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-// Status returns HTTPResponse.Status
-func (r ModelsCreateForOrganizationResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// AlertRulesDeleteForModel operation middleware
+func (siw *ServerInterfaceWrapper) AlertRulesDeleteForModel(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "organization" -------------
+	var parameterOrganization ParameterOrganization
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "organization", runtime.ParamLocationPath, chi.URLParam(r, "organization"), &parameterOrganization)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "organization", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "model" -------------
+	var parameterModel ParameterModel
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "model", runtime.ParamLocationPath, chi.URLParam(r, "model"), &parameterModel)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "model", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "alertRule" -------------
+	var parameterAlertRule ParameterAlertRule
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "alertRule", runtime.ParamLocationPath, chi.URLParam(r, "alertRule"), &parameterAlertRule)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "alertRule", Err: err})
+		return
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r ModelsCreateForOrganizationResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	// Parameter object where we will unmarshal all parameters from the context
+	var params AlertRulesDeleteForModelParams
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "If-Match" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("If-Match")]; found {
+		var IfMatch IfMatch
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "If-Match", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithLocation("simple", false, "If-Match", runtime.ParamLocationHeader, valueList[0], &IfMatch)
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "If-Match", Err: err})
+			return
+		}
+
+		params.IfMatch = &IfMatch
+
 	}
-	return 0
-}
 
-type ModelsGetForOrganizationResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *Model
-	JSON401      *Error
-	JSON403      *Error
-	JSON404      *Error
-	JSON500      *Error
-}
+	var handler http.Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.AlertRulesDeleteForModel(w, r, parameterOrganization, parameterModel, parameterAlertRule, params)
+	})
 
-// Status returns HTTPResponse.Status
-func (r ModelsGetForOrganizationResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
 	}
-	return http.StatusText(0)
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r ModelsGetForOrganizationResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// AlertRulesGetForModel operation middleware
+func (siw *ServerInterfaceWrapper) AlertRulesGetForModel(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "organization" -------------
+	var parameterOrganization ParameterOrganization
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "organization", runtime.ParamLocationPath, chi.URLParam(r, "organization"), &parameterOrganization)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "organization", Err: err})
+		return
 	}
-	return 0
-}
 
-type ModelsUpdateForOrganizationResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *Model
-	JSON401      *Error
-	JSON403      *Error
-	JSON422      *Error
-	JSON500      *Error
-}
+	// ------------- Path parameter "model" -------------
+	var parameterModel ParameterModel
 
-// Status returns HTTPResponse.Status
-func (r ModelsUpdateForOrganizationResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	err = runtime.BindStyledParameterWithLocation("simple", false, "model", runtime.ParamLocationPath, chi.URLParam(r, "model"), &parameterModel)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "model", Err: err})
+		return
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r ModelsUpdateForOrganizationResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	// ------------- Path parameter "alertRule" -------------
+	var parameterAlertRule ParameterAlertRule
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "alertRule", runtime.ParamLocationPath, chi.URLParam(r, "alertRule"), &parameterAlertRule)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "alertRule", Err: err})
+		return
 	}
-	return 0
-}
 
-type VersionsListForModelResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *[]Version
-	JSON401      *Error
-	JSON403      *Error
-	JSON404      *Error
-	JSON500      *Error
-}
+	// Parameter object where we will unmarshal all parameters from the context
+	var params AlertRulesGetForModelParams
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "If-None-Match" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("If-None-Match")]; found {
+		var IfNoneMatch IfNoneMatch
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "If-None-Match", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithLocation("simple", false, "If-None-Match", runtime.ParamLocationHeader, valueList[0], &IfNoneMatch)
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "If-None-Match", Err: err})
+			return
+		}
+
+		params.IfNoneMatch = &IfNoneMatch
 
-// Status returns HTTPResponse.Status
-func (r VersionsListForModelResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r VersionsListForModelResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	var handler http.Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.AlertRulesGetForModel(w, r, parameterOrganization, parameterModel, parameterAlertRule, params)
+	})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
 	}
-	return 0
-}
 
-type VersionsCreateForModelResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON201      *Version
-	JSON401      *Error
-	JSON403      *Error
-	JSON422      *Error
-	JSON500      *Error
+	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-// Status returns HTTPResponse.Status
-func (r VersionsCreateForModelResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// ComparisonGetForModel operation middleware
+func (siw *ServerInterfaceWrapper) ComparisonGetForModel(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "organization" -------------
+	var parameterOrganization ParameterOrganization
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "organization", runtime.ParamLocationPath, chi.URLParam(r, "organization"), &parameterOrganization)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "organization", Err: err})
+		return
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r VersionsCreateForModelResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	// ------------- Path parameter "model" -------------
+	var parameterModel ParameterModel
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "model", runtime.ParamLocationPath, chi.URLParam(r, "model"), &parameterModel)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "model", Err: err})
+		return
 	}
-	return 0
-}
 
-type VersionsGetForModelResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *Version
-	JSON401      *Error
-	JSON403      *Error
-	JSON404      *Error
-	JSON500      *Error
-}
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ComparisonGetForModelParams
 
-// Status returns HTTPResponse.Status
-func (r VersionsGetForModelResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	// ------------- Required query parameter "champion" -------------
+
+	if paramValue := r.URL.Query().Get("champion"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "champion"})
+		return
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r VersionsGetForModelResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	err = runtime.BindQueryParameter("form", true, true, "champion", r.URL.Query(), &params.Champion)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "champion", Err: err})
+		return
 	}
-	return 0
-}
 
-type ResultsListForVersionResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *[]Result
-	JSON401      *Error
-	JSON403      *Error
-	JSON404      *Error
-	JSON500      *Error
-}
+	// ------------- Required query parameter "challenger" -------------
 
-// Status returns HTTPResponse.Status
-func (r ResultsListForVersionResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	if paramValue := r.URL.Query().Get("challenger"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "challenger"})
+		return
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r ResultsListForVersionResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	err = runtime.BindQueryParameter("form", true, true, "challenger", r.URL.Query(), &params.Challenger)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "challenger", Err: err})
+		return
 	}
-	return 0
-}
 
-type ResultsCreateForVersionResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON201      *Result
-	JSON401      *Error
-	JSON403      *Error
-	JSON422      *Error
-	JSON500      *Error
-}
+	// ------------- Optional query parameter "labelSelector" -------------
 
-// Status returns HTTPResponse.Status
-func (r ResultsCreateForVersionResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	err = runtime.BindQueryParameter("form", true, false, "labelSelector", r.URL.Query(), &params.LabelSelector)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "labelSelector", Err: err})
+		return
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r ResultsCreateForVersionResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	var handler http.Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ComparisonGetForModel(w, r, parameterOrganization, parameterModel, params)
+	})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
 	}
-	return 0
-}
 
-type ResultsGetForVersionResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *Result
-	JSON401      *Error
-	JSON403      *Error
-	JSON404      *Error
-	JSON500      *Error
+	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-// Status returns HTTPResponse.Status
-func (r ResultsGetForVersionResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// MetricsGetForModel operation middleware
+func (siw *ServerInterfaceWrapper) MetricsGetForModel(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "organization" -------------
+	var parameterOrganization ParameterOrganization
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "organization", runtime.ParamLocationPath, chi.URLParam(r, "organization"), &parameterOrganization)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "organization", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "model" -------------
+	var parameterModel ParameterModel
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "model", runtime.ParamLocationPath, chi.URLParam(r, "model"), &parameterModel)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "model", Err: err})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params MetricsGetForModelParams
+
+	// ------------- Required query parameter "stage" -------------
+
+	if paramValue := r.URL.Query().Get("stage"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "stage"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "stage", r.URL.Query(), &params.Stage)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "stage", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "labelSelector" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "labelSelector", r.URL.Query(), &params.LabelSelector)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "labelSelector", Err: err})
+		return
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r ResultsGetForVersionResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	var handler http.Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.MetricsGetForModel(w, r, parameterOrganization, parameterModel, params)
+	})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
 	}
-	return 0
-}
 
-type SchemasListForOrganizationResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *[]Schema
-	JSON401      *Error
-	JSON403      *Error
-	JSON404      *Error
-	JSON500      *Error
+	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-// Status returns HTTPResponse.Status
-func (r SchemasListForOrganizationResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// ResultsListForModel operation middleware
+func (siw *ServerInterfaceWrapper) ResultsListForModel(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "organization" -------------
+	var parameterOrganization ParameterOrganization
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "organization", runtime.ParamLocationPath, chi.URLParam(r, "organization"), &parameterOrganization)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "organization", Err: err})
+		return
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r SchemasListForOrganizationResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	// ------------- Path parameter "model" -------------
+	var parameterModel ParameterModel
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "model", runtime.ParamLocationPath, chi.URLParam(r, "model"), &parameterModel)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "model", Err: err})
+		return
 	}
-	return 0
-}
 
-type SchemasCreateForOrganizationResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON201      *Schema
-	JSON401      *Error
-	JSON403      *Error
-	JSON422      *Error
-	JSON500      *Error
-}
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ResultsListForModelParams
 
-// Status returns HTTPResponse.Status
-func (r SchemasCreateForOrganizationResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	// ------------- Required query parameter "stage" -------------
+
+	if paramValue := r.URL.Query().Get("stage"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "stage"})
+		return
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r SchemasCreateForOrganizationResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	err = runtime.BindQueryParameter("form", true, true, "stage", r.URL.Query(), &params.Stage)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "stage", Err: err})
+		return
 	}
-	return 0
-}
 
-type SchemasGetForOrganizationResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *Schema
-	JSON401      *Error
-	JSON403      *Error
-	JSON404      *Error
-	JSON500      *Error
-}
+	// ------------- Optional query parameter "labelSelector" -------------
 
-// Status returns HTTPResponse.Status
-func (r SchemasGetForOrganizationResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	err = runtime.BindQueryParameter("form", true, false, "labelSelector", r.URL.Query(), &params.LabelSelector)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "labelSelector", Err: err})
+		return
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r SchemasGetForOrganizationResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	var handler http.Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ResultsListForModel(w, r, parameterOrganization, parameterModel, params)
+	})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
 	}
-	return 0
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-// OrganizationsCreateWithBodyWithResponse request with arbitrary body returning *OrganizationsCreateResponse
-func (c *ClientWithResponses) OrganizationsCreateWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*OrganizationsCreateResponse, error) {
-	rsp, err := c.OrganizationsCreateWithBody(ctx, contentType, body, reqEditors...)
+// ResultsCreateForModel operation middleware
+func (siw *ServerInterfaceWrapper) ResultsCreateForModel(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "organization" -------------
+	var parameterOrganization ParameterOrganization
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "organization", runtime.ParamLocationPath, chi.URLParam(r, "organization"), &parameterOrganization)
 	if err != nil {
-		return nil, err
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "organization", Err: err})
+		return
 	}
-	return ParseOrganizationsCreateResponse(rsp)
-}
 
-func (c *ClientWithResponses) OrganizationsCreateWithResponse(ctx context.Context, body OrganizationsCreateJSONRequestBody, reqEditors ...RequestEditorFn) (*OrganizationsCreateResponse, error) {
-	rsp, err := c.OrganizationsCreate(ctx, body, reqEditors...)
+	// ------------- Path parameter "model" -------------
+	var parameterModel ParameterModel
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "model", runtime.ParamLocationPath, chi.URLParam(r, "model"), &parameterModel)
 	if err != nil {
-		return nil, err
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "model", Err: err})
+		return
 	}
-	return ParseOrganizationsCreateResponse(rsp)
-}
 
-// ModelsListForOrganizationWithResponse request returning *ModelsListForOrganizationResponse
-func (c *ClientWithResponses) ModelsListForOrganizationWithResponse(ctx context.Context, parameterOrganization ParameterOrganization, reqEditors ...RequestEditorFn) (*ModelsListForOrganizationResponse, error) {
-	rsp, err := c.ModelsListForOrganization(ctx, parameterOrganization, reqEditors...)
-	if err != nil {
-		return nil, err
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ResultsCreateForModelParams
+
+	// ------------- Required query parameter "stage" -------------
+
+	if paramValue := r.URL.Query().Get("stage"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "stage"})
+		return
 	}
-	return ParseModelsListForOrganizationResponse(rsp)
-}
 
-// ModelsCreateForOrganizationWithBodyWithResponse request with arbitrary body returning *ModelsCreateForOrganizationResponse
-func (c *ClientWithResponses) ModelsCreateForOrganizationWithBodyWithResponse(ctx context.Context, parameterOrganization ParameterOrganization, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ModelsCreateForOrganizationResponse, error) {
-	rsp, err := c.ModelsCreateForOrganizationWithBody(ctx, parameterOrganization, contentType, body, reqEditors...)
+	err = runtime.BindQueryParameter("form", true, true, "stage", r.URL.Query(), &params.Stage)
 	if err != nil {
-		return nil, err
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "stage", Err: err})
+		return
 	}
-	return ParseModelsCreateForOrganizationResponse(rsp)
-}
 
-func (c *ClientWithResponses) ModelsCreateForOrganizationWithResponse(ctx context.Context, parameterOrganization ParameterOrganization, body ModelsCreateForOrganizationJSONRequestBody, reqEditors ...RequestEditorFn) (*ModelsCreateForOrganizationResponse, error) {
-	rsp, err := c.ModelsCreateForOrganization(ctx, parameterOrganization, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	headers := r.Header
+
+	// ------------- Optional header parameter "Idempotency-Key" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("Idempotency-Key")]; found {
+		var IdempotencyKey IdempotencyKey
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "Idempotency-Key", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithLocation("simple", false, "Idempotency-Key", runtime.ParamLocationHeader, valueList[0], &IdempotencyKey)
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "Idempotency-Key", Err: err})
+			return
+		}
+
+		params.IdempotencyKey = &IdempotencyKey
+
 	}
-	return ParseModelsCreateForOrganizationResponse(rsp)
-}
 
-// ModelsGetForOrganizationWithResponse request returning *ModelsGetForOrganizationResponse
-func (c *ClientWithResponses) ModelsGetForOrganizationWithResponse(ctx context.Context, parameterOrganization ParameterOrganization, parameterModel ParameterModel, reqEditors ...RequestEditorFn) (*ModelsGetForOrganizationResponse, error) {
-	rsp, err := c.ModelsGetForOrganization(ctx, parameterOrganization, parameterModel, reqEditors...)
-	if err != nil {
-		return nil, err
+	var handler http.Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ResultsCreateForModel(w, r, parameterOrganization, parameterModel, params)
+	})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
 	}
-	return ParseModelsGetForOrganizationResponse(rsp)
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-// ModelsUpdateForOrganizationWithBodyWithResponse request with arbitrary body returning *ModelsUpdateForOrganizationResponse
-func (c *ClientWithResponses) ModelsUpdateForOrganizationWithBodyWithResponse(ctx context.Context, parameterOrganization ParameterOrganization, parameterModel ParameterModel, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ModelsUpdateForOrganizationResponse, error) {
-	rsp, err := c.ModelsUpdateForOrganizationWithBody(ctx, parameterOrganization, parameterModel, contentType, body, reqEditors...)
+// ResultsStreamForModel operation middleware
+func (siw *ServerInterfaceWrapper) ResultsStreamForModel(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "organization" -------------
+	var parameterOrganization ParameterOrganization
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "organization", runtime.ParamLocationPath, chi.URLParam(r, "organization"), &parameterOrganization)
 	if err != nil {
-		return nil, err
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "organization", Err: err})
+		return
 	}
-	return ParseModelsUpdateForOrganizationResponse(rsp)
-}
 
-func (c *ClientWithResponses) ModelsUpdateForOrganizationWithResponse(ctx context.Context, parameterOrganization ParameterOrganization, parameterModel ParameterModel, body ModelsUpdateForOrganizationJSONRequestBody, reqEditors ...RequestEditorFn) (*ModelsUpdateForOrganizationResponse, error) {
-	rsp, err := c.ModelsUpdateForOrganization(ctx, parameterOrganization, parameterModel, body, reqEditors...)
+	// ------------- Path parameter "model" -------------
+	var parameterModel ParameterModel
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "model", runtime.ParamLocationPath, chi.URLParam(r, "model"), &parameterModel)
 	if err != nil {
-		return nil, err
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "model", Err: err})
+		return
 	}
-	return ParseModelsUpdateForOrganizationResponse(rsp)
-}
 
-// VersionsListForModelWithResponse request returning *VersionsListForModelResponse
-func (c *ClientWithResponses) VersionsListForModelWithResponse(ctx context.Context, parameterOrganization ParameterOrganization, parameterModel ParameterModel, reqEditors ...RequestEditorFn) (*VersionsListForModelResponse, error) {
-	rsp, err := c.VersionsListForModel(ctx, parameterOrganization, parameterModel, reqEditors...)
-	if err != nil {
-		return nil, err
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ResultsStreamForModelParams
+
+	// ------------- Required query parameter "stage" -------------
+
+	if paramValue := r.URL.Query().Get("stage"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "stage"})
+		return
 	}
-	return ParseVersionsListForModelResponse(rsp)
-}
 
-// VersionsCreateForModelWithBodyWithResponse request with arbitrary body returning *VersionsCreateForModelResponse
-func (c *ClientWithResponses) VersionsCreateForModelWithBodyWithResponse(ctx context.Context, parameterOrganization ParameterOrganization, parameterModel ParameterModel, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*VersionsCreateForModelResponse, error) {
-	rsp, err := c.VersionsCreateForModelWithBody(ctx, parameterOrganization, parameterModel, contentType, body, reqEditors...)
+	err = runtime.BindQueryParameter("form", true, true, "stage", r.URL.Query(), &params.Stage)
 	if err != nil {
-		return nil, err
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "stage", Err: err})
+		return
 	}
-	return ParseVersionsCreateForModelResponse(rsp)
-}
 
-func (c *ClientWithResponses) VersionsCreateForModelWithResponse(ctx context.Context, parameterOrganization ParameterOrganization, parameterModel ParameterModel, body VersionsCreateForModelJSONRequestBody, reqEditors ...RequestEditorFn) (*VersionsCreateForModelResponse, error) {
-	rsp, err := c.VersionsCreateForModel(ctx, parameterOrganization, parameterModel, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	headers := r.Header
+
+	// ------------- Optional header parameter "Last-Event-ID" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("Last-Event-ID")]; found {
+		var LastEventID LastEventID
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "Last-Event-ID", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithLocation("simple", false, "Last-Event-ID", runtime.ParamLocationHeader, valueList[0], &LastEventID)
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "Last-Event-ID", Err: err})
+			return
+		}
+
+		params.LastEventID = &LastEventID
+
 	}
-	return ParseVersionsCreateForModelResponse(rsp)
+
+	var handler http.Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ResultsStreamForModel(w, r, parameterOrganization, parameterModel, params)
+	})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-// VersionsGetForModelWithResponse request returning *VersionsGetForModelResponse
-func (c *ClientWithResponses) VersionsGetForModelWithResponse(ctx context.Context, parameterOrganization ParameterOrganization, parameterModel ParameterModel, parameterVersion ParameterVersion, reqEditors ...RequestEditorFn) (*VersionsGetForModelResponse, error) {
-	rsp, err := c.VersionsGetForModel(ctx, parameterOrganization, parameterModel, parameterVersion, reqEditors...)
+// StagesListForModel operation middleware
+func (siw *ServerInterfaceWrapper) StagesListForModel(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "organization" -------------
+	var parameterOrganization ParameterOrganization
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "organization", runtime.ParamLocationPath, chi.URLParam(r, "organization"), &parameterOrganization)
 	if err != nil {
-		return nil, err
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "organization", Err: err})
+		return
 	}
-	return ParseVersionsGetForModelResponse(rsp)
-}
 
-// ResultsListForVersionWithResponse request returning *ResultsListForVersionResponse
-func (c *ClientWithResponses) ResultsListForVersionWithResponse(ctx context.Context, parameterOrganization ParameterOrganization, parameterModel ParameterModel, parameterVersion ParameterVersion, reqEditors ...RequestEditorFn) (*ResultsListForVersionResponse, error) {
-	rsp, err := c.ResultsListForVersion(ctx, parameterOrganization, parameterModel, parameterVersion, reqEditors...)
+	// ------------- Path parameter "model" -------------
+	var parameterModel ParameterModel
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "model", runtime.ParamLocationPath, chi.URLParam(r, "model"), &parameterModel)
 	if err != nil {
-		return nil, err
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "model", Err: err})
+		return
+	}
+
+	var handler http.Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.StagesListForModel(w, r, parameterOrganization, parameterModel)
+	})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
 	}
-	return ParseResultsListForVersionResponse(rsp)
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-// ResultsCreateForVersionWithBodyWithResponse request with arbitrary body returning *ResultsCreateForVersionResponse
-func (c *ClientWithResponses) ResultsCreateForVersionWithBodyWithResponse(ctx context.Context, parameterOrganization ParameterOrganization, parameterModel ParameterModel, parameterVersion ParameterVersion, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ResultsCreateForVersionResponse, error) {
-	rsp, err := c.ResultsCreateForVersionWithBody(ctx, parameterOrganization, parameterModel, parameterVersion, contentType, body, reqEditors...)
+// StagesGetForModel operation middleware
+func (siw *ServerInterfaceWrapper) StagesGetForModel(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "organization" -------------
+	var parameterOrganization ParameterOrganization
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "organization", runtime.ParamLocationPath, chi.URLParam(r, "organization"), &parameterOrganization)
 	if err != nil {
-		return nil, err
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "organization", Err: err})
+		return
 	}
-	return ParseResultsCreateForVersionResponse(rsp)
-}
 
-func (c *ClientWithResponses) ResultsCreateForVersionWithResponse(ctx context.Context, parameterOrganization ParameterOrganization, parameterModel ParameterModel, parameterVersion ParameterVersion, body ResultsCreateForVersionJSONRequestBody, reqEditors ...RequestEditorFn) (*ResultsCreateForVersionResponse, error) {
-	rsp, err := c.ResultsCreateForVersion(ctx, parameterOrganization, parameterModel, parameterVersion, body, reqEditors...)
+	// ------------- Path parameter "model" -------------
+	var parameterModel ParameterModel
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "model", runtime.ParamLocationPath, chi.URLParam(r, "model"), &parameterModel)
 	if err != nil {
-		return nil, err
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "model", Err: err})
+		return
 	}
-	return ParseResultsCreateForVersionResponse(rsp)
-}
 
-// ResultsGetForVersionWithResponse request returning *ResultsGetForVersionResponse
-func (c *ClientWithResponses) ResultsGetForVersionWithResponse(ctx context.Context, parameterOrganization ParameterOrganization, parameterModel ParameterModel, parameterVersion ParameterVersion, parameterResult ParameterResult, reqEditors ...RequestEditorFn) (*ResultsGetForVersionResponse, error) {
-	rsp, err := c.ResultsGetForVersion(ctx, parameterOrganization, parameterModel, parameterVersion, parameterResult, reqEditors...)
+	// ------------- Path parameter "stage" -------------
+	var parameterStage ParameterStage
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "stage", runtime.ParamLocationPath, chi.URLParam(r, "stage"), &parameterStage)
 	if err != nil {
-		return nil, err
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "stage", Err: err})
+		return
 	}
-	return ParseResultsGetForVersionResponse(rsp)
-}
 
-// SchemasListForOrganizationWithResponse request returning *SchemasListForOrganizationResponse
-func (c *ClientWithResponses) SchemasListForOrganizationWithResponse(ctx context.Context, parameterOrganization ParameterOrganization, reqEditors ...RequestEditorFn) (*SchemasListForOrganizationResponse, error) {
-	rsp, err := c.SchemasListForOrganization(ctx, parameterOrganization, reqEditors...)
-	if err != nil {
-		return nil, err
+	// Parameter object where we will unmarshal all parameters from the context
+	var params StagesGetForModelParams
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "If-None-Match" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("If-None-Match")]; found {
+		var IfNoneMatch IfNoneMatch
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "If-None-Match", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithLocation("simple", false, "If-None-Match", runtime.ParamLocationHeader, valueList[0], &IfNoneMatch)
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "If-None-Match", Err: err})
+			return
+		}
+
+		params.IfNoneMatch = &IfNoneMatch
+
 	}
-	return ParseSchemasListForOrganizationResponse(rsp)
-}
 
-// SchemasCreateForOrganizationWithBodyWithResponse request with arbitrary body returning *SchemasCreateForOrganizationResponse
-func (c *ClientWithResponses) SchemasCreateForOrganizationWithBodyWithResponse(ctx context.Context, parameterOrganization ParameterOrganization, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*SchemasCreateForOrganizationResponse, error) {
-	rsp, err := c.SchemasCreateForOrganizationWithBody(ctx, parameterOrganization, contentType, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	var handler http.Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.StagesGetForModel(w, r, parameterOrganization, parameterModel, parameterStage, params)
+	})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
 	}
-	return ParseSchemasCreateForOrganizationResponse(rsp)
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-func (c *ClientWithResponses) SchemasCreateForOrganizationWithResponse(ctx context.Context, parameterOrganization ParameterOrganization, body SchemasCreateForOrganizationJSONRequestBody, reqEditors ...RequestEditorFn) (*SchemasCreateForOrganizationResponse, error) {
-	rsp, err := c.SchemasCreateForOrganization(ctx, parameterOrganization, body, reqEditors...)
+// StagesHistoryForModel operation middleware
+func (siw *ServerInterfaceWrapper) StagesHistoryForModel(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "organization" -------------
+	var parameterOrganization ParameterOrganization
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "organization", runtime.ParamLocationPath, chi.URLParam(r, "organization"), &parameterOrganization)
 	if err != nil {
-		return nil, err
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "organization", Err: err})
+		return
 	}
-	return ParseSchemasCreateForOrganizationResponse(rsp)
-}
 
-// SchemasGetForOrganizationWithResponse request returning *SchemasGetForOrganizationResponse
-func (c *ClientWithResponses) SchemasGetForOrganizationWithResponse(ctx context.Context, parameterOrganization ParameterOrganization, parameterSchema ParameterSchema, reqEditors ...RequestEditorFn) (*SchemasGetForOrganizationResponse, error) {
-	rsp, err := c.SchemasGetForOrganization(ctx, parameterOrganization, parameterSchema, reqEditors...)
+	// ------------- Path parameter "model" -------------
+	var parameterModel ParameterModel
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "model", runtime.ParamLocationPath, chi.URLParam(r, "model"), &parameterModel)
 	if err != nil {
-		return nil, err
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "model", Err: err})
+		return
 	}
-	return ParseSchemasGetForOrganizationResponse(rsp)
-}
 
-// ParseOrganizationsCreateResponse parses an HTTP response from a OrganizationsCreateWithResponse call
-func ParseOrganizationsCreateResponse(rsp *http.Response) (*OrganizationsCreateResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
+	// ------------- Path parameter "stage" -------------
+	var parameterStage ParameterStage
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "stage", runtime.ParamLocationPath, chi.URLParam(r, "stage"), &parameterStage)
 	if err != nil {
-		return nil, err
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "stage", Err: err})
+		return
 	}
 
-	response := &OrganizationsCreateResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
-	}
+	var handler http.Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.StagesHistoryForModel(w, r, parameterOrganization, parameterModel, parameterStage)
+	})
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
-		var dest Organization
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON201 = &dest
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
-		var dest Error
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON401 = &dest
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
-		var dest Error
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON403 = &dest
+// StagesPromoteForModel operation middleware
+func (siw *ServerInterfaceWrapper) StagesPromoteForModel(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 422:
-		var dest Error
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON422 = &dest
+	var err error
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
-		var dest Error
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON500 = &dest
+	// ------------- Path parameter "organization" -------------
+	var parameterOrganization ParameterOrganization
 
+	err = runtime.BindStyledParameterWithLocation("simple", false, "organization", runtime.ParamLocationPath, chi.URLParam(r, "organization"), &parameterOrganization)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "organization", Err: err})
+		return
 	}
 
-	return response, nil
-}
+	// ------------- Path parameter "model" -------------
+	var parameterModel ParameterModel
 
-// ParseModelsListForOrganizationResponse parses an HTTP response from a ModelsListForOrganizationWithResponse call
-func ParseModelsListForOrganizationResponse(rsp *http.Response) (*ModelsListForOrganizationResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
+	err = runtime.BindStyledParameterWithLocation("simple", false, "model", runtime.ParamLocationPath, chi.URLParam(r, "model"), &parameterModel)
 	if err != nil {
-		return nil, err
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "model", Err: err})
+		return
 	}
 
-	response := &ModelsListForOrganizationResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
-	}
+	// ------------- Path parameter "stage" -------------
+	var parameterStage ParameterStage
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest []Model
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON200 = &dest
+	err = runtime.BindStyledParameterWithLocation("simple", false, "stage", runtime.ParamLocationPath, chi.URLParam(r, "stage"), &parameterStage)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "stage", Err: err})
+		return
+	}
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
-		var dest Error
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON401 = &dest
+	var handler http.Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.StagesPromoteForModel(w, r, parameterOrganization, parameterModel, parameterStage)
+	})
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
-		var dest Error
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON403 = &dest
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
-		var dest Error
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON404 = &dest
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
-		var dest Error
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON500 = &dest
+// StagesRollbackForModel operation middleware
+func (siw *ServerInterfaceWrapper) StagesRollbackForModel(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
 
-	}
+	var err error
 
-	return response, nil
-}
+	// ------------- Path parameter "organization" -------------
+	var parameterOrganization ParameterOrganization
 
-// ParseModelsCreateForOrganizationResponse parses an HTTP response from a ModelsCreateForOrganizationWithResponse call
-func ParseModelsCreateForOrganizationResponse(rsp *http.Response) (*ModelsCreateForOrganizationResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
+	err = runtime.BindStyledParameterWithLocation("simple", false, "organization", runtime.ParamLocationPath, chi.URLParam(r, "organization"), &parameterOrganization)
 	if err != nil {
-		return nil, err
-	}
-
-	response := &ModelsCreateForOrganizationResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "organization", Err: err})
+		return
 	}
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
-		var dest Model
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON201 = &dest
+	// ------------- Path parameter "model" -------------
+	var parameterModel ParameterModel
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
-		var dest Error
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON401 = &dest
+	err = runtime.BindStyledParameterWithLocation("simple", false, "model", runtime.ParamLocationPath, chi.URLParam(r, "model"), &parameterModel)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "model", Err: err})
+		return
+	}
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
-		var dest Error
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON403 = &dest
+	// ------------- Path parameter "stage" -------------
+	var parameterStage ParameterStage
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 422:
-		var dest Error
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON422 = &dest
+	err = runtime.BindStyledParameterWithLocation("simple", false, "stage", runtime.ParamLocationPath, chi.URLParam(r, "stage"), &parameterStage)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "stage", Err: err})
+		return
+	}
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
-		var dest Error
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON500 = &dest
+	var handler http.Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.StagesRollbackForModel(w, r, parameterOrganization, parameterModel, parameterStage)
+	})
 
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
 	}
 
-	return response, nil
+	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-// ParseModelsGetForOrganizationResponse parses an HTTP response from a ModelsGetForOrganizationWithResponse call
-func ParseModelsGetForOrganizationResponse(rsp *http.Response) (*ModelsGetForOrganizationResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
+// VersionsListForModel operation middleware
+func (siw *ServerInterfaceWrapper) VersionsListForModel(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "organization" -------------
+	var parameterOrganization ParameterOrganization
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "organization", runtime.ParamLocationPath, chi.URLParam(r, "organization"), &parameterOrganization)
 	if err != nil {
-		return nil, err
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "organization", Err: err})
+		return
 	}
 
-	response := &ModelsGetForOrganizationResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
-	}
+	// ------------- Path parameter "model" -------------
+	var parameterModel ParameterModel
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest Model
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON200 = &dest
+	err = runtime.BindStyledParameterWithLocation("simple", false, "model", runtime.ParamLocationPath, chi.URLParam(r, "model"), &parameterModel)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "model", Err: err})
+		return
+	}
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
-		var dest Error
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON401 = &dest
+	// Parameter object where we will unmarshal all parameters from the context
+	var params VersionsListForModelParams
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
-		var dest Error
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON403 = &dest
+	// ------------- Optional query parameter "labelSelector" -------------
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
-		var dest Error
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON404 = &dest
+	err = runtime.BindQueryParameter("form", true, false, "labelSelector", r.URL.Query(), &params.LabelSelector)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "labelSelector", Err: err})
+		return
+	}
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
-		var dest Error
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON500 = &dest
+	var handler http.Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.VersionsListForModel(w, r, parameterOrganization, parameterModel, params)
+	})
 
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
 	}
 
-	return response, nil
+	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-// ParseModelsUpdateForOrganizationResponse parses an HTTP response from a ModelsUpdateForOrganizationWithResponse call
-func ParseModelsUpdateForOrganizationResponse(rsp *http.Response) (*ModelsUpdateForOrganizationResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
+// VersionsCreateForModel operation middleware
+func (siw *ServerInterfaceWrapper) VersionsCreateForModel(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "organization" -------------
+	var parameterOrganization ParameterOrganization
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "organization", runtime.ParamLocationPath, chi.URLParam(r, "organization"), &parameterOrganization)
 	if err != nil {
-		return nil, err
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "organization", Err: err})
+		return
 	}
 
-	response := &ModelsUpdateForOrganizationResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
+	// ------------- Path parameter "model" -------------
+	var parameterModel ParameterModel
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "model", runtime.ParamLocationPath, chi.URLParam(r, "model"), &parameterModel)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "model", Err: err})
+		return
 	}
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest Model
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON200 = &dest
+	var handler http.Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.VersionsCreateForModel(w, r, parameterOrganization, parameterModel)
+	})
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
-		var dest Error
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON401 = &dest
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
-		var dest Error
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON403 = &dest
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 422:
-		var dest Error
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON422 = &dest
+// VersionsGetForModel operation middleware
+func (siw *ServerInterfaceWrapper) VersionsGetForModel(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
-		var dest Error
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON500 = &dest
+	var err error
+
+	// ------------- Path parameter "organization" -------------
+	var parameterOrganization ParameterOrganization
 
+	err = runtime.BindStyledParameterWithLocation("simple", false, "organization", runtime.ParamLocationPath, chi.URLParam(r, "organization"), &parameterOrganization)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "organization", Err: err})
+		return
 	}
 
-	return response, nil
-}
+	// ------------- Path parameter "model" -------------
+	var parameterModel ParameterModel
 
-// ParseVersionsListForModelResponse parses an HTTP response from a VersionsListForModelWithResponse call
-func ParseVersionsListForModelResponse(rsp *http.Response) (*VersionsListForModelResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
+	err = runtime.BindStyledParameterWithLocation("simple", false, "model", runtime.ParamLocationPath, chi.URLParam(r, "model"), &parameterModel)
 	if err != nil {
-		return nil, err
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "model", Err: err})
+		return
 	}
 
-	response := &VersionsListForModelResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
+	// ------------- Path parameter "version" -------------
+	var parameterVersion ParameterVersion
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "version", runtime.ParamLocationPath, chi.URLParam(r, "version"), &parameterVersion)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "version", Err: err})
+		return
 	}
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest []Version
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON200 = &dest
+	// Parameter object where we will unmarshal all parameters from the context
+	var params VersionsGetForModelParams
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
-		var dest Error
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON401 = &dest
+	headers := r.Header
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
-		var dest Error
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
+	// ------------- Optional header parameter "If-None-Match" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("If-None-Match")]; found {
+		var IfNoneMatch IfNoneMatch
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "If-None-Match", Count: n})
+			return
 		}
-		response.JSON403 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
-		var dest Error
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
+		err = runtime.BindStyledParameterWithLocation("simple", false, "If-None-Match", runtime.ParamLocationHeader, valueList[0], &IfNoneMatch)
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "If-None-Match", Err: err})
+			return
 		}
-		response.JSON404 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
-		var dest Error
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON500 = &dest
+		params.IfNoneMatch = &IfNoneMatch
 
 	}
 
-	return response, nil
-}
-
-// ParseVersionsCreateForModelResponse parses an HTTP response from a VersionsCreateForModelWithResponse call
-func ParseVersionsCreateForModelResponse(rsp *http.Response) (*VersionsCreateForModelResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
-	if err != nil {
-		return nil, err
-	}
+	var handler http.Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.VersionsGetForModel(w, r, parameterOrganization, parameterModel, parameterVersion, params)
+	})
 
-	response := &VersionsCreateForModelResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
 	}
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
-		var dest Version
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON201 = &dest
-
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
-		var dest Error
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON401 = &dest
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
-		var dest Error
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON403 = &dest
+// VersionsPatchForModel operation middleware
+func (siw *ServerInterfaceWrapper) VersionsPatchForModel(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 422:
-		var dest Error
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON422 = &dest
+	var err error
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
-		var dest Error
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON500 = &dest
+	// ------------- Path parameter "organization" -------------
+	var parameterOrganization ParameterOrganization
 
+	err = runtime.BindStyledParameterWithLocation("simple", false, "organization", runtime.ParamLocationPath, chi.URLParam(r, "organization"), &parameterOrganization)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "organization", Err: err})
+		return
 	}
 
-	return response, nil
-}
+	// ------------- Path parameter "model" -------------
+	var parameterModel ParameterModel
 
-// ParseVersionsGetForModelResponse parses an HTTP response from a VersionsGetForModelWithResponse call
-func ParseVersionsGetForModelResponse(rsp *http.Response) (*VersionsGetForModelResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
+	err = runtime.BindStyledParameterWithLocation("simple", false, "model", runtime.ParamLocationPath, chi.URLParam(r, "model"), &parameterModel)
 	if err != nil {
-		return nil, err
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "model", Err: err})
+		return
 	}
 
-	response := &VersionsGetForModelResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
+	// ------------- Path parameter "version" -------------
+	var parameterVersion ParameterVersion
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "version", runtime.ParamLocationPath, chi.URLParam(r, "version"), &parameterVersion)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "version", Err: err})
+		return
 	}
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest Version
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON200 = &dest
+	// Parameter object where we will unmarshal all parameters from the context
+	var params VersionsPatchForModelParams
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
-		var dest Error
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON401 = &dest
+	headers := r.Header
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
-		var dest Error
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
+	// ------------- Optional header parameter "If-Match" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("If-Match")]; found {
+		var IfMatch IfMatch
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "If-Match", Count: n})
+			return
 		}
-		response.JSON403 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
-		var dest Error
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
+		err = runtime.BindStyledParameterWithLocation("simple", false, "If-Match", runtime.ParamLocationHeader, valueList[0], &IfMatch)
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "If-Match", Err: err})
+			return
 		}
-		response.JSON404 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
-		var dest Error
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON500 = &dest
+		params.IfMatch = &IfMatch
 
 	}
 
-	return response, nil
-}
+	var handler http.Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.VersionsPatchForModel(w, r, parameterOrganization, parameterModel, parameterVersion, params)
+	})
 
-// ParseResultsListForVersionResponse parses an HTTP response from a ResultsListForVersionWithResponse call
-func ParseResultsListForVersionResponse(rsp *http.Response) (*ResultsListForVersionResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
-	if err != nil {
-		return nil, err
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
 	}
 
-	response := &ResultsListForVersionResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
-	}
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest []Result
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON200 = &dest
+// VersionsUpdateForModel operation middleware
+func (siw *ServerInterfaceWrapper) VersionsUpdateForModel(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
-		var dest Error
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON401 = &dest
+	var err error
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
-		var dest Error
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON403 = &dest
+	// ------------- Path parameter "organization" -------------
+	var parameterOrganization ParameterOrganization
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
-		var dest Error
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON404 = &dest
+	err = runtime.BindStyledParameterWithLocation("simple", false, "organization", runtime.ParamLocationPath, chi.URLParam(r, "organization"), &parameterOrganization)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "organization", Err: err})
+		return
+	}
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
-		var dest Error
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON500 = &dest
+	// ------------- Path parameter "model" -------------
+	var parameterModel ParameterModel
 
+	err = runtime.BindStyledParameterWithLocation("simple", false, "model", runtime.ParamLocationPath, chi.URLParam(r, "model"), &parameterModel)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "model", Err: err})
+		return
 	}
 
-	return response, nil
-}
+	// ------------- Path parameter "version" -------------
+	var parameterVersion ParameterVersion
 
-// ParseResultsCreateForVersionResponse parses an HTTP response from a ResultsCreateForVersionWithResponse call
-func ParseResultsCreateForVersionResponse(rsp *http.Response) (*ResultsCreateForVersionResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
+	err = runtime.BindStyledParameterWithLocation("simple", false, "version", runtime.ParamLocationPath, chi.URLParam(r, "version"), &parameterVersion)
 	if err != nil {
-		return nil, err
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "version", Err: err})
+		return
 	}
 
-	response := &ResultsCreateForVersionResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
-	}
+	// Parameter object where we will unmarshal all parameters from the context
+	var params VersionsUpdateForModelParams
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
-		var dest Result
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON201 = &dest
+	headers := r.Header
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
-		var dest Error
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
+	// ------------- Optional header parameter "If-Match" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("If-Match")]; found {
+		var IfMatch IfMatch
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "If-Match", Count: n})
+			return
 		}
-		response.JSON401 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
-		var dest Error
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
+		err = runtime.BindStyledParameterWithLocation("simple", false, "If-Match", runtime.ParamLocationHeader, valueList[0], &IfMatch)
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "If-Match", Err: err})
+			return
 		}
-		response.JSON403 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 422:
-		var dest Error
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON422 = &dest
+		params.IfMatch = &IfMatch
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
-		var dest Error
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON500 = &dest
+	}
+
+	var handler http.Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.VersionsUpdateForModel(w, r, parameterOrganization, parameterModel, parameterVersion, params)
+	})
 
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
 	}
 
-	return response, nil
+	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-// ParseResultsGetForVersionResponse parses an HTTP response from a ResultsGetForVersionWithResponse call
-func ParseResultsGetForVersionResponse(rsp *http.Response) (*ResultsGetForVersionResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
+// DriftGetForVersion operation middleware
+func (siw *ServerInterfaceWrapper) DriftGetForVersion(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "organization" -------------
+	var parameterOrganization ParameterOrganization
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "organization", runtime.ParamLocationPath, chi.URLParam(r, "organization"), &parameterOrganization)
 	if err != nil {
-		return nil, err
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "organization", Err: err})
+		return
 	}
 
-	response := &ResultsGetForVersionResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
-	}
+	// ------------- Path parameter "model" -------------
+	var parameterModel ParameterModel
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest Result
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON200 = &dest
+	err = runtime.BindStyledParameterWithLocation("simple", false, "model", runtime.ParamLocationPath, chi.URLParam(r, "model"), &parameterModel)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "model", Err: err})
+		return
+	}
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
-		var dest Error
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON401 = &dest
+	// ------------- Path parameter "version" -------------
+	var parameterVersion ParameterVersion
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
-		var dest Error
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON403 = &dest
+	err = runtime.BindStyledParameterWithLocation("simple", false, "version", runtime.ParamLocationPath, chi.URLParam(r, "version"), &parameterVersion)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "version", Err: err})
+		return
+	}
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
-		var dest Error
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON404 = &dest
+	// Parameter object where we will unmarshal all parameters from the context
+	var params DriftGetForVersionParams
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
-		var dest Error
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON500 = &dest
+	// ------------- Optional query parameter "reference" -------------
 
+	err = runtime.BindQueryParameter("form", true, false, "reference", r.URL.Query(), &params.Reference)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "reference", Err: err})
+		return
 	}
 
-	return response, nil
-}
+	// ------------- Optional query parameter "referenceStart" -------------
 
-// ParseSchemasListForOrganizationResponse parses an HTTP response from a SchemasListForOrganizationWithResponse call
-func ParseSchemasListForOrganizationResponse(rsp *http.Response) (*SchemasListForOrganizationResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
+	err = runtime.BindQueryParameter("form", true, false, "referenceStart", r.URL.Query(), &params.ReferenceStart)
 	if err != nil {
-		return nil, err
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "referenceStart", Err: err})
+		return
 	}
 
-	response := &SchemasListForOrganizationResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
+	// ------------- Optional query parameter "referenceEnd" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "referenceEnd", r.URL.Query(), &params.ReferenceEnd)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "referenceEnd", Err: err})
+		return
 	}
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest []Schema
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON200 = &dest
+	// ------------- Optional query parameter "start" -------------
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
-		var dest Error
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON401 = &dest
+	err = runtime.BindQueryParameter("form", true, false, "start", r.URL.Query(), &params.Start)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "start", Err: err})
+		return
+	}
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
-		var dest Error
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON403 = &dest
+	// ------------- Optional query parameter "end" -------------
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
-		var dest Error
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON404 = &dest
+	err = runtime.BindQueryParameter("form", true, false, "end", r.URL.Query(), &params.End)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "end", Err: err})
+		return
+	}
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
-		var dest Error
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON500 = &dest
+	// ------------- Optional query parameter "bins" -------------
 
+	err = runtime.BindQueryParameter("form", true, false, "bins", r.URL.Query(), &params.Bins)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "bins", Err: err})
+		return
 	}
 
-	return response, nil
-}
+	// ------------- Optional query parameter "labelSelector" -------------
 
-// ParseSchemasCreateForOrganizationResponse parses an HTTP response from a SchemasCreateForOrganizationWithResponse call
-func ParseSchemasCreateForOrganizationResponse(rsp *http.Response) (*SchemasCreateForOrganizationResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
+	err = runtime.BindQueryParameter("form", true, false, "labelSelector", r.URL.Query(), &params.LabelSelector)
 	if err != nil {
-		return nil, err
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "labelSelector", Err: err})
+		return
 	}
 
-	response := &SchemasCreateForOrganizationResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
+	var handler http.Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DriftGetForVersion(w, r, parameterOrganization, parameterModel, parameterVersion, params)
+	})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
 	}
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
-		var dest Schema
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON201 = &dest
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
-		var dest Error
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON401 = &dest
+// MetricsGetForVersion operation middleware
+func (siw *ServerInterfaceWrapper) MetricsGetForVersion(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
-		var dest Error
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON403 = &dest
+	var err error
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 422:
-		var dest Error
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON422 = &dest
+	// ------------- Path parameter "organization" -------------
+	var parameterOrganization ParameterOrganization
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
-		var dest Error
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON500 = &dest
+	err = runtime.BindStyledParameterWithLocation("simple", false, "organization", runtime.ParamLocationPath, chi.URLParam(r, "organization"), &parameterOrganization)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "organization", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "model" -------------
+	var parameterModel ParameterModel
 
+	err = runtime.BindStyledParameterWithLocation("simple", false, "model", runtime.ParamLocationPath, chi.URLParam(r, "model"), &parameterModel)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "model", Err: err})
+		return
 	}
 
-	return response, nil
-}
+	// ------------- Path parameter "version" -------------
+	var parameterVersion ParameterVersion
 
-// ParseSchemasGetForOrganizationResponse parses an HTTP response from a SchemasGetForOrganizationWithResponse call
-func ParseSchemasGetForOrganizationResponse(rsp *http.Response) (*SchemasGetForOrganizationResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
+	err = runtime.BindStyledParameterWithLocation("simple", false, "version", runtime.ParamLocationPath, chi.URLParam(r, "version"), &parameterVersion)
 	if err != nil {
-		return nil, err
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "version", Err: err})
+		return
 	}
 
-	response := &SchemasGetForOrganizationResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
+	// Parameter object where we will unmarshal all parameters from the context
+	var params MetricsGetForVersionParams
+
+	// ------------- Optional query parameter "labelSelector" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "labelSelector", r.URL.Query(), &params.LabelSelector)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "labelSelector", Err: err})
+		return
 	}
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest Schema
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON200 = &dest
+	var handler http.Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.MetricsGetForVersion(w, r, parameterOrganization, parameterModel, parameterVersion, params)
+	})
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
-		var dest Error
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON401 = &dest
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
-		var dest Error
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON403 = &dest
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
-		var dest Error
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON404 = &dest
+// ResultsListForVersion operation middleware
+func (siw *ServerInterfaceWrapper) ResultsListForVersion(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
-		var dest Error
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON500 = &dest
+	var err error
+
+	// ------------- Path parameter "organization" -------------
+	var parameterOrganization ParameterOrganization
 
+	err = runtime.BindStyledParameterWithLocation("simple", false, "organization", runtime.ParamLocationPath, chi.URLParam(r, "organization"), &parameterOrganization)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "organization", Err: err})
+		return
 	}
 
-	return response, nil
-}
+	// ------------- Path parameter "model" -------------
+	var parameterModel ParameterModel
 
-// ServerInterface represents all server handlers.
-type ServerInterface interface {
-	// Create an organization
-	// (POST /organizations)
-	OrganizationsCreate(w http.ResponseWriter, r *http.Request)
-	// List organization models
-	// (GET /organizations/{organization}/models)
-	ModelsListForOrganization(w http.ResponseWriter, r *http.Request, parameterOrganization ParameterOrganization)
-	// Create an organization model
-	// (POST /organizations/{organization}/models)
-	ModelsCreateForOrganization(w http.ResponseWriter, r *http.Request, parameterOrganization ParameterOrganization)
-	// Get organization model
-	// (GET /organizations/{organization}/models/{model})
-	ModelsGetForOrganization(w http.ResponseWriter, r *http.Request, parameterOrganization ParameterOrganization, parameterModel ParameterModel)
-	// Update an organization model
-	// (PUT /organizations/{organization}/models/{model})
-	ModelsUpdateForOrganization(w http.ResponseWriter, r *http.Request, parameterOrganization ParameterOrganization, parameterModel ParameterModel)
-	// List model versions
-	// (GET /organizations/{organization}/models/{model}/versions)
-	VersionsListForModel(w http.ResponseWriter, r *http.Request, parameterOrganization ParameterOrganization, parameterModel ParameterModel)
-	// Create a model version
-	// (POST /organizations/{organization}/models/{model}/versions)
-	VersionsCreateForModel(w http.ResponseWriter, r *http.Request, parameterOrganization ParameterOrganization, parameterModel ParameterModel)
-	// Get model version
-	// (GET /organizations/{organization}/models/{model}/versions/{version})
-	VersionsGetForModel(w http.ResponseWriter, r *http.Request, parameterOrganization ParameterOrganization, parameterModel ParameterModel, parameterVersion ParameterVersion)
-	// List results
-	// (GET /organizations/{organization}/models/{model}/versions/{version}/results)
-	ResultsListForVersion(w http.ResponseWriter, r *http.Request, parameterOrganization ParameterOrganization, parameterModel ParameterModel, parameterVersion ParameterVersion)
-	// Create a model result
-	// (POST /organizations/{organization}/models/{model}/versions/{version}/results)
-	ResultsCreateForVersion(w http.ResponseWriter, r *http.Request, parameterOrganization ParameterOrganization, parameterModel ParameterModel, parameterVersion ParameterVersion)
-	// Get a result
-	// (GET /organizations/{organization}/models/{model}/versions/{version}/results/{result})
-	ResultsGetForVersion(w http.ResponseWriter, r *http.Request, parameterOrganization ParameterOrganization, parameterModel ParameterModel, parameterVersion ParameterVersion, parameterResult ParameterResult)
-	// List organization schemas
-	// (GET /organizations/{organization}/schemas)
-	SchemasListForOrganization(w http.ResponseWriter, r *http.Request, parameterOrganization ParameterOrganization)
-	// Create an organization schema
-	// (POST /organizations/{organization}/schemas)
-	SchemasCreateForOrganization(w http.ResponseWriter, r *http.Request, parameterOrganization ParameterOrganization)
-	// Get organization schema
-	// (GET /organizations/{organization}/schemas/{schema})
-	SchemasGetForOrganization(w http.ResponseWriter, r *http.Request, parameterOrganization ParameterOrganization, parameterSchema ParameterSchema)
-}
+	err = runtime.BindStyledParameterWithLocation("simple", false, "model", runtime.ParamLocationPath, chi.URLParam(r, "model"), &parameterModel)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "model", Err: err})
+		return
+	}
 
-// ServerInterfaceWrapper converts contexts to parameters.
-type ServerInterfaceWrapper struct {
-	Handler            ServerInterface
-	HandlerMiddlewares []MiddlewareFunc
-	ErrorHandlerFunc   func(w http.ResponseWriter, r *http.Request, err error)
-}
+	// ------------- Path parameter "version" -------------
+	var parameterVersion ParameterVersion
 
-type MiddlewareFunc func(http.Handler) http.Handler
+	err = runtime.BindStyledParameterWithLocation("simple", false, "version", runtime.ParamLocationPath, chi.URLParam(r, "version"), &parameterVersion)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "version", Err: err})
+		return
+	}
 
-// OrganizationsCreate operation middleware
-func (siw *ServerInterfaceWrapper) OrganizationsCreate(w http.ResponseWriter, r *http.Request) {
-	ctx := r.Context()
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ResultsListForVersionParams
+
+	// ------------- Optional query parameter "labelSelector" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "labelSelector", r.URL.Query(), &params.LabelSelector)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "labelSelector", Err: err})
+		return
+	}
 
 	var handler http.Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.OrganizationsCreate(w, r)
+		siw.Handler.ResultsListForVersion(w, r, parameterOrganization, parameterModel, parameterVersion, params)
 	})
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -2688,8 +9917,8 @@ func (siw *ServerInterfaceWrapper) OrganizationsCreate(w http.ResponseWriter, r
 	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-// ModelsListForOrganization operation middleware
-func (siw *ServerInterfaceWrapper) ModelsListForOrganization(w http.ResponseWriter, r *http.Request) {
+// ResultsCreateForVersion operation middleware
+func (siw *ServerInterfaceWrapper) ResultsCreateForVersion(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	var err error
@@ -2703,8 +9932,50 @@ func (siw *ServerInterfaceWrapper) ModelsListForOrganization(w http.ResponseWrit
 		return
 	}
 
+	// ------------- Path parameter "model" -------------
+	var parameterModel ParameterModel
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "model", runtime.ParamLocationPath, chi.URLParam(r, "model"), &parameterModel)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "model", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "version" -------------
+	var parameterVersion ParameterVersion
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "version", runtime.ParamLocationPath, chi.URLParam(r, "version"), &parameterVersion)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "version", Err: err})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ResultsCreateForVersionParams
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "Idempotency-Key" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("Idempotency-Key")]; found {
+		var IdempotencyKey IdempotencyKey
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "Idempotency-Key", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithLocation("simple", false, "Idempotency-Key", runtime.ParamLocationHeader, valueList[0], &IdempotencyKey)
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "Idempotency-Key", Err: err})
+			return
+		}
+
+		params.IdempotencyKey = &IdempotencyKey
+
+	}
+
 	var handler http.Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.ModelsListForOrganization(w, r, parameterOrganization)
+		siw.Handler.ResultsCreateForVersion(w, r, parameterOrganization, parameterModel, parameterVersion, params)
 	})
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -2714,8 +9985,8 @@ func (siw *ServerInterfaceWrapper) ModelsListForOrganization(w http.ResponseWrit
 	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-// ModelsCreateForOrganization operation middleware
-func (siw *ServerInterfaceWrapper) ModelsCreateForOrganization(w http.ResponseWriter, r *http.Request) {
+// ResultsCreateBulkForVersion operation middleware
+func (siw *ServerInterfaceWrapper) ResultsCreateBulkForVersion(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	var err error
@@ -2729,8 +10000,50 @@ func (siw *ServerInterfaceWrapper) ModelsCreateForOrganization(w http.ResponseWr
 		return
 	}
 
+	// ------------- Path parameter "model" -------------
+	var parameterModel ParameterModel
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "model", runtime.ParamLocationPath, chi.URLParam(r, "model"), &parameterModel)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "model", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "version" -------------
+	var parameterVersion ParameterVersion
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "version", runtime.ParamLocationPath, chi.URLParam(r, "version"), &parameterVersion)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "version", Err: err})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ResultsCreateBulkForVersionParams
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "Idempotency-Key" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("Idempotency-Key")]; found {
+		var IdempotencyKey IdempotencyKey
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "Idempotency-Key", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithLocation("simple", false, "Idempotency-Key", runtime.ParamLocationHeader, valueList[0], &IdempotencyKey)
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "Idempotency-Key", Err: err})
+			return
+		}
+
+		params.IdempotencyKey = &IdempotencyKey
+
+	}
+
 	var handler http.Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.ModelsCreateForOrganization(w, r, parameterOrganization)
+		siw.Handler.ResultsCreateBulkForVersion(w, r, parameterOrganization, parameterModel, parameterVersion, params)
 	})
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -2740,8 +10053,8 @@ func (siw *ServerInterfaceWrapper) ModelsCreateForOrganization(w http.ResponseWr
 	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-// ModelsGetForOrganization operation middleware
-func (siw *ServerInterfaceWrapper) ModelsGetForOrganization(w http.ResponseWriter, r *http.Request) {
+// ResultsStreamForVersion operation middleware
+func (siw *ServerInterfaceWrapper) ResultsStreamForVersion(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	var err error
@@ -2764,8 +10077,41 @@ func (siw *ServerInterfaceWrapper) ModelsGetForOrganization(w http.ResponseWrite
 		return
 	}
 
+	// ------------- Path parameter "version" -------------
+	var parameterVersion ParameterVersion
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "version", runtime.ParamLocationPath, chi.URLParam(r, "version"), &parameterVersion)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "version", Err: err})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ResultsStreamForVersionParams
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "Last-Event-ID" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("Last-Event-ID")]; found {
+		var LastEventID LastEventID
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "Last-Event-ID", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithLocation("simple", false, "Last-Event-ID", runtime.ParamLocationHeader, valueList[0], &LastEventID)
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "Last-Event-ID", Err: err})
+			return
+		}
+
+		params.LastEventID = &LastEventID
+
+	}
+
 	var handler http.Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.ModelsGetForOrganization(w, r, parameterOrganization, parameterModel)
+		siw.Handler.ResultsStreamForVersion(w, r, parameterOrganization, parameterModel, parameterVersion, params)
 	})
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -2775,8 +10121,8 @@ func (siw *ServerInterfaceWrapper) ModelsGetForOrganization(w http.ResponseWrite
 	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-// ModelsUpdateForOrganization operation middleware
-func (siw *ServerInterfaceWrapper) ModelsUpdateForOrganization(w http.ResponseWriter, r *http.Request) {
+// ResultsGetForVersion operation middleware
+func (siw *ServerInterfaceWrapper) ResultsGetForVersion(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	var err error
@@ -2799,8 +10145,26 @@ func (siw *ServerInterfaceWrapper) ModelsUpdateForOrganization(w http.ResponseWr
 		return
 	}
 
+	// ------------- Path parameter "version" -------------
+	var parameterVersion ParameterVersion
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "version", runtime.ParamLocationPath, chi.URLParam(r, "version"), &parameterVersion)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "version", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "result" -------------
+	var parameterResult ParameterResult
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "result", runtime.ParamLocationPath, chi.URLParam(r, "result"), &parameterResult)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "result", Err: err})
+		return
+	}
+
 	var handler http.Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.ModelsUpdateForOrganization(w, r, parameterOrganization, parameterModel)
+		siw.Handler.ResultsGetForVersion(w, r, parameterOrganization, parameterModel, parameterVersion, parameterResult)
 	})
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -2810,8 +10174,8 @@ func (siw *ServerInterfaceWrapper) ModelsUpdateForOrganization(w http.ResponseWr
 	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-// VersionsListForModel operation middleware
-func (siw *ServerInterfaceWrapper) VersionsListForModel(w http.ResponseWriter, r *http.Request) {
+// SchemasListForOrganization operation middleware
+func (siw *ServerInterfaceWrapper) SchemasListForOrganization(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	var err error
@@ -2825,17 +10189,8 @@ func (siw *ServerInterfaceWrapper) VersionsListForModel(w http.ResponseWriter, r
 		return
 	}
 
-	// ------------- Path parameter "model" -------------
-	var parameterModel ParameterModel
-
-	err = runtime.BindStyledParameterWithLocation("simple", false, "model", runtime.ParamLocationPath, chi.URLParam(r, "model"), &parameterModel)
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "model", Err: err})
-		return
-	}
-
 	var handler http.Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.VersionsListForModel(w, r, parameterOrganization, parameterModel)
+		siw.Handler.SchemasListForOrganization(w, r, parameterOrganization)
 	})
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -2845,8 +10200,8 @@ func (siw *ServerInterfaceWrapper) VersionsListForModel(w http.ResponseWriter, r
 	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-// VersionsCreateForModel operation middleware
-func (siw *ServerInterfaceWrapper) VersionsCreateForModel(w http.ResponseWriter, r *http.Request) {
+// SchemasCreateForOrganization operation middleware
+func (siw *ServerInterfaceWrapper) SchemasCreateForOrganization(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	var err error
@@ -2860,17 +10215,8 @@ func (siw *ServerInterfaceWrapper) VersionsCreateForModel(w http.ResponseWriter,
 		return
 	}
 
-	// ------------- Path parameter "model" -------------
-	var parameterModel ParameterModel
-
-	err = runtime.BindStyledParameterWithLocation("simple", false, "model", runtime.ParamLocationPath, chi.URLParam(r, "model"), &parameterModel)
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "model", Err: err})
-		return
-	}
-
 	var handler http.Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.VersionsCreateForModel(w, r, parameterOrganization, parameterModel)
+		siw.Handler.SchemasCreateForOrganization(w, r, parameterOrganization)
 	})
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -2880,8 +10226,8 @@ func (siw *ServerInterfaceWrapper) VersionsCreateForModel(w http.ResponseWriter,
 	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-// VersionsGetForModel operation middleware
-func (siw *ServerInterfaceWrapper) VersionsGetForModel(w http.ResponseWriter, r *http.Request) {
+// SchemasGetForOrganization operation middleware
+func (siw *ServerInterfaceWrapper) SchemasGetForOrganization(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	var err error
@@ -2895,26 +10241,41 @@ func (siw *ServerInterfaceWrapper) VersionsGetForModel(w http.ResponseWriter, r
 		return
 	}
 
-	// ------------- Path parameter "model" -------------
-	var parameterModel ParameterModel
+	// ------------- Path parameter "schema" -------------
+	var parameterSchema ParameterSchema
 
-	err = runtime.BindStyledParameterWithLocation("simple", false, "model", runtime.ParamLocationPath, chi.URLParam(r, "model"), &parameterModel)
+	err = runtime.BindStyledParameterWithLocation("simple", false, "schema", runtime.ParamLocationPath, chi.URLParam(r, "schema"), &parameterSchema)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "model", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "schema", Err: err})
 		return
 	}
 
-	// ------------- Path parameter "version" -------------
-	var parameterVersion ParameterVersion
+	// Parameter object where we will unmarshal all parameters from the context
+	var params SchemasGetForOrganizationParams
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "If-None-Match" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("If-None-Match")]; found {
+		var IfNoneMatch IfNoneMatch
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "If-None-Match", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithLocation("simple", false, "If-None-Match", runtime.ParamLocationHeader, valueList[0], &IfNoneMatch)
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "If-None-Match", Err: err})
+			return
+		}
+
+		params.IfNoneMatch = &IfNoneMatch
 
-	err = runtime.BindStyledParameterWithLocation("simple", false, "version", runtime.ParamLocationPath, chi.URLParam(r, "version"), &parameterVersion)
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "version", Err: err})
-		return
 	}
 
 	var handler http.Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.VersionsGetForModel(w, r, parameterOrganization, parameterModel, parameterVersion)
+		siw.Handler.SchemasGetForOrganization(w, r, parameterOrganization, parameterSchema, params)
 	})
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -2924,8 +10285,8 @@ func (siw *ServerInterfaceWrapper) VersionsGetForModel(w http.ResponseWriter, r
 	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-// ResultsListForVersion operation middleware
-func (siw *ServerInterfaceWrapper) ResultsListForVersion(w http.ResponseWriter, r *http.Request) {
+// WebhooksListForOrganization operation middleware
+func (siw *ServerInterfaceWrapper) WebhooksListForOrganization(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	var err error
@@ -2939,26 +10300,8 @@ func (siw *ServerInterfaceWrapper) ResultsListForVersion(w http.ResponseWriter,
 		return
 	}
 
-	// ------------- Path parameter "model" -------------
-	var parameterModel ParameterModel
-
-	err = runtime.BindStyledParameterWithLocation("simple", false, "model", runtime.ParamLocationPath, chi.URLParam(r, "model"), &parameterModel)
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "model", Err: err})
-		return
-	}
-
-	// ------------- Path parameter "version" -------------
-	var parameterVersion ParameterVersion
-
-	err = runtime.BindStyledParameterWithLocation("simple", false, "version", runtime.ParamLocationPath, chi.URLParam(r, "version"), &parameterVersion)
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "version", Err: err})
-		return
-	}
-
 	var handler http.Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.ResultsListForVersion(w, r, parameterOrganization, parameterModel, parameterVersion)
+		siw.Handler.WebhooksListForOrganization(w, r, parameterOrganization)
 	})
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -2968,8 +10311,8 @@ func (siw *ServerInterfaceWrapper) ResultsListForVersion(w http.ResponseWriter,
 	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-// ResultsCreateForVersion operation middleware
-func (siw *ServerInterfaceWrapper) ResultsCreateForVersion(w http.ResponseWriter, r *http.Request) {
+// WebhooksCreateForOrganization operation middleware
+func (siw *ServerInterfaceWrapper) WebhooksCreateForOrganization(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	var err error
@@ -2983,26 +10326,8 @@ func (siw *ServerInterfaceWrapper) ResultsCreateForVersion(w http.ResponseWriter
 		return
 	}
 
-	// ------------- Path parameter "model" -------------
-	var parameterModel ParameterModel
-
-	err = runtime.BindStyledParameterWithLocation("simple", false, "model", runtime.ParamLocationPath, chi.URLParam(r, "model"), &parameterModel)
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "model", Err: err})
-		return
-	}
-
-	// ------------- Path parameter "version" -------------
-	var parameterVersion ParameterVersion
-
-	err = runtime.BindStyledParameterWithLocation("simple", false, "version", runtime.ParamLocationPath, chi.URLParam(r, "version"), &parameterVersion)
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "version", Err: err})
-		return
-	}
-
 	var handler http.Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.ResultsCreateForVersion(w, r, parameterOrganization, parameterModel, parameterVersion)
+		siw.Handler.WebhooksCreateForOrganization(w, r, parameterOrganization)
 	})
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -3012,8 +10337,8 @@ func (siw *ServerInterfaceWrapper) ResultsCreateForVersion(w http.ResponseWriter
 	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-// ResultsGetForVersion operation middleware
-func (siw *ServerInterfaceWrapper) ResultsGetForVersion(w http.ResponseWriter, r *http.Request) {
+// WebhooksDeleteForOrganization operation middleware
+func (siw *ServerInterfaceWrapper) WebhooksDeleteForOrganization(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	var err error
@@ -3027,35 +10352,41 @@ func (siw *ServerInterfaceWrapper) ResultsGetForVersion(w http.ResponseWriter, r
 		return
 	}
 
-	// ------------- Path parameter "model" -------------
-	var parameterModel ParameterModel
+	// ------------- Path parameter "webhook" -------------
+	var parameterWebhook ParameterWebhook
 
-	err = runtime.BindStyledParameterWithLocation("simple", false, "model", runtime.ParamLocationPath, chi.URLParam(r, "model"), &parameterModel)
+	err = runtime.BindStyledParameterWithLocation("simple", false, "webhook", runtime.ParamLocationPath, chi.URLParam(r, "webhook"), &parameterWebhook)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "model", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "webhook", Err: err})
 		return
 	}
 
-	// ------------- Path parameter "version" -------------
-	var parameterVersion ParameterVersion
+	// Parameter object where we will unmarshal all parameters from the context
+	var params WebhooksDeleteForOrganizationParams
 
-	err = runtime.BindStyledParameterWithLocation("simple", false, "version", runtime.ParamLocationPath, chi.URLParam(r, "version"), &parameterVersion)
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "version", Err: err})
-		return
-	}
+	headers := r.Header
 
-	// ------------- Path parameter "result" -------------
-	var parameterResult ParameterResult
+	// ------------- Optional header parameter "If-Match" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("If-Match")]; found {
+		var IfMatch IfMatch
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "If-Match", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithLocation("simple", false, "If-Match", runtime.ParamLocationHeader, valueList[0], &IfMatch)
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "If-Match", Err: err})
+			return
+		}
+
+		params.IfMatch = &IfMatch
 
-	err = runtime.BindStyledParameterWithLocation("simple", false, "result", runtime.ParamLocationPath, chi.URLParam(r, "result"), &parameterResult)
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "result", Err: err})
-		return
 	}
 
 	var handler http.Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.ResultsGetForVersion(w, r, parameterOrganization, parameterModel, parameterVersion, parameterResult)
+		siw.Handler.WebhooksDeleteForOrganization(w, r, parameterOrganization, parameterWebhook, params)
 	})
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -3065,8 +10396,8 @@ func (siw *ServerInterfaceWrapper) ResultsGetForVersion(w http.ResponseWriter, r
 	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-// SchemasListForOrganization operation middleware
-func (siw *ServerInterfaceWrapper) SchemasListForOrganization(w http.ResponseWriter, r *http.Request) {
+// WebhooksGetForOrganization operation middleware
+func (siw *ServerInterfaceWrapper) WebhooksGetForOrganization(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	var err error
@@ -3080,34 +10411,41 @@ func (siw *ServerInterfaceWrapper) SchemasListForOrganization(w http.ResponseWri
 		return
 	}
 
-	var handler http.Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.SchemasListForOrganization(w, r, parameterOrganization)
-	})
+	// ------------- Path parameter "webhook" -------------
+	var parameterWebhook ParameterWebhook
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
+	err = runtime.BindStyledParameterWithLocation("simple", false, "webhook", runtime.ParamLocationPath, chi.URLParam(r, "webhook"), &parameterWebhook)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "webhook", Err: err})
+		return
 	}
 
-	handler.ServeHTTP(w, r.WithContext(ctx))
-}
+	// Parameter object where we will unmarshal all parameters from the context
+	var params WebhooksGetForOrganizationParams
 
-// SchemasCreateForOrganization operation middleware
-func (siw *ServerInterfaceWrapper) SchemasCreateForOrganization(w http.ResponseWriter, r *http.Request) {
-	ctx := r.Context()
+	headers := r.Header
 
-	var err error
+	// ------------- Optional header parameter "If-None-Match" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("If-None-Match")]; found {
+		var IfNoneMatch IfNoneMatch
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "If-None-Match", Count: n})
+			return
+		}
 
-	// ------------- Path parameter "organization" -------------
-	var parameterOrganization ParameterOrganization
+		err = runtime.BindStyledParameterWithLocation("simple", false, "If-None-Match", runtime.ParamLocationHeader, valueList[0], &IfNoneMatch)
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "If-None-Match", Err: err})
+			return
+		}
+
+		params.IfNoneMatch = &IfNoneMatch
 
-	err = runtime.BindStyledParameterWithLocation("simple", false, "organization", runtime.ParamLocationPath, chi.URLParam(r, "organization"), &parameterOrganization)
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "organization", Err: err})
-		return
 	}
 
 	var handler http.Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.SchemasCreateForOrganization(w, r, parameterOrganization)
+		siw.Handler.WebhooksGetForOrganization(w, r, parameterOrganization, parameterWebhook, params)
 	})
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -3117,8 +10455,8 @@ func (siw *ServerInterfaceWrapper) SchemasCreateForOrganization(w http.ResponseW
 	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-// SchemasGetForOrganization operation middleware
-func (siw *ServerInterfaceWrapper) SchemasGetForOrganization(w http.ResponseWriter, r *http.Request) {
+// WebhooksDeliveriesForOrganization operation middleware
+func (siw *ServerInterfaceWrapper) WebhooksDeliveriesForOrganization(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	var err error
@@ -3132,17 +10470,28 @@ func (siw *ServerInterfaceWrapper) SchemasGetForOrganization(w http.ResponseWrit
 		return
 	}
 
-	// ------------- Path parameter "schema" -------------
-	var parameterSchema ParameterSchema
+	// ------------- Path parameter "webhook" -------------
+	var parameterWebhook ParameterWebhook
 
-	err = runtime.BindStyledParameterWithLocation("simple", false, "schema", runtime.ParamLocationPath, chi.URLParam(r, "schema"), &parameterSchema)
+	err = runtime.BindStyledParameterWithLocation("simple", false, "webhook", runtime.ParamLocationPath, chi.URLParam(r, "webhook"), &parameterWebhook)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "schema", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "webhook", Err: err})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params WebhooksDeliveriesForOrganizationParams
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
 		return
 	}
 
 	var handler http.Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.SchemasGetForOrganization(w, r, parameterOrganization, parameterSchema)
+		siw.Handler.WebhooksDeliveriesForOrganization(w, r, parameterOrganization, parameterWebhook, params)
 	})
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -3277,9 +10626,54 @@ func HandlerWithOptions(si ServerInterface, options ChiServerOptions) http.Handl
 	r.Group(func(r chi.Router) {
 		r.Get(options.BaseURL+"/organizations/{organization}/models/{model}", wrapper.ModelsGetForOrganization)
 	})
+	r.Group(func(r chi.Router) {
+		r.Patch(options.BaseURL+"/organizations/{organization}/models/{model}", wrapper.ModelsPatchForOrganization)
+	})
 	r.Group(func(r chi.Router) {
 		r.Put(options.BaseURL+"/organizations/{organization}/models/{model}", wrapper.ModelsUpdateForOrganization)
 	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/organizations/{organization}/models/{model}/alert-rules", wrapper.AlertRulesListForModel)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/organizations/{organization}/models/{model}/alert-rules", wrapper.AlertRulesCreateForModel)
+	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/organizations/{organization}/models/{model}/alert-rules/{alertRule}", wrapper.AlertRulesDeleteForModel)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/organizations/{organization}/models/{model}/alert-rules/{alertRule}", wrapper.AlertRulesGetForModel)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/organizations/{organization}/models/{model}/comparison", wrapper.ComparisonGetForModel)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/organizations/{organization}/models/{model}/metrics", wrapper.MetricsGetForModel)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/organizations/{organization}/models/{model}/results", wrapper.ResultsListForModel)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/organizations/{organization}/models/{model}/results", wrapper.ResultsCreateForModel)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/organizations/{organization}/models/{model}/results/stream", wrapper.ResultsStreamForModel)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/organizations/{organization}/models/{model}/stages", wrapper.StagesListForModel)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/organizations/{organization}/models/{model}/stages/{stage}", wrapper.StagesGetForModel)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/organizations/{organization}/models/{model}/stages/{stage}/history", wrapper.StagesHistoryForModel)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/organizations/{organization}/models/{model}/stages/{stage}/promote", wrapper.StagesPromoteForModel)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/organizations/{organization}/models/{model}/stages/{stage}/rollback", wrapper.StagesRollbackForModel)
+	})
 	r.Group(func(r chi.Router) {
 		r.Get(options.BaseURL+"/organizations/{organization}/models/{model}/versions", wrapper.VersionsListForModel)
 	})
@@ -3289,12 +10683,30 @@ func HandlerWithOptions(si ServerInterface, options ChiServerOptions) http.Handl
 	r.Group(func(r chi.Router) {
 		r.Get(options.BaseURL+"/organizations/{organization}/models/{model}/versions/{version}", wrapper.VersionsGetForModel)
 	})
+	r.Group(func(r chi.Router) {
+		r.Patch(options.BaseURL+"/organizations/{organization}/models/{model}/versions/{version}", wrapper.VersionsPatchForModel)
+	})
+	r.Group(func(r chi.Router) {
+		r.Put(options.BaseURL+"/organizations/{organization}/models/{model}/versions/{version}", wrapper.VersionsUpdateForModel)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/organizations/{organization}/models/{model}/versions/{version}/drift", wrapper.DriftGetForVersion)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/organizations/{organization}/models/{model}/versions/{version}/metrics", wrapper.MetricsGetForVersion)
+	})
 	r.Group(func(r chi.Router) {
 		r.Get(options.BaseURL+"/organizations/{organization}/models/{model}/versions/{version}/results", wrapper.ResultsListForVersion)
 	})
 	r.Group(func(r chi.Router) {
 		r.Post(options.BaseURL+"/organizations/{organization}/models/{model}/versions/{version}/results", wrapper.ResultsCreateForVersion)
 	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/organizations/{organization}/models/{model}/versions/{version}/results/bulk", wrapper.ResultsCreateBulkForVersion)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/organizations/{organization}/models/{model}/versions/{version}/results/stream", wrapper.ResultsStreamForVersion)
+	})
 	r.Group(func(r chi.Router) {
 		r.Get(options.BaseURL+"/organizations/{organization}/models/{model}/versions/{version}/results/{result}", wrapper.ResultsGetForVersion)
 	})
@@ -3307,6 +10719,21 @@ func HandlerWithOptions(si ServerInterface, options ChiServerOptions) http.Handl
 	r.Group(func(r chi.Router) {
 		r.Get(options.BaseURL+"/organizations/{organization}/schemas/{schema}", wrapper.SchemasGetForOrganization)
 	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/organizations/{organization}/webhooks", wrapper.WebhooksListForOrganization)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/organizations/{organization}/webhooks", wrapper.WebhooksCreateForOrganization)
+	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/organizations/{organization}/webhooks/{webhook}", wrapper.WebhooksDeleteForOrganization)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/organizations/{organization}/webhooks/{webhook}", wrapper.WebhooksGetForOrganization)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/organizations/{organization}/webhooks/{webhook}/deliveries", wrapper.WebhooksDeliveriesForOrganization)
+	})
 
 	return r
 }
@@ -3314,45 +10741,161 @@ func HandlerWithOptions(si ServerInterface, options ChiServerOptions) http.Handl
 // Base64 encoded, gzipped, json marshaled Swagger object
 var swaggerSpec = []string{
 
-	"H4sIAAAAAAAC/+xcS3PjuBH+KygkVXuhRNL2pHZ1c3ZmJ05lxltjZw/x6gCTkIQdimAAUDOOiv89hRff",
-	"pKiXJW35NOIDQLPxdffXjfasYUCXCY1xLDicrGGCGFpigZm6+kRDHMkfIeYBI4kgNIYT+LjAYCkfgRgt",
-	"8RjIa/kLEA5iKkCAOAYcx5wIssJj6EAihyVILKAD5ZtwAtUE0IEM/zclDIdwIliKHciDBV4iuah4SeSL",
-	"XDASz2HmwO+jOR2Z4b9aQbWMmQPv2RzF5H9IC9kmMy29sY/o5XkO8AUVwTMHfsE8jUT7JzD1DNy97xBN",
-	"Px8iFIkFnmPWKZWRInPgQz60KY+edh9lGsH2V6MRM3Pgb5jxThCs9MN9RDZTHEBmK2mWybl4QmOOleV9",
-	"YIzKLVB35I2AxgLHChUoSSISKLi4f3D9ncXCf2V4BifwL25h2K5+yl01K8zkalXF3MYAy2fACjFWIplx",
-	"uUBNjbYMdGDCaIKZIPpTAhqqL8Df0TKJMJzceDdOCwqxXSF/EX6mAvxC0ziETl2hWVn5T3oRO8c0c7pc",
-	"161xXAwnDHOpHIDAEgULEmMQYcRiEs8Bx2xFAgxmaRTNSBTJe3I1zAVv+UCGkZBilEW/8nx/5N2MfO/x",
-	"ypt4P02u/f9AB84oWyIBJzBEAo8EWeLmp8nNmaE0El2Gd/ce0BkQhfkJClKOwYwyQJYSHEREL8DIZb7Y",
-	"gFbJn4vpX13fvPtb226Q6vd0vFiF9d17OVJf1GX+LI3MSK0EqsgB6fMfOBCjEAscCMralEJ7/XuhEzX7",
-	"D7zi7Qd+dJqErTt5M/KuR971o//j5N2PE98bupM1kJLQ+hCnHkMshgoZpg4URCgJPplQaWbXutoc8m7j",
-	"asQjEutyeZ6gAIMFjUIJbKUvDlAcSvURZkD1KkA/Jso691/60vilTZxX3P/eDb+vgqOx710M4dbyg5J7",
-	"U6pIRZIKkDAapgEGzy8AgQQxQYI0QiwPiXR2js5wd4yQOEk7eJR6BOZkhWPpPXO/IS+smsSCcKPQKn74",
-	"9cR1n9PgKxauZAauoK7ZHCOGEU5G5/EX9O0T5hzNsZRp2R6Xau5roL96BZeoodPBpzWsxALl2AoluApt",
-	"yogkr7SildIr6z6tYRAhzqVRIqk9HlCG4cQb/5Q5xbOvRAgclx+/y6YbVa0A1Sq4fMIFWiZSQd8WEgMF",
-	"t/6GeP41Y/Beh2JuUSKH6i/Ov3IkGAq+WlPBkgsFmKwwN7MqU6kiaC+DkFTzvmdbAsoYDkRle/iCplEI",
-	"nnGxUVvsTV3//gDtH9qXOjnt7gG6eWUQtocHZ5uqFrxfe5bcOip7YoC3wcV/sYlaw7l3sb5by/Zqzh1/",
-	"T3AgeR4XLA1EyvIIqKTUkV0Lyvtd/MV6838+3H8GWm+g9LTu/rQ+KuDoStS6cX3B9LbPlw9UoZ6iXYX1",
-	"isLr+YYeU25Y6jbE+8HWJhpG2lliuM3ZVCXF/LNSrIPSmQs2LT40VW91SWUXffapqo2GeeGu14R+y2Nm",
-	"zYYy5dJntKOumOCAzEyVS1GVn2XS9gO3NN1Srttf76TGIhJgUykz+LxNULDA4GrsaS6oxakStlJMn0B/",
-	"7I29EYqSBfIVahIco4TACbyWT6QZIrFQFuiWVaLr5pS3ONaflWbk9jYwJQ1aXdyFtYSP61Gmuoi5+DsN",
-	"XwaUAA0MuBZDsVb5c4WitKwXnfxmWQW0VQfTbod5qbQjv+4HlJpz2oqCahG1Xgm98vyD1T+rlfZmGTQv",
-	"uGYOvNHrtk2Xy+dWy7Rq1PUuo66udhj1zvO2HiV3PV0uEXvJ4VlHp9xKNOdy06own8rRVei76/Jl5upa",
-	"kpRqjlvs4V+EG9Jqik4k3mwbqvrF5dBfKKtVRsrHVU/tqiheqW3/tAE0byugEYGXfBPi8tMpg3vEGHo5",
-	"K+h5N6eAntzOanXSQKcAn7kxzZxN3tXEhOFg0iOPAKdD+uvaIYClAtaNy9SczAhm/b782EcJQ1lcPXrk",
-	"TO4CwoYx4rd40RovgCWEDcMdGC7ctfo36wwbH7HYxco/4gNHDGfj+wYp+4aWywLjaSLIRywGwtCBrVUP",
-	"nazsAqx/q5GnxNbR48xZxZRsJ//vvfn/A5ucxv3R/L9rMTAgf7CvKlChgk1UzdUUH2zyYM/RLyIGDEov",
-	"8v6htwSjLcGoupYSRPNbQ7ILW7sdBLU8tXh9sB2hWLTyx97YL4p9Q6LDMP5fOqlr1Gt5T8NhI7ik3B46",
-	"E14us7clGV1nf6bQadY9dbpRNAW+JRw64agacrsd7xps3LX5tTH/2MoP6OTjVZ3A5hdzZB0zQTlH+J4u",
-	"RXkV5Lq6dWUIcTJvGhB3NoG1g1s3LVg6VRzt/NngPYh7FQ3yb9SrSb0sIgvE2ztDKFfRddcKwJxknTkE",
-	"D8nITPvLE0zQHANf2unV7/rL5xhc1a7Vc//3WJKZou0jYTgkgTm0fPId3/GneaNc1+lxtd2sPoUnp1D7",
-	"f90mbn+vpJVsvzbA4eL3t7T1strD9pJu6I8Z0HS5Vb/lpTVKnkMfZK/O6t0SPe2Ap84mbJh6SyaqyUT+",
-	"l3LN+HQ4Quau9Y+NuYUxqX1Ymc44zjcibn7VQvWY2ckZmsPpkhO0lxWU/jpwQ9Jh3hx0xKJPFS6136P4",
-	"I9S3pGBDw4eFT4E9e2dIhmCqf1sg6iKaPnKa2WA7zb6PMk9sFDh35LJbd9Lv1C1fLwZrQVtrwQfrWefb",
-	"krr2BvJT0znrYN7oXHszSt6V3HQqQwOau9Y/NpK27X3QCVpSLGCOSarOEJRn0pTSB0c5UuXBet9TFsEJ",
-	"XCeMChrQKJu47npBuZB+KHNRQtyVb7rTHbhCjKBnE0jsW5WgAv9x//D4+fbTB1jfkwcczUZyjG2+aOTl",
-	"dsKxVIoVqDr7Qohkh5ntZGMJlmmulrqBfYjDhJJYVxOWKEbzar85BymXs0o3/+XDw6P9M4CW/6CFKyPZ",
-	"PH1nR3L/UqbZYdga3Ty4fxELmmGr5L0RRdLYP31+FjFsfnuEoKZvJqj9a9n8Iptm/w8AAP///PK8kXxI",
-	"AAA=",
+	"H4sIAAAAAAAC/+x9a3Mbt7LgX8HOblV26/Kph+0odT7oWM6J1o7jY8nJ1iYuC5xpkrgaAmMAI4nXpf9+",
+	"C895EMMZStTL0SdRJAbTaPQL3Y3ub1HMFhmjQKWIDr5Fc8AJcP3xzSmeqb8JiJiTTBJGo4PodA4IqCRy",
+	"iSSeITZFcg4ozjkHKtEFcEEYdV9zECznMfTQ5ZzEcxTPMZ2BQJdzoHABvDIIEYHyLMESkkHUi0Q8hwVW",
+	"AMhlBtFBJCQndBZdX1/3ogxzvABpIT1MgcuPeQphcLH6GfE8BUTxAtTkRP2WYTmPepH6LjqIsJ+kF3H4",
+	"mhMOSXQgeQ5rYOlFV/0Z69spPjioCoCue9FxAouMSaDx8i0sV0E8RDklX3NA57BE8ZwJoGiyNGhNCVDZ",
+	"QzCYDRBGnz4dH/XQJZFzi0/JEAfJlxaPX3MQEgk8hXQ5QMdThP2X+iE1SuCFedMlFginHHCyRIoGUpCQ",
+	"IJHHMQgxzdN02UNECrU9GaNCbw8HmXMKCcIzTKielOUSccgAS0JnZTgG6C0sBYKrjHBAeCqBI4wSvFSL",
+	"S2CK81T6rTBkV2xGCWd9hbR15NCLjqe/YhnPV1H7xhOqWCVJXFoPofpHRfLIwDJApyWcMpouDW4gEYhU",
+	"p9KopExa8k6QIFS9gAxgoFHouKPEN0QgRsECtfgJMTkHfkkUmiWaYpIKs2UY7Y13/B4042vaNyhoQ9R7",
+	"RuEukHVclQOrEqLM5aWV9xBGu6O9gsocTWE0YcmyQnSECgk4qc+4DilquZ0w8w5PID2BFGLJeIhH3+YT",
+	"4BQkiL6QyxRQqp5Awj6iWHFKUglckbf+TVi+PeMwI4z+A/Ie0AuFvf+dcZb0hMQzQmf/p/c/4CoDThZA",
+	"JU7P/HK+5sCXxWrSCoRtqxHyzQVQeXwUFonHRw6LKRYSgRqLOMRALiBBU84WCFMEmKcEOBKSA14M0EcQ",
+	"earomQOWjs4R5mBHqEcJF81sreDqa8D6x0ehNRAqYQZcL+JXlkAaBn+hfjLCXLOp+oSIZUIsAAmggkhy",
+	"0STs9QRbEPQGxute9BufYUr+CxsgQzCz0ojbgF6eZwsrqAB+3YvMHoeXwPVv6PioATTzexeg/D43QGWh",
+	"uO5FJ/7RVXjMtLdBpgXs9mi0YCqAJZ41GCKK4w2QTjQoQZDHashZE4R6ui0AqOdx8P1by5YgkBqHVjgk",
+	"kKVsqQSThf1SmSfeypMM5WLtWmoybOPFXPei383LwsA6SG5BAnaKLaDYQXrdi/6AyZyx8zDQl+bHdcao",
+	"HbIFoBwk19dmLhDynywhoA1nw2avtThX/8eMSqCa+3GWpSTWYmH4n8LgH66wshOFWZa24NTHC5zm+nFC",
+	"s1xGB39GmaKVsaKinb8UZvX/O7X/9e/jv2j0uWeUm57XKMvoIIJcrYnlUs/5Lco4JESTl4gO/hz3xr3x",
+	"5+teJIle785ovNcf7fZHu6fjVwf7rw7Go/8f9SKFs98apxipKRRixG5oHZHYPRgOJ3l8DnKo9mco2ZBN",
+	"/hNiGRWQ/fktilMsRHQQxVhqpcY4RAejwY/XveK3cyIl0PLP+9efNwH/z+a5xtd2GZ42/heHaXQQ/c9h",
+	"cbobml/F8D1cOvF6fV0nMPONscXMKZBzxj/ab9bQSMbZJIXFfzha6QbLB/OUgaRmclEE6t0l47dYY3Hs",
+	"+xUkJ3GDmaB/U5ZM6QhIhDp9EAoJYvQnZ4ue4TjOOY6XZz10lgmi/pynZ4hxdHYuzgalAWoGJRzdF4Ul",
+	"NYE0hcSqSfFTZSJMEz0TWgAWOQf9SMLJVLrnpwTSxJvemgaFfsxQmlCiwnJgdBC5t0eKhqT+SuMDWYT0",
+	"VuWD/v23DDgO2rjaSLG/KrmeIMn0yRBbaDV/OPgsbv25Us45iDlLkwKlf+Wj0W581nOf/uE/gkGs+fyP",
+	"s+rKzOCVdXnAm1YWdgAcVjZ/Srh1PyDslmAXVHJdYGtgyjmWCK9qwYwRqvZGogvCUixBIFwgQK0m4wqT",
+	"0opZHCv9c6Ks5TDalRQwNKVBFRJzaYxpQmdV7DRIiinjCyyjgyjBEvpqvhCerOFekuVmxnF/tNcfj053",
+	"RgejHw92x91n1EQbXpT+yVoMhtCJQKCIyLl4Chg0tQ/wrOEd+tPKS/6Yg5wDLyFOHcTNaHVgdZTKhDnb",
+	"UOleTxgdFG+aMJYC1nqbVHEz3tnd23/Rq1utVVV7fKSeXHg5tE7elUWWeih8xClOZ3pABVXjXsCGNpDU",
+	"p3lfsuQKHqgi/h27PCxJkjrqWUlatK7Lc6h6cO15qFhgeVz7OkXYtn7f3WRtJsHCfA0hwnN3gx2qRWNJ",
+	"LhLhRGeiZWTlXaPBj2X+YvkkLRE+zRcTs1zrDV1h15sLAG/iNC2hKt27MFFpVa/2Oy3rktCEXYahSIHO",
+	"5Ny91YxU/1mdakRy8ybu7M1Hi5FYXXrF0PlT8bnlml79QO1cA+6ssnD6lJUUkKcGvxovpgopW2zg57oy",
+	"s35mC6S1Ka970T/z9Nx6WRoUdC5jZqhdv0aJOocbQtEkT89X9U/MctpwpDe74qcrrJdBFGTAc5JlkLTN",
+	"VdmtS+BgjmP2DROIcS4AYTuu5pw2Tm/lonLuabgiIgxRbVvNQgswS3gvYzaA+NeaWYkISatD7eXsS9ZX",
+	"f1HshxpbIZ7jVJEtcC9lrOs2nuNFpv5330+w0GanR1CGFejW3U84ihnnkBof0fHR6k46q+8IUtngFCnA",
+	"+UEUNuqC0NyYrQ6o8q/MeHQtNI4C0G8LItWGkSkColWtf0LFaug5ZZd1GTDa6SQD8IwDLKCJLKcca1Gs",
+	"MKygEmjKuA16TJicO4wKZMQ2JAX9GHO5Ar2C3TgqKTPz3UgeF6hdr4NWKaLyuuhiPBgPRkEDzT9pjATR",
+	"pnjdsMqzfxAq2ljUn1bq6NWhjtoqlF2lSTOWlZXsjUJCwlFYK5IqzLGColEjivRzN0KQfnJr6DHwNyFn",
+	"J4gcPV/b22uMWLGLRqPgvJeEfrRenC7sdMl0kNGytXfoeXltf6/SQYWnLCfp5V+umAIvXnbgqLr0dnRT",
+	"4TSHstr+rdD7KmWE2KmkEUoiP6AQjtSJ5SNkjMuQRqgqAW14EkWjk1xqsdTxTF+KZWl9oBVKRYtIbb7q",
+	"7zlMgQONYVUv2Ljb6y6KvvSucsjO2loFCTj7tRP5mYWqVxMJi1au/FkN1yiOrv10mHO8jDRR2IWulyB+",
+	"2KYixD94E3wVb+2Gsf0GjF00+bXLS9xIfdTYqezYdvjsVSllBRV+I0t8orcJWVYIcIqO553qb0OIVOM1",
+	"/VITZiz8Q+Zwa63Cs577whrN6gtDLuUhDiGBr0rPmS0bTFTg94sbql1O2qr3QwfosGmwhlWfNKzIY2rL",
+	"lR7Aaeppwpu0yyLXolfYs6tERCg601aq8QiqQSm7BCH1v3Mym6vPZvcJR8dH5hGSWA+iCtceJzWPWQ0p",
+	"JdeZ3hukNyfAByUuDG6dd09iFeqdpVaeDdDPXqwZMjAyDVJt0envNS8LExuGDHOHpYRJHxc3Tp8pYJlz",
+	"EIPR2e3kmj7AViWvS1WoyrhOAu2c0MAx5xfN7xB8F65LAJovFB/SfAHmBBljCTPGSYzT6LN/abEf5w3h",
+	"7rd5mk5wfN5/B2SSAkcJuQA+00KoJsXLWsjE8asyq/x73XLvcHqver7evtNQiw+/N7sVsFguMskkiVHW",
+	"r/gY5CXrC/129JalCzZjnF30TxaEU3aBpE4h+k3n3YDUjGcRaZVqHfrxxtCfWLj1Gk4klkTIplCCcD/f",
+	"EfSvNoe+gLjRA3iqD3WFP0W/u4cyDlNyZT1Tlg+NeDRmydkGztlMkIb3siy3J1kh8YSkKiGI0ASu1tKs",
+	"BomDyCCWSLLu5Dve2RSFH06ON7cG1kiZumXQwQyoqWzrkdKyp11VK9RroVFS1loyIyPTA7r6nY+z4iQh",
+	"aoE4/VCRuCsbXEXGzxygr9CscggtP5sThYsY2Swol51l89iq2VJV8q8GfX2Y+gtJooNoNNlPdidjzaVu",
+	"kXYVgfWVDoVVuP+dY02AxptnnLO59LlOmksLNa3sAvNRWQD+LEimOp1P8tx5GVymAc3TVOtAexB0Q+0o",
+	"+JrjVNQfbnbvtJ/i6sFGG6vi4CBo9n/UH627Qro5ce1rOp+jHZzGHV/Fi5anVbx4gF6+CPsYNrDcO+l7",
+	"B2jHOZ2LT7vAGoF/1YHnnc/SA1DgtsTXxZl2lebDQaRDG8TkkHEQ2i7DaIHjOaGAUsCcKt+xAH5BlGGb",
+	"p+mUpCnR/mTNfyJAnzyeqwzB9bE4814ikBs+QIf2k/lNex4hk4oaKVwG92mKUwGhEN32Y5g2laUp06wI",
+	"Vtl8M5P2pJU6WagMCCLTpT8KmNU7/2SV9sLhxLqQDfif8wWmfQ44wZMUUOnn5kBh9Fpli5ApAYHIAs+M",
+	"0sKULHAqBiE83Dz4WaTvrDv0W7HdKV4ZWI+h+H4C0qXArqyga9RRz/6DWBN9bNypbcfkugemUqf1PBe2",
+	"hJp+tZGssMT4EM4CP614rSqZEDGmaAI+y91KwP978tt7tAA+06ZmPPenQ6UODcyFcjJ6kmuHF8iHEDCr",
+	"AuWu2f8uuX2FAzbjxOsasZgdDJFMkTQWANiaSpJZLATO8Tqmd5ysuf1CEqBSiStedYq2X4nxV102CCbq",
+	"7St52HpVUtHxe7OjPSSYIWCRTxZE6H11vMBBcgKJu3OzsiNVYfnaYOHI20/mfBRECw0ixNxG0dCUAl9E",
+	"WLCLFH02RQmZTqF8K0vUcVMJNmq2tIEHnSBlXdx0FqLm8PpKa9KLtImUIRmjf0IzcgHUHfOsqGFuaZWV",
+	"uVfZ96s0w8FHfPkrCGETmzdVQkVqaTDMrsDzOJ4sSxA60jHAm9NxO3gm07Mp+0tIvMjUrmnUl6hfhVcc",
+	"GAN0ZGSVcCiziWNYFuD1JcfxubPtgLtrHaJyOauzcVTNoF0F3h14LMYMo8xZnqo4f4HAAM56SHtF0tQc",
+	"ltxRyljUS5AD9ImmIIT7LU/Tnvq8yIVECyWo9Iz2xVY4r/rN125LXftqevWkUVl9SbEWwjAgKdffBDmk",
+	"1YsgSonpRHCR4RiQyilRW+e0mHERE26XFzDJt28O39wMbDfrGg2uKGaUBlPQ7tHgWmtN/Va1xlb23aUy",
+	"H3xrS2R2/iIDrULNx59fo5evRi8D28uSUFKrdqml0HMHusJaUA84bNuc7FLEBafqpZB8sXJABUtyinM5",
+	"V7omdvGTKeMTkiRA1T+UyS9TllP9S8zoNCWxfjDjEDNq3Ehf1J1F83CGlynDyRfJ2JcU8xmoLwm9wCnR",
+	"v3Ms4UtKFsS+7GvOJP4CVzFAYr7JKb7AJFXrMW5JRXSc2stxBRV4yMKnOolJGt6OqyzF1PomM4jJlMRG",
+	"ohKBWGz8bnEdjdV3G1XwVzTlOE/8oeSvCCUMjCDThkYIMiGxzBtyAH45Pf2AzIDKVpZvgJbSL/ZC5qWl",
+	"2ADRzBlXZsxigblPW3fxucaVvmcS/dyEZRmM+x2iTx+PS/5Qk7Xn7BnR7b14wnJ5MEkxPW/lYmkjXHrl",
+	"HsOeCHqGjz6vud/mrdiSv0SuWAIm0JdhLkmcp5jXssZv511pNpIVZTSYyUSss5OrGN2fjuIXeH+nv5uM",
+	"ob83fTnp/xjv4P4r2EvGk5f4Rfzj6MFN2ArEzvvbfzl5hft78Xjaxy8m4/7udD/egVfJj3jv5aZG6WPS",
+	"mNs1kIvlrL25tGU7+iZJ7I3ulXvw4HSw+6vU2cX49++93XWwp3aG2BID3d0ho2lvAlfoWrF/B+n4TTlA",
+	"BaGHMoCaaHvzJPciR2jd0ccSXskFudZSbj4bNfnYDt3xraZ84SqDWFcakDyPZc5hfSpdswp+3AenNWpA",
+	"u1cN3ho9gT8Ii49aWDV8Q7iZwJ+wW36dUO+IwkD8rrFewf0JiTU8vcKyNSZfy6QnrvLBKpOGL1kd2vtU",
+	"lXhi6bZVES2wzllb36SnfKX6vMFRcceqZ3Wsv0mJ67WjPKk9XrbdquXTznwmd3GDS2tPMBy2iU4sjJba",
+	"tdw7V5brOUtWUqVqjPULEZLx5Rsq+bKZyeZmFAI1DHGIGVcRNRt7C+IBh7DQyDstFqTN+6d1ensgRss4",
+	"XBCWi99vRxuQKEkzgSnj0LREDdX+BpdPi1ev8mcz31xsYyWmqNq6hXSke3fXcZXC63RdId8AjTfu0KFf",
+	"T0WBbNN/0i14XLoJHAgf+1DZLTJUMJdkimP5iTekRirnmN1kN9a6yNTMC2v+EtGoEsMHff2zGMYu74MP",
+	"9RWIcYuD5NCB+/HYeHUWC9J4DlO/oZNfDj3Nm6Ju2lUZ8Om0L+DVdLwHe/vTGACPX7zE+3j3RQJJsjcB",
+	"vLO/t3s/ZQwSLLGABreg92Ka87Idixi1V8IaV6oP3pJjQiFp3jY7nxjujHaUzgzCd9uMgeBFko9ggXMX",
+	"QH9VHOEWGMwvmC8z4NXqm6tkUhtUqVi5Ma6+RZCxeC5U6l4vcqLB3HUbDUajcbtNfn+JTfdsET7a45jo",
+	"mkwTPMY2Vr15jGlZvl6AO1BtnKf1u9e6Teq0Y65WxWJ4NNlat1W4D6FgH5u+fCCFdad66OF0y5YDIKK5",
+	"4qMrphgoIlUGMFBCsafjPMrWR9b8d6hDRSnJIkvN5tBUg8+DoFyriZ3mnL/GIoaHvoShjwvo65K2slZx",
+	"9cNc+6wkvOgrChZmxssFv+/az2NAbL6kqsG16/AiMIFU3bVTIut4imCRyWXPDVKrS1P7bHVw1Ot2D7q4",
+	"Oxu4Bn2XiTh2A2s5OOQ25sJmpZy27zjKedqkDd4pZjJCwu6d2q6MiZUCQnMpM3EwHMZkYL8cxGwxVLgS",
+	"w2psrEVNfPr4bhMzQkHvabTFYPjDVwdt4tkjQ4lBD5Ol0mX5UrbCDy4TRU3RS6lIv7VuhRuHFjjR6se+",
+	"S9OHflE7YdwB43PeVO9Q/xSqcWVX0rNy1WQ3VUklp6XwVJGwsx8+wenVbyQObsH+cCUPzQLW1Bu0q1aD",
+	"3XKNqsqA6iRERyhbLEBoc8PCUHnxGSCVbxodezv+iBXF85zTOh8dRDguv3n1Zrz9pqgPcV3nolb7wOVi",
+	"nWySx+Wr2NtsAqdBCW2ivVoxmVGDT7IRBPt2XxfPbGaRDGi3WddZMJ0NXJEEm8tXc1m4MfeRpNnzFZDX",
+	"KJyQDht3c3heeglq+LKgzFLymJd6VY7qJpyRF8ErUvpaR3ynrGHbbEKiMZemjKPXKjX2B+HSf1xqxuGH",
+	"Y7X0lMRgS/O6A0qG4zmgHUvcFq4V5VVwgmaEPk6zOR7bqo8UZyQ6iHZtCRWVQ6TJbFhmN/2N0qKrKzE1",
+	"pUXd/Bv4Ono2aaySVivMU1G5UvVyC3WpLV5MinGtUnJV0TVfo6drspjX+w70nJ+DVFCrvVwrvbwzGndY",
+	"fLdCy9Uy/6vVln2F5+tetDcaN03n4RtW60Lrp3Zv8tTOzg2e2h+NNn5K7brJfPXkWadOtZV4pmqDR1Uy",
+	"/6yerpL+8Fv532vrfFdQzULH8nf60pE/8OmqLq28oe9kCfXoz4zX8s/L5+Q/w6gohtS2//MKoY02IrRO",
+	"RxvfGqN6rHlEpDfaewjSU9tZPRJb0imIz36h0pVbpKu7CtmZmMyTd0BO25TXtRuZzvIt/DIS8EJZcMT1",
+	"W3AS3oe+1ov5v/GVz15HHdf0vseo3KyoedZqQa2GXIRiRbx0VGrDb/rvdaNy+xfIm8iif8GW9VqvdbxT",
+	"Sq0Dy53Sbq0vb0e7vVBTxtCUdthQj9Ez7hoVF+zkVG1dt2CJkpuup1c5buMvDZfb15lTa6XDmusF9zfR",
+	"4/8C2ZHN9PEpFL97bdthEpqQC5LkOA2UXgjwk44AuPZ4qzE9hIsWJ4Say4W7r17YhoT2Dd7DzWyZIPU5",
+	"halUTxMTD6wPXY0HhthaBysfMWOXmbqL1aIx29eY/Y/uFkxln1sqsRQavNwJMDpQ+O7VjZ0NOv2U6nx0",
+	"0shPRKrt3YB1n4JI2hvvPB1b44ORNLS7DAyl5xv/2U2Mh0/6ye9IzNzqcPSoTjvXNzoOPBnhc49i5CkJ",
+	"BMOQd3b4GOoORn2eW9Zo8bIV/Y7KxtSqMPGdw5ybzRXNuhc5ci+OuEoz8mdn3KozrkQrJULV33ZywlU7",
+	"zDHuqc20ELdNB33HIpQBJyxRVaDTpemkLmS9BV3pundbE7oBOix1ZakWNi33FrBnNlu13sZ4bW5ROSBc",
+	"tHf6qejb5qedgLwEoKszY99CwBU2r7044xCDfmnR1snXojJ9n9bxp/dc3j+HblN9uz5x5QaOrjt2pRdb",
+	"0Xqt6IhoM81q176K1mS6kY1rr6WaYa13g27UvE8yT8LVAu54Bme21rWu8aJZwDxjFit07pQ9Z/ZKdZM1",
+	"0eiR2q4QmtJy0ZB7eKMOe92cndXueNvrgtchM7Bbm2WH+cHNe9NVe3mW2ncOok4Ff2/VtW2Vdnb25mcd",
+	"3csb92J7aH90SeM+dQ374J7sgjVDuvkWRuTwG3bbdG2oOoVQ/6Qj/X1dzXezKc2z962z2geWTcINj7IV",
+	"lgl4l98z9Nry0HfsdLk1gRvKaCfw3rqISwNFIslm5vqFd92X2kYSKdZ082yiZBOredpkfE8RnW7S/zmq",
+	"82ijOlvXOnGluWiQoU0zOhDhfqI8p/o+NKFIzLEyrtTMjW1Gy+1KKyWBbeOa0qWroubvmh6kVaFQ9M17",
+	"GKGw3pYOtZUkauDX3CRjulSRotlg1TAsH5dWjNMObw90/mx4f9HhcCMIWhClEz1ObKOVu5V0BSU8G7o3",
+	"NXTtmazqVS/7S13jyo2lzqLohdNsQ3xd2xen1pqy5qPCnT1UqzEc87pHaVXoOhf/1tz6uNjNt7J95rUb",
+	"63ZH58ZN6/wKt2c2XjRtb4lM2JH1BgS3ZCir5B8iiPHIOapTjMSg7zlAEg6QlEl2lWnsr53Slc3YW9F+",
+	"0Y/DXp3nAiXMdGED04JOtRDwc+vi+o0M8zBRhW2zzHECi4xJoPHyLSxDUYswBbghBBwLGHxEd+kddby2",
+	"nrd2Rjtbe2PRryHw0tNqnVwdwtBhOhXGuiRpqqto2OQHLJY0nnNGWS7SpTpb2Vt9KoYHOFGUvDMaFxV4",
+	"Xf3c3HVfwRQRlfSn3o6+5pDDEzla741+fNSqXj21ux2vc1lMrZN3NzQShkJyndPXYCuc6J/FtqxvhPVU",
+	"y3LphV7AR2jvMnoTqfrKnprGUHNfGL+hwuwA/eFJ3ZWkKFeoo0y/xE+ysibs2xNp+AxqSucPikKOSSu9",
+	"DaaevsEjpL75fXxkRfdac0fClRxq7PcLQmr2GFz3AjUm1WMK/6ENfeOrW6ipXH/0MxRjzpcIl7tk6Z7l",
+	"+AK0U/n4yFKa7lu+Oo+lKzdREYVcoW5T17BcBsjWPNFgA02EHYJVUYcUevZfU+HBdD4QSHcnoRCXWoIV",
+	"qW4pFtKVPlC8YQuZ+F6+ak/6egX94yPrA9UsMOHsUlibQ8+rB51ov+t37CRVT41vJ10Ns3Y2JjcVrnqm",
+	"LgewuqRcnyCmWfn7TQ7Ty3s+94TPPUbBWsIqaNR+cVMSHX7Tf1uvU4UqRrWQ6eP1pz2qCJ2F6Dk69xSj",
+	"cyWm3D5PDm3h7Q5qJLaXtyRbz6s9xNIEhERTwoVs4lxbV/nRcu/9KaNKhelnxdSmmFyp+DvghYyzBTMp",
+	"UWG/3gd7xFxH/pXeDhvWLgwxygcD1ONmlG0mDNcKVo3Xp/Q21pWvR6pLyaV2n9uzMd3knx/4gk+7/n4O",
+	"g625uWe2u1wvmHluvSu9ylmaTnB8fkthoqao1UNVosN5nCRz3R3qWW5Fg4SQUPlooftu1e/fgZtGPz4E",
+	"NynSsWS5bRby2SDtxqgbWrl+tELstuLvI40SP0Tc12Lk2c5cZ2cGspL8V12CvnZwN9r8Tq55VUrUd+3S",
+	"4krJd2mK4gvAt/USqVNxW8uPQBX29gYc7aW5/CJF94vrz3X+n+v8P6I6/93uEq5beVNblNNKbMqM0hch",
+	"TVZ2O8E0Fbt19+fMex/6XpzXts+V2lymQUXDhhXsTc3G4Tf7qTXSsJGCfpQxhsKOezxRhi7U/hxneORx",
+	"hrWcedPCbiF+e6w13Rzbu6puT5nx77/yW7u5HSjpsEGFt0p/sHv2wt6xfHuu8vZ4qrx1FIahCm+fbIU3",
+	"m+6FFek3S8FG8ePLvf2d5M/jcTZsKNQa3QHPx/3n4/7TOO4/dBHDO9euz3ryDoof3s95fqhrZrXXEtBS",
+	"gihOnOT6TY59isOI+m+16a77pZSpX+hrQhFGcc45UF/yzZ4jmbDR2kJY2QGsuBCP3ucL4CR2UOhrCQbk",
+	"BOVC1TlQ785YltsqBELiCdHXlAlN4MrcInibm5ht/x2QSQocJeQC+Ey/1FWgk5esL7TuRG9ZumAzxtlF",
+	"/2RBOGUXSIKQP+kWjuaSwk3h8a9bBxKbuvIKWMKMcQLq7oRGjpV2C6Zrc2GKxqPRaOSRH4DHDPBTupGx",
+	"2gCKsEQc04QtVs2pI0U4xoNTdB1+hKbUehdrQV2epo6MWSSccvTEKgWk06YiDH6iaOOqD4TGaS7IhU5n",
+	"4nIVNEP4A/SJTlhOE5PmZA/mrfCcqDkrQHVrWh2CFK4cpECTLcP5hiZbgrIBn1U5sxmU4q6ReBvgYGuY",
+	"K9pvTgg19RMVFyRExBwk+S89oiRwmyBST0fVGIU9aqi434JQssgXDR32HlOZBC3jPkLGuHzOEbtNGSRb",
+	"xJMbVG6jSkLAlNl+lRKMMnU6jPMU88CFyZZiJI9aLT7XIvmeGMzRpmOBO+Kwm5Um2ZiLqhVIntnouQDJ",
+	"1guQ3KroSHsJkKdMs88VQJ4rgDzX8mjOsDH7u5WL5s0qdjjJ0/P2ntgLTJc1XesrH0jEaAzBbACtEUpV",
+	"1XvWP04EyjiLQQhNnvpSPQe80IWSMJ8QyTEn6RKlmM8ATVQQCgSKMVV0LYDKAXpDtAdM+8I4orZFfNkm",
+	"KBUPaZGk/8zT8+9emm5X+5fEU8gAuL8MPbV3djP/rjdC9sZPL4+wLON0vxUtiO5Y2N2silFJ1t1lWaLW",
+	"ikFP+4DyXDDouWDQ36xg0F2Ls2/mQ2uydKU23M18JE/f0+islbt0MXY7Jf5dehLc6gRjUdrBBWhHdmrE",
+	"ahqKOo/fFvuw3k+xETP82eMW9LiVd94RRflOs/2mi/vNjN2EorxHbts0tc2kS501E7Cfig4PPulSrTOX",
+	"1eFF8HRdSqR/yarHSvsEDMJCGXlaA/0gbHJPh/S4brfbDKDhLnl+iTcG1kzRDm34lptBlgfkoW+7OQHz",
+	"fNnNN3MLSJWgUOmq0IbfzIdWo21zGWRstHttLu410iOqlNdOw89X2B6t0bg1druEyZyx8y4GpBuqz0Ft",
+	"vPaHHfxETUgL/rMNGbYhPdUUNOe/6mI42sHmqF0jJV12HC60S8dLC2tNlAeWy8wx7pyJPTWfceboeuXC",
+	"Fo+S+pLJOzWRmnvpQRD5RME4KYapDS86Zqsgyl9Uia4ML1OGEzWvIDMKSSGgBMQcfGajnfon/c/Z/+vr",
+	"Y3r/lOP4nNBZ/4TMKJY5h/7O/oszK7yQ6nNr+GwOV32gMUsgQb/8evi6f/LL4c7+Cze7AyPjMCVXDooz",
+	"Mcc7+y/+cTb4i/6MSQoJSiBVScoEhL0FKjlxw+HK7DrBpsQRm06befhJGO3GZVlKyB9Y4lDWorPbSdSL",
+	"zF7p21Qx35VRL8p5Gh1EcykzcTAcxmRg3zWI2WKoMWCcTX1pt3B9oT4HSUj9KdoSlgipyWm221TupK3o",
+	"GRaZXPbcOCVy09Q+rnbTPmWzULuINO2cPFXCbEWodT0iWMIOF8CwaA3NYX7z+auKexwZi+BkekdCM/1y",
+	"evpBYUf9PdEMLZm7TMQ0u5fCBOvuhH36+K7psKFe7tfz0EcNr4ies/FulyxgaTessTqbScNv9lO3xtJe",
+	"y6yaTLV4mIqaFAK7WRb7vtP3en4pzKHnntIP2VN6PRWv7Si9hhSbqe0Bjsobkdo9nZW7COHnw/IjjrBs",
+	"W/gPC1Hd4eBcrlZbPFi1Aj2MPRXsXlNUvqQI7EQPyaGrNLvAV+o2UenuUmnJkqHUVgAP3VNKyYLIpotK",
+	"6qaSmVv/13Zx6T7dBHYvvsOi9g9ktZWdDCX6aeBf9ahOXjHkbo5z3zLOJItZen0wHH6bMyEVkV0PcUaG",
+	"F2OcZnOsamVcYE5U6QO91W5UhfKiX347OX1/+OublWoZJ5BO+3PjYqgeEF1qsJtQS1sHUHV2dey8wcxu",
+	"soGisc8eL3WOfEMT2y1OMrTAVBfdLsu60jXoj29OTtHhh+NBwZCVoQGGD02v4Q2Fmde/yjzW8R3Nsez1",
+	"L7HPdXyLL71cKlO+dnr3QMf5g7lzXd/FXS5np1e1dcdqQZse3vYqUL4YddhSs7h7faFyAx3e6K5JdV8d",
+	"ToFLxPN0g3XpZzZ5yRoX+Po3uQej68/X/z0AmUeM3pkPAQA=",
 }
 
 // GetSwagger returns the content of the embedded swagger specification file
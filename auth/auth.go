@@ -0,0 +1,151 @@
+// Package auth identifies clients by their TLS client certificates
+// and authorizes their access to the resources of organizations.
+package auth
+
+import (
+	"context"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"net/http"
+	"os"
+
+	"gopkg.in/yaml.v3"
+)
+
+// Role determines the requests that an identity may make.
+type Role string
+
+const (
+	// Reader may read the resources of its organization.
+	Reader Role = "reader"
+	// Writer may read and write the resources of its organization,
+	// e.g. to ingest results.
+	Writer Role = "writer"
+	// Admin may read and write the resources of all organizations
+	// and create new organizations.
+	Admin Role = "admin"
+)
+
+// Valid returns an error if the role is unknown.
+func (r Role) Valid() error {
+	switch r {
+	case Reader, Writer, Admin:
+		return nil
+	default:
+		return fmt.Errorf("unknown role %q", r)
+	}
+}
+
+// Identity is an authenticated client.
+type Identity struct {
+	// Name identifies the client, e.g. the subject of its certificate.
+	Name string
+	// Organization is the organization whose resources the client may access.
+	// It is empty for admins.
+	Organization string
+	Role         Role
+}
+
+// Allowed returns true if the identity may make a request
+// with the given method to the resources of the organization.
+// An empty organization denotes resources outside of any organization.
+func (i *Identity) Allowed(organization, method string) bool {
+	if i.Role == Admin {
+		return true
+	}
+	if organization == "" || organization != i.Organization {
+		return false
+	}
+	switch method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions:
+		return true
+	default:
+		return i.Role == Writer
+	}
+}
+
+type contextKey struct{}
+
+// NewContext returns a new context carrying the identity.
+func NewContext(ctx context.Context, i *Identity) context.Context {
+	return context.WithValue(ctx, contextKey{}, i)
+}
+
+// FromContext returns the identity in the context, if any.
+func FromContext(ctx context.Context) (*Identity, bool) {
+	i, ok := ctx.Value(contextKey{}).(*Identity)
+	return i, ok
+}
+
+// Certificate maps the subject of client certificates to an organization and role.
+type Certificate struct {
+	// Subject matches the distinguished name of the subject in RFC 2253 form,
+	// e.g. "CN=fraud-detector,O=acme".
+	Subject string `yaml:"subject,omitempty"`
+	// CommonName matches the common name of the subject.
+	CommonName   string `yaml:"commonName,omitempty"`
+	Organization string `yaml:"organization,omitempty"`
+	Role         Role   `yaml:"role"`
+}
+
+// Certificates identifies clients by the subjects of their certificates.
+type Certificates struct {
+	Certificates []Certificate `yaml:"certificates"`
+}
+
+// LoadCertificates reads the mapping of certificate subjects from a YAML file, e.g.:
+//
+//	certificates:
+//	- subject: CN=fraud-detector,O=acme
+//	  organization: acme
+//	  role: writer
+//	- commonName: platform
+//	  role: admin
+func LoadCertificates(path string) (*Certificates, error) {
+	buf, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	var c Certificates
+	if err := yaml.Unmarshal(buf, &c); err != nil {
+		return nil, fmt.Errorf("failed to parse %s: %w", path, err)
+	}
+	if err := c.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid certificate mapping in %s: %w", path, err)
+	}
+	return &c, nil
+}
+
+// Validate returns an error if any of the mappings is invalid.
+func (c *Certificates) Validate() error {
+	for i, m := range c.Certificates {
+		if (m.Subject == "") == (m.CommonName == "") {
+			return fmt.Errorf("certificate %d: exactly one of subject and commonName must be set", i)
+		}
+		if err := m.Role.Valid(); err != nil {
+			return fmt.Errorf("certificate %d: %w", i, err)
+		}
+		if m.Role == Admin && m.Organization != "" {
+			return fmt.Errorf("certificate %d: admins cannot be limited to an organization", i)
+		}
+		if m.Role != Admin && m.Organization == "" {
+			return fmt.Errorf("certificate %d: an organization is required", i)
+		}
+	}
+	return nil
+}
+
+// ErrUnknownCertificate is returned when the subject of a certificate is not mapped to an identity.
+var ErrUnknownCertificate = errors.New("the subject of the client certificate is not mapped to an identity")
+
+// Identify returns the identity of the first mapping matching the subject of the certificate.
+func (c *Certificates) Identify(cert *x509.Certificate) (*Identity, error) {
+	subject := cert.Subject.String()
+	for _, m := range c.Certificates {
+		if (m.Subject != "" && m.Subject == subject) || (m.CommonName != "" && m.CommonName == cert.Subject.CommonName) {
+			return &Identity{Name: subject, Organization: m.Organization, Role: m.Role}, nil
+		}
+	}
+	return nil, ErrUnknownCertificate
+}
@@ -0,0 +1,69 @@
+package auth
+
+import (
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"net/http"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/efficientgo/core/testutil"
+)
+
+func TestLoadCertificates(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "certificates.yaml")
+	testutil.Ok(t, os.WriteFile(path, []byte(`
+certificates:
+- subject: CN=fraud-detector,O=acme
+  organization: acme
+  role: writer
+- commonName: dashboard
+  organization: acme
+  role: reader
+- commonName: platform
+  role: admin
+`), 0o600))
+	c, err := LoadCertificates(path)
+	testutil.Ok(t, err)
+
+	i, err := c.Identify(&x509.Certificate{Subject: pkix.Name{CommonName: "fraud-detector", Organization: []string{"acme"}}})
+	testutil.Ok(t, err)
+	testutil.Equals(t, &Identity{Name: "CN=fraud-detector,O=acme", Organization: "acme", Role: Writer}, i)
+
+	i, err = c.Identify(&x509.Certificate{Subject: pkix.Name{CommonName: "dashboard", Organization: []string{"other"}}})
+	testutil.Ok(t, err)
+	testutil.Equals(t, Reader, i.Role)
+
+	_, err = c.Identify(&x509.Certificate{Subject: pkix.Name{CommonName: "fraud-detector"}})
+	testutil.Equals(t, ErrUnknownCertificate, err)
+
+	for _, invalid := range []string{
+		"certificates: [{organization: acme, role: writer}]",
+		"certificates: [{subject: CN=a, commonName: a, organization: acme, role: writer}]",
+		"certificates: [{commonName: a, organization: acme, role: owner}]",
+		"certificates: [{commonName: a, role: writer}]",
+		"certificates: [{commonName: a, organization: acme, role: admin}]",
+	} {
+		testutil.Ok(t, os.WriteFile(path, []byte(invalid), 0o600))
+		_, err := LoadCertificates(path)
+		testutil.NotOk(t, err, invalid)
+	}
+}
+
+func TestAllowed(t *testing.T) {
+	reader := &Identity{Organization: "acme", Role: Reader}
+	writer := &Identity{Organization: "acme", Role: Writer}
+	admin := &Identity{Role: Admin}
+
+	testutil.Assert(t, reader.Allowed("acme", http.MethodGet))
+	testutil.Assert(t, !reader.Allowed("acme", http.MethodPost))
+	testutil.Assert(t, writer.Allowed("acme", http.MethodPost))
+	testutil.Assert(t, writer.Allowed("acme", http.MethodDelete))
+	testutil.Assert(t, !writer.Allowed("other", http.MethodGet))
+	// Only admins may access resources outside of organizations, e.g. create organizations.
+	testutil.Assert(t, !writer.Allowed("", http.MethodPost))
+	testutil.Assert(t, admin.Allowed("", http.MethodPost))
+	testutil.Assert(t, admin.Allowed("other", http.MethodDelete))
+}
@@ -0,0 +1,143 @@
+// Package certs serves TLS certificates that are reloaded from disk when they change,
+// so that certificates can be rotated without restarting the server.
+package certs
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/go-kit/log"
+	"github.com/go-kit/log/level"
+)
+
+// Reloader holds a certificate and key pair and optionally a bundle
+// of client CA certificates, which it reloads when the files change.
+type Reloader struct {
+	certFile string
+	keyFile  string
+	caFile   string
+	logger   log.Logger
+
+	mu        sync.RWMutex
+	cert      *tls.Certificate
+	clientCAs *x509.CertPool
+	// contents holds the last loaded contents of the files
+	// to detect changes independently of modification times,
+	// which are unreliable for files mounted from Kubernetes secrets.
+	contents [][]byte
+}
+
+// NewReloader creates a new reloader and loads the files.
+// If caFile is empty, clients are not verified.
+func NewReloader(certFile, keyFile, caFile string, logger log.Logger) (*Reloader, error) {
+	if logger == nil {
+		logger = log.NewNopLogger()
+	}
+	r := &Reloader{
+		certFile: certFile,
+		keyFile:  keyFile,
+		caFile:   caFile,
+		logger:   logger,
+	}
+	if _, err := r.Reload(); err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+// Reload reloads the files if they changed and returns true if they did.
+// If the files are invalid, the previous certificates are kept.
+func (r *Reloader) Reload() (bool, error) {
+	files := []string{r.certFile, r.keyFile}
+	if r.caFile != "" {
+		files = append(files, r.caFile)
+	}
+	contents := make([][]byte, len(files))
+	for i, f := range files {
+		buf, err := os.ReadFile(f)
+		if err != nil {
+			return false, fmt.Errorf("failed to read %s: %w", f, err)
+		}
+		contents[i] = buf
+	}
+
+	r.mu.RLock()
+	changed := len(contents) != len(r.contents)
+	for i := 0; !changed && i < len(contents); i++ {
+		changed = !bytes.Equal(contents[i], r.contents[i])
+	}
+	r.mu.RUnlock()
+	if !changed {
+		return false, nil
+	}
+
+	cert, err := tls.X509KeyPair(contents[0], contents[1])
+	if err != nil {
+		return false, fmt.Errorf("failed to load the key pair from %s and %s: %w", r.certFile, r.keyFile, err)
+	}
+	var clientCAs *x509.CertPool
+	if r.caFile != "" {
+		clientCAs = x509.NewCertPool()
+		if !clientCAs.AppendCertsFromPEM(contents[2]) {
+			return false, fmt.Errorf("failed to load any CA certificates from %s", r.caFile)
+		}
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.cert = &cert
+	r.clientCAs = clientCAs
+	r.contents = contents
+	return true, nil
+}
+
+// Run reloads the files at the given interval until the context is canceled.
+func (r *Reloader) Run(ctx context.Context, interval time.Duration) error {
+	t := time.NewTicker(interval)
+	defer t.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-t.C:
+			changed, err := r.Reload()
+			if err != nil {
+				level.Error(r.logger).Log("msg", "failed to reload TLS certificates; keeping the previous certificates", "err", err.Error())
+				continue
+			}
+			if changed {
+				level.Info(r.logger).Log("msg", "reloaded TLS certificates")
+			}
+		}
+	}
+}
+
+// TLSConfig returns a TLS configuration for servers that always uses
+// the latest certificates. Clients are verified against the client CA certificates
+// as determined by clientAuth.
+func (r *Reloader) TLSConfig(clientAuth tls.ClientAuthType) (*tls.Config, error) {
+	if clientAuth > tls.NoClientCert && r.caFile == "" {
+		return nil, errors.New("client CA certificates are required to verify client certificates")
+	}
+	return &tls.Config{
+		MinVersion: tls.VersionTLS12,
+		GetConfigForClient: func(*tls.ClientHelloInfo) (*tls.Config, error) {
+			r.mu.RLock()
+			defer r.mu.RUnlock()
+			return &tls.Config{
+				MinVersion:   tls.VersionTLS12,
+				Certificates: []tls.Certificate{*r.cert},
+				ClientAuth:   clientAuth,
+				ClientCAs:    r.clientCAs,
+				NextProtos:   []string{"h2", "http/1.1"},
+			}, nil
+		},
+	}, nil
+}
@@ -0,0 +1,160 @@
+package certs
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/efficientgo/core/testutil"
+)
+
+type keyPair struct {
+	cert    *x509.Certificate
+	key     *ecdsa.PrivateKey
+	certPEM []byte
+	keyPEM  []byte
+}
+
+// newKeyPair creates a certificate for the subject signed by the parent.
+// If parent is nil, the certificate is a self-signed CA.
+func newKeyPair(t *testing.T, subject pkix.Name, serial int64, parent *keyPair) *keyPair {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	testutil.Ok(t, err)
+	tmpl := &x509.Certificate{
+		SerialNumber: big.NewInt(serial),
+		Subject:      subject,
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth, x509.ExtKeyUsageClientAuth},
+		IPAddresses:  []net.IP{net.ParseIP("127.0.0.1")},
+	}
+	signer, signerKey := tmpl, key
+	if parent == nil {
+		tmpl.IsCA = true
+		tmpl.BasicConstraintsValid = true
+		tmpl.KeyUsage = x509.KeyUsageCertSign | x509.KeyUsageDigitalSignature
+	} else {
+		signer, signerKey = parent.cert, parent.key
+	}
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, signer, &key.PublicKey, signerKey)
+	testutil.Ok(t, err)
+	cert, err := x509.ParseCertificate(der)
+	testutil.Ok(t, err)
+	keyDER, err := x509.MarshalECPrivateKey(key)
+	testutil.Ok(t, err)
+	return &keyPair{
+		cert:    cert,
+		key:     key,
+		certPEM: pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}),
+		keyPEM:  pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER}),
+	}
+}
+
+func TestReloader(t *testing.T) {
+	dir := t.TempDir()
+	certFile, keyFile, caFile := filepath.Join(dir, "tls.crt"), filepath.Join(dir, "tls.key"), filepath.Join(dir, "ca.crt")
+
+	ca := newKeyPair(t, pkix.Name{CommonName: "ca"}, 1, nil)
+	server := newKeyPair(t, pkix.Name{CommonName: "server"}, 2, ca)
+	client := newKeyPair(t, pkix.Name{CommonName: "client", Organization: []string{"acme"}}, 3, ca)
+	testutil.Ok(t, os.WriteFile(certFile, server.certPEM, 0o600))
+	testutil.Ok(t, os.WriteFile(keyFile, server.keyPEM, 0o600))
+	testutil.Ok(t, os.WriteFile(caFile, ca.certPEM, 0o600))
+
+	r, err := NewReloader(certFile, keyFile, caFile, nil)
+	testutil.Ok(t, err)
+	cfg, err := r.TLSConfig(tls.RequireAndVerifyClientCert)
+	testutil.Ok(t, err)
+
+	var subjects []string
+	s := httptest.NewUnstartedServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		subjects = append(subjects, r.TLS.VerifiedChains[0][0].Subject.String())
+	}))
+	s.TLS = cfg
+	s.StartTLS()
+	defer s.Close()
+
+	roots := x509.NewCertPool()
+	roots.AddCert(ca.cert)
+	clientCert, err := tls.X509KeyPair(client.certPEM, client.keyPEM)
+	testutil.Ok(t, err)
+	get := func(certs []tls.Certificate) (*x509.Certificate, error) {
+		c := &http.Client{Transport: &http.Transport{
+			TLSClientConfig:   &tls.Config{RootCAs: roots, Certificates: certs},
+			DisableKeepAlives: true,
+		}}
+		res, err := c.Get(s.URL)
+		if err != nil {
+			return nil, err
+		}
+		defer res.Body.Close()
+		return res.TLS.PeerCertificates[0], nil
+	}
+
+	peer, err := get([]tls.Certificate{clientCert})
+	testutil.Ok(t, err)
+	testutil.Equals(t, "server", peer.Subject.CommonName)
+	testutil.Equals(t, []string{"CN=client,O=acme"}, subjects)
+
+	// Clients without a certificate are rejected.
+	_, err = get(nil)
+	testutil.NotOk(t, err)
+
+	// Nothing changed, so nothing is reloaded.
+	changed, err := r.Reload()
+	testutil.Ok(t, err)
+	testutil.Assert(t, !changed)
+
+	// Rotate the server certificate.
+	rotated := newKeyPair(t, pkix.Name{CommonName: "rotated"}, 4, ca)
+	testutil.Ok(t, os.WriteFile(certFile, rotated.certPEM, 0o600))
+	testutil.Ok(t, os.WriteFile(keyFile, rotated.keyPEM, 0o600))
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan error)
+	go func() { done <- r.Run(ctx, 10*time.Millisecond) }()
+	for i := 0; i < 100; i++ {
+		if peer, err = get([]tls.Certificate{clientCert}); err == nil && peer.Subject.CommonName == "rotated" {
+			break
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	cancel()
+	testutil.Ok(t, <-done)
+	testutil.Equals(t, "rotated", peer.Subject.CommonName)
+
+	// Invalid files keep the previous certificate.
+	testutil.Ok(t, os.WriteFile(keyFile, server.keyPEM, 0o600))
+	_, err = r.Reload()
+	testutil.NotOk(t, err)
+	peer, err = get([]tls.Certificate{clientCert})
+	testutil.Ok(t, err)
+	testutil.Equals(t, "rotated", peer.Subject.CommonName)
+}
+
+func TestTLSConfigWithoutCA(t *testing.T) {
+	dir := t.TempDir()
+	certFile, keyFile := filepath.Join(dir, "tls.crt"), filepath.Join(dir, "tls.key")
+	ca := newKeyPair(t, pkix.Name{CommonName: "ca"}, 1, nil)
+	testutil.Ok(t, os.WriteFile(certFile, ca.certPEM, 0o600))
+	testutil.Ok(t, os.WriteFile(keyFile, ca.keyPEM, 0o600))
+
+	r, err := NewReloader(certFile, keyFile, "", nil)
+	testutil.Ok(t, err)
+	_, err = r.TLSConfig(tls.NoClientCert)
+	testutil.Ok(t, err)
+	_, err = r.TLSConfig(tls.RequireAndVerifyClientCert)
+	testutil.NotOk(t, err)
+}
@@ -1,6 +1,8 @@
 package main
 
 import (
+	"context"
+	"crypto/tls"
 	"database/sql"
 	"errors"
 	"flag"
@@ -8,6 +10,7 @@ import (
 	stdlog "log"
 	"net"
 	"net/http"
+	"net/url"
 	"os"
 	"os/signal"
 	"strings"
@@ -23,10 +26,27 @@ import (
 	"github.com/oklog/run"
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/prometheus/client_golang/prometheus/collectors"
+	"go.opentelemetry.io/otel"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
 
+	"github.com/connylabs/model-tracking/alerting"
+	ingestion "github.com/connylabs/model-tracking/api/ingestion/v1alpha1"
 	v1alpha1 "github.com/connylabs/model-tracking/api/v1alpha1"
+	"github.com/connylabs/model-tracking/auth"
+	"github.com/connylabs/model-tracking/certs"
+	"github.com/connylabs/model-tracking/collector"
+	"github.com/connylabs/model-tracking/config"
+	"github.com/connylabs/model-tracking/consumer"
+	migrations "github.com/connylabs/model-tracking/db"
+	"github.com/connylabs/model-tracking/health"
+	"github.com/connylabs/model-tracking/notify"
+	"github.com/connylabs/model-tracking/queue"
+	"github.com/connylabs/model-tracking/ratelimit"
 	"github.com/connylabs/model-tracking/store"
+	"github.com/connylabs/model-tracking/tracing"
 	"github.com/connylabs/model-tracking/version"
+	"github.com/connylabs/model-tracking/webhooks"
 )
 
 const (
@@ -39,6 +59,9 @@ const (
 
 	logFmtJson = "json"
 	logFmtFmt  = "fmt"
+
+	tlsClientAuthOptional = "optional"
+	tlsClientAuthRequire  = "require"
 )
 
 var (
@@ -55,20 +78,82 @@ var (
 		logFmtJson,
 		logFmtFmt,
 	}, ",")
+
+	availableTLSClientAuths = strings.Join([]string{
+		tlsClientAuthOptional,
+		tlsClientAuthRequire,
+	}, ", ")
+
+	availableTracingExporters = func() string {
+		es := make([]string, 0, len(tracing.Exporters))
+		for _, e := range tracing.Exporters {
+			es = append(es, string(e))
+		}
+		return strings.Join(es, ", ")
+	}()
 )
 
 // Main is the principal function for the binary, wrapped only by `main` for convenience.
 func Main() error {
+	configFile := flag.String("config", "", "The path to a YAML configuration file whose keys are the names of these flags. Every flag can also be set with an environment variable, e.g. MODEL_TRACKING_LOG_LEVEL for --log-level. Values can be read from files using environment variables with the suffix _FILE or keys with the suffix -file, e.g. MODEL_TRACKING_DATABASE_FILE. Flags take precedence over environment variables, which take precedence over the configuration file.")
 	postgresURL := flag.String("database", "", "Database connection string")
+	maxOpenConns := flag.Int("database-max-open-conns", 0, "The maximum number of open connections to the database. If 0, the number is unlimited.")
+	maxIdleConns := flag.Int("database-max-idle-conns", 2, "The maximum number of idle connections to the database. If 0, idle connections are not retained.")
+	connMaxLifetime := flag.Duration("database-conn-max-lifetime", 0, "The maximum time for which a connection to the database may be reused. If 0, connections are reused forever.")
+	connMaxIdleTime := flag.Duration("database-conn-max-idle-time", 0, "The maximum time for which a connection to the database may be idle. If 0, connections are not closed due to idleness.")
 	listen := flag.String("listen", ":8080", "The address at which to listen.")
+	listenGRPC := flag.String("listen-grpc", "", "The address at which to listen for the gRPC ingestion API. If empty, the gRPC API is disabled.")
+	grpcMaxMessageBytes := flag.Int("grpc-max-message-bytes", 4<<20, "The maximum size in bytes of a message received by the gRPC API, e.g. a batch of results.")
 	listenInternal := flag.String("listen-internal", ":9090", "The address at which to listen for health and metrics.")
 	healthCheckURL := flag.String("healthchecks-url", "http://localhost:8080", "The URL against which to run healthchecks.")
+	alertEvaluationInterval := flag.Duration("alert-evaluation-interval", time.Minute, "The interval at which to evaluate alert rules.")
+	alertWebhookURLs := flag.String("alert-webhook-urls", "", "A comma-separated list of URLs to which to post notifications when alerts start or stop firing.")
+	alertmanagerURLs := flag.String("alertmanager-urls", "", "A comma-separated list of Alertmanager URLs to which to send alerts, e.g. http://alertmanager:9093.")
+	kafkaRESTProxyURL := flag.String("kafka-rest-proxy-url", "", "The URL of a Kafka REST proxy through which to consume results from Kafka topics, e.g. http://kafka-rest:8082. If empty, results are not consumed from Kafka.")
+	kafkaTopics := flag.String("kafka-topics", "", "A comma-separated list of topics from which to consume results.")
+	kafkaGroup := flag.String("kafka-group", "model-tracking", "The consumer group in which to consume results from Kafka.")
+	kafkaConsumerName := flag.String("kafka-consumer-name", "", "The name of the consumer in the consumer group, which must be unique within the group. Defaults to the hostname.")
+	kafkaDeadLetterTopic := flag.String("kafka-dead-letter-topic", "", "The topic to which to write records that cannot be ingested. If empty, such records are logged and skipped.")
+	kafkaFetchTimeout := flag.Duration("kafka-fetch-timeout", 5*time.Second, "The maximum time to wait for new records from Kafka.")
+	webhookPollInterval := flag.Duration("webhook-poll-interval", 5*time.Second, "The interval at which to poll for events to deliver to webhooks.")
+	webhookMaxAttempts := flag.Int("webhook-max-attempts", 10, "The number of attempts after which to give up delivering an event to a webhook.")
+	tlsCertFile := flag.String("tls-cert-file", "", "The path to a PEM-encoded certificate with which to serve the API over TLS. The certificate is reloaded when the file changes.")
+	tlsKeyFile := flag.String("tls-key-file", "", "The path to the PEM-encoded private key of the TLS certificate.")
+	tlsClientCAFile := flag.String("tls-client-ca-file", "", "The path to a bundle of PEM-encoded CA certificates against which to verify client certificates. If empty, client certificates are not requested.")
+	tlsClientAuth := flag.String("tls-client-auth", tlsClientAuthRequire, fmt.Sprintf("Whether client certificates are required when a client CA is given. Possible values: %s", availableTLSClientAuths))
+	tlsClientCertificates := flag.String("tls-client-certificates", "", "The path to a YAML file mapping the subjects of client certificates to organizations and roles. If empty, all verified clients may make any request.")
+	tlsReloadInterval := flag.Duration("tls-reload-interval", time.Minute, "The interval at which to check the TLS certificates for changes.")
+	rateLimits := flag.String("rate-limits", "", "The path to a YAML file configuring rate limits and quotas per organization. If empty, requests are not limited.")
+	maxBodyBytes := flag.Int64("max-body-bytes", 1<<20, "The maximum size in bytes of the body of a request to an operation without a limit in --body-limits. Zero means unlimited.")
+	bodyLimits := flag.String("body-limits", "results-create-bulk-for-version=268435456", "A comma-separated list of limits for the size of request bodies per operation of the form operation-id=bytes. Zero means unlimited.")
+	streamDuration := flag.Duration("stream-duration", 25*time.Second, "The maximum duration of a stream of results, after which clients reconnect. It must be shorter than --write-timeout unless that is zero.")
+	streamBuffer := flag.Int("stream-buffer", 1024, "The number of events to buffer for each stream of results before it is closed because the client fell behind.")
+	ingestionQueueSize := flag.Int("ingestion-queue-size", 0, "The number of validated results to buffer in memory when creating results asynchronously. Single results are then acknowledged with 202 Accepted and created in batches. If 0, results are created synchronously.")
+	ingestionBatchSize := flag.Int("ingestion-batch-size", 500, "The maximum number of results that the ingestion queue creates at once.")
+	ingestionFlushInterval := flag.Duration("ingestion-flush-interval", time.Second, "The maximum time for which results wait in the ingestion queue before they are created.")
+	ingestionWALFile := flag.String("ingestion-wal-file", "", "The path to a file to which to write the results that the ingestion queue cannot create before shutting down. They are created when model-tracking starts again. If empty, such results are lost.")
+	idempotencyKeyLease := flag.Duration("idempotency-key-lease", time.Minute, "The time for which an Idempotency-Key header is reserved for a request in progress. Retries with the key are rejected until the request completes or the lease expires, e.g. because the server crashed. It should be longer than --write-timeout.")
+	idempotencyKeyTTL := flag.Duration("idempotency-key-ttl", 24*time.Hour, "The time for which the responses to requests with an Idempotency-Key header are kept to answer retries.")
+	statisticsRefreshInterval := flag.Duration("statistics-refresh-interval", time.Minute, "The interval at which to refresh the statistics of the results that are exposed as metrics.")
+	quotaSyncInterval := flag.Duration("quota-sync-interval", 30*time.Second, "The interval at which to synchronize the number of results counted against quotas with the database.")
+	tracingExporter := flag.String("tracing-exporter", string(tracing.None), fmt.Sprintf("The exporter to which to send traces. Possible values: %s", availableTracingExporters))
+	otlpEndpoint := flag.String("otlp-endpoint", "", "The host and port of the OTLP collector to which to export traces. Defaults to the value of OTEL_EXPORTER_OTLP_ENDPOINT or localhost:4318.")
+	otlpInsecure := flag.Bool("otlp-insecure", false, "Connect to the OTLP collector without TLS.")
+	tracingSampleRatio := flag.Float64("tracing-sample-ratio", 1, "The fraction of traces to sample for requests without a sampled parent span.")
+	shutdownDelay := flag.Duration("shutdown-delay", 5*time.Second, "The time to wait after receiving a termination signal and failing readiness checks before shutting down, so that load balancers can stop sending requests.")
+	shutdownTimeout := flag.Duration("shutdown-timeout", 30*time.Second, "The maximum time to wait for in-flight requests to complete when shutting down.")
+	readTimeout := flag.Duration("read-timeout", 30*time.Second, "The maximum duration for reading an entire request, including the body.")
+	readHeaderTimeout := flag.Duration("read-header-timeout", 10*time.Second, "The maximum duration for reading the headers of a request.")
+	writeTimeout := flag.Duration("write-timeout", 30*time.Second, "The maximum duration before timing out writes of a response.")
+	idleTimeout := flag.Duration("idle-timeout", 2*time.Minute, "The maximum time to wait for the next request on a keep-alive connection.")
 	logLevel := flag.String("log-level", logLevelInfo, fmt.Sprintf("Log level to use. Possible values: %s", availableLogLevels))
 	logFmt := flag.String("log-fmt", logFmtFmt, fmt.Sprintf("Log format to use. Possible values: %s", availableLogFmts))
 	help := flag.Bool("h", false, "Show usage")
 	printVersion := flag.Bool("version", false, "Show version")
 
-	flag.Parse()
+	if err := config.Parse(flag.CommandLine, os.Args[1:], "MODEL_TRACKING", "config"); err != nil {
+		return fmt.Errorf("failed to parse configuration: %w", err)
+	}
 
 	if *help {
 		flag.Usage()
@@ -113,44 +198,328 @@ func Main() error {
 	if *postgresURL == "" {
 		return errors.New("a value for --database must be specified")
 	}
+	if *writeTimeout > 0 && (*streamDuration <= 0 || *streamDuration >= *writeTimeout) {
+		return errors.New("the value of --stream-duration must be shorter than that of --write-timeout")
+	}
+	if *ingestionQueueSize < 0 || *ingestionBatchSize <= 0 || *ingestionFlushInterval <= 0 {
+		return errors.New("the values of --ingestion-queue-size, --ingestion-batch-size and --ingestion-flush-interval must be positive")
+	}
+	if *configFile != "" {
+		level.Debug(logger).Log("msg", "loaded configuration file", "path", *configFile)
+	}
 
 	db, err := sql.Open("pgx", *postgresURL)
 	if err != nil {
 		return fmt.Errorf("could not connect to database: %w", err)
 	}
+	db.SetMaxOpenConns(*maxOpenConns)
+	db.SetMaxIdleConns(*maxIdleConns)
+	db.SetConnMaxLifetime(*connMaxLifetime)
+	db.SetConnMaxIdleTime(*connMaxIdleTime)
+	defer func() {
+		// All servers and background jobs have stopped by now,
+		// so no more queries will be made.
+		if err := db.Close(); err != nil {
+			level.Error(logger).Log("msg", "failed to close the database connection pool", "err", err.Error())
+		}
+	}()
+
+	migrationVersion, err := migrations.Version()
+	if err != nil {
+		return fmt.Errorf("failed to determine the expected migration version: %w", err)
+	}
+
+	shutdownTracing, err := tracing.Setup(context.Background(), tracing.Config{
+		Exporter:    tracing.Exporter(*tracingExporter),
+		Endpoint:    *otlpEndpoint,
+		Insecure:    *otlpInsecure,
+		SampleRatio: *tracingSampleRatio,
+		Version:     version.Version,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to set up tracing: %w", err)
+	}
+	defer func() {
+		// Flush the remaining spans before exiting.
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		if err := shutdownTracing(ctx); err != nil {
+			level.Error(logger).Log("msg", "failed to shut down tracing", "err", err.Error())
+		}
+	}()
+	store.Trace(otel.GetTracerProvider())
 
+	statistics := collector.New(store.NewSQLStore(db).Statistics(), *statisticsRefreshInterval, 10*time.Second, log.With(logger, "component", "collector"))
 	reg := prometheus.NewRegistry()
 	reg.MustRegister(
 		collectors.NewGoCollector(),
 		collectors.NewProcessCollector(collectors.ProcessCollectorOpts{}),
+		statistics,
 	)
 
+	var shutdown health.Shutdown
+	timeouts := serverTimeouts{
+		read:       *readTimeout,
+		readHeader: *readHeaderTimeout,
+		write:      *writeTimeout,
+		idle:       *idleTimeout,
+	}
+
 	var g run.Group
+	hub := notify.NewHub(*streamBuffer, reg)
+	{
+		l, err := notify.NewListener(*postgresURL, hub, log.With(logger, "component", "event-listener"))
+		if err != nil {
+			return err
+		}
+		ctx, cancel := context.WithCancel(context.Background())
+		g.Add(func() error {
+			level.Info(logger).Log("msg", "starting the event listener")
+			return l.Run(ctx)
+		}, func(error) {
+			cancel()
+		})
+	}
+
+	// The interface stays nil unless the queue is enabled, so that results are created synchronously.
+	var enqueuer v1alpha1.Enqueuer
+	var q *queue.Queue
+	if *ingestionQueueSize > 0 {
+		q = queue.New(store.NewSQLStore(db), *ingestionQueueSize, *ingestionBatchSize, *ingestionFlushInterval, *ingestionWALFile, reg, log.With(logger, "component", "ingestion-queue"))
+		enqueuer = q
+	}
+
+	var tlsConfig *tls.Config
+	if *tlsCertFile != "" || *tlsKeyFile != "" {
+		if *tlsCertFile == "" || *tlsKeyFile == "" {
+			return errors.New("both --tls-cert-file and --tls-key-file must be specified to enable TLS")
+		}
+		clientAuth := tls.NoClientCert
+		if *tlsClientCAFile != "" {
+			switch *tlsClientAuth {
+			case tlsClientAuthOptional:
+				clientAuth = tls.VerifyClientCertIfGiven
+			case tlsClientAuthRequire:
+				clientAuth = tls.RequireAndVerifyClientCert
+			default:
+				return fmt.Errorf("TLS client auth %v unknown; possible values are: %s", *tlsClientAuth, availableTLSClientAuths)
+			}
+		}
+		reloader, err := certs.NewReloader(*tlsCertFile, *tlsKeyFile, *tlsClientCAFile, log.With(logger, "component", "tls"))
+		if err != nil {
+			return fmt.Errorf("failed to load TLS certificates: %w", err)
+		}
+		if tlsConfig, err = reloader.TLSConfig(clientAuth); err != nil {
+			return fmt.Errorf("failed to configure TLS: %w", err)
+		}
+		ctx, cancel := context.WithCancel(context.Background())
+		g.Add(func() error {
+			return reloader.Run(ctx, *tlsReloadInterval)
+		}, func(error) {
+			cancel()
+		})
+	} else if *tlsClientCAFile != "" || *tlsClientCertificates != "" {
+		return errors.New("client certificates can only be verified when TLS is enabled with --tls-cert-file and --tls-key-file")
+	}
+
+	// The rate limits and client certificates apply to the HTTP and gRPC APIs alike.
+	var limiter *ratelimit.Limiter
+	if *rateLimits != "" {
+		c, err := ratelimit.LoadConfig(*rateLimits)
+		if err != nil {
+			return fmt.Errorf("failed to load rate limits: %w", err)
+		}
+		limiter = ratelimit.NewLimiter(c, store.NewSQLStore(db).Statistics(), *quotaSyncInterval, reg)
+	}
+	var certificates *auth.Certificates
+	if *tlsClientCertificates != "" {
+		if *tlsClientCAFile == "" {
+			return errors.New("a value for --tls-client-ca-file must be specified to map client certificates")
+		}
+		if certificates, err = auth.LoadCertificates(*tlsClientCertificates); err != nil {
+			return fmt.Errorf("failed to load client certificate mapping: %w", err)
+		}
+	}
+
 	{
 		l, err := net.Listen("tcp", *listen)
 		if err != nil {
 			return fmt.Errorf("failed to listen on %s: %v", *listen, err)
 		}
 
+		// Every middleware wraps the previous ones,
+		// so the last middleware runs first.
+		var middlewares []v1alpha1.MiddlewareFunc
+		{
+			im, err := v1alpha1.NewIdempotencyMiddleware(store.NewSQLStore(db), []string{"results-create-for-version", "results-create-for-model", "results-create-bulk-for-version"}, *idempotencyKeyLease, *idempotencyKeyTTL, "/api/v1alpha1", log.With(logger, "component", "http-server"))
+			if err != nil {
+				return fmt.Errorf("failed to create idempotency middleware: %w", err)
+			}
+			middlewares = append(middlewares, im)
+
+			limits, err := v1alpha1.ParseBodyLimits(*bodyLimits)
+			if err != nil {
+				return fmt.Errorf("failed to parse --body-limits: %w", err)
+			}
+			bl, err := v1alpha1.NewBodyLimitMiddleware(*maxBodyBytes, limits, "/api/v1alpha1", log.With(logger, "component", "http-server"))
+			if err != nil {
+				return fmt.Errorf("invalid body limits: %w", err)
+			}
+			middlewares = append(middlewares, bl)
+		}
+		if limiter != nil {
+			middlewares = append(middlewares, v1alpha1.NewRateLimitMiddleware(limiter, log.With(logger, "component", "http-server")))
+		}
+		if certificates != nil {
+			middlewares = append(middlewares, v1alpha1.NewCertificateAuthMiddleware(certificates, log.With(logger, "component", "http-server")))
+		}
+
+		r := chi.NewRouter()
+		r.Use(tracing.Middleware())
+		v1alpha1.HandlerWithOptions(
+			v1alpha1.NewInstrumentedServerInterface(
+				v1alpha1.NewTracedServerInterface(
+					v1alpha1.NewServer(
+						store.NewSQLStore(db), hub, enqueuer, *streamDuration, reg, log.With(logger, "component", "http-server")),
+					otel.GetTracerProvider(),
+				),
+				reg,
+			), v1alpha1.ChiServerOptions{
+				BaseRouter:       r,
+				BaseURL:          "/api/v1alpha1",
+				Middlewares:      middlewares,
+				ErrorHandlerFunc: v1alpha1.NewErrorHandler(log.With(logger, "component", "http-server")),
+			},
+		)
+		srv := newServer(r, timeouts)
+		srv.TLSConfig = tlsConfig
+
 		g.Add(func() error {
 			level.Info(logger).Log("msg", "starting the model-tracking HTTP server", "addr", *listen, "version", version.Version)
-			r := chi.NewRouter()
-			v1alpha1.HandlerWithOptions(
-				v1alpha1.NewInstrumentedServerInterface(
-					v1alpha1.NewServer(
-						store.NewSQLStore(db), log.With(logger, "component", "http-server")),
-					reg,
-				), v1alpha1.ChiServerOptions{
-					BaseRouter: r,
-					BaseURL:    "/api/v1alpha1",
-				},
-			)
-			if err := http.Serve(l, r); err != nil && err != http.ErrServerClosed {
+			serve := srv.Serve
+			if srv.TLSConfig != nil {
+				serve = func(l net.Listener) error { return srv.ServeTLS(l, "", "") }
+			}
+			if err := serve(l); err != nil && err != http.ErrServerClosed {
 				return fmt.Errorf("error: server exited unexpectedly: %v", err)
 			}
 			return nil
 		}, func(error) {
-			l.Close()
+			shutdownServer(srv, *shutdownTimeout, log.With(logger, "component", "http-server"))
+		})
+	}
+
+	if *listenGRPC != "" {
+		l, err := net.Listen("tcp", *listenGRPC)
+		if err != nil {
+			return fmt.Errorf("failed to listen on %s: %v", *listenGRPC, err)
+		}
+
+		i := ingestion.NewInstrumentation(reg, otel.GetTracerProvider())
+		opts := []grpc.ServerOption{
+			grpc.ChainUnaryInterceptor(i.UnaryServerInterceptor()),
+			grpc.ChainStreamInterceptor(i.StreamServerInterceptor()),
+			grpc.MaxRecvMsgSize(*grpcMaxMessageBytes),
+		}
+		if tlsConfig != nil {
+			opts = append(opts, grpc.Creds(credentials.NewTLS(tlsConfig)))
+		}
+		srv := grpc.NewServer(opts...)
+		ingestion.RegisterIngestionServer(srv, ingestion.NewServer(store.NewSQLStore(db), certificates, limiter, log.With(logger, "component", "grpc-server")))
+
+		g.Add(func() error {
+			level.Info(logger).Log("msg", "starting the model-tracking gRPC server", "addr", *listenGRPC)
+			if err := srv.Serve(l); err != nil {
+				return fmt.Errorf("error: gRPC server exited unexpectedly: %v", err)
+			}
+			return nil
+		}, func(error) {
+			shutdownGRPCServer(srv, *shutdownTimeout, log.With(logger, "component", "grpc-server"))
+		})
+	}
+
+	if q != nil {
+		// The run group interrupts its actors in the order in which they were added,
+		// so the queue is added after the servers to stop it only once they finished draining
+		// their in-flight requests, which may still enqueue results.
+		ctx, cancel := context.WithCancel(context.Background())
+		g.Add(func() error {
+			level.Info(logger).Log("msg", "starting the ingestion queue", "size", *ingestionQueueSize, "batch-size", *ingestionBatchSize)
+			return q.Run(ctx)
+		}, func(error) {
+			cancel()
+		})
+	}
+
+	{
+		var receivers []alerting.Receiver
+		for _, u := range strings.Split(*alertWebhookURLs, ",") {
+			if u = strings.TrimSpace(u); u != "" {
+				receivers = append(receivers, alerting.NewWebhookReceiver(u, nil))
+			}
+		}
+		for _, u := range strings.Split(*alertmanagerURLs, ",") {
+			if u = strings.TrimSpace(u); u != "" {
+				// Give Alertmanager a few evaluations to receive a firing alert again before resolving it.
+				receivers = append(receivers, alerting.NewAlertmanagerReceiver(u, nil, 4**alertEvaluationInterval))
+			}
+		}
+		e := alerting.NewEvaluator(store.NewSQLStore(db), receivers, *alertEvaluationInterval, log.With(logger, "component", "alert-evaluator"))
+		ctx, cancel := context.WithCancel(context.Background())
+		g.Add(func() error {
+			level.Info(logger).Log("msg", "starting the alert evaluator", "interval", *alertEvaluationInterval, "receivers", len(receivers))
+			return e.Run(ctx)
+		}, func(error) {
+			cancel()
+		})
+	}
+
+	if *kafkaRESTProxyURL != "" {
+		var topics []string
+		for _, t := range strings.Split(*kafkaTopics, ",") {
+			if t = strings.TrimSpace(t); t != "" {
+				topics = append(topics, t)
+			}
+		}
+		if len(topics) == 0 {
+			return errors.New("--kafka-topics must be specified to consume results from Kafka")
+		}
+		name := *kafkaConsumerName
+		if name == "" {
+			var err error
+			if name, err = os.Hostname(); err != nil {
+				return fmt.Errorf("failed to determine the name of the Kafka consumer: %w", err)
+			}
+		}
+		b := consumer.NewRESTProxy(*kafkaRESTProxyURL, *kafkaGroup, name, topics, *kafkaFetchTimeout, nil)
+		c := consumer.New(b, store.NewSQLStore(db), *kafkaDeadLetterTopic, limiter, reg, log.With(logger, "component", "kafka-consumer"))
+		ctx, cancel := context.WithCancel(context.Background())
+		g.Add(func() error {
+			level.Info(logger).Log("msg", "starting the Kafka consumer", "topics", strings.Join(topics, ","), "group", *kafkaGroup, "name", name)
+			return c.Run(ctx)
+		}, func(error) {
+			cancel()
+		})
+	}
+
+	{
+		d := webhooks.NewDispatcher(store.NewSQLStore(db).Deliveries(), nil, *webhookPollInterval, *webhookMaxAttempts, reg, log.With(logger, "component", "webhook-dispatcher"))
+		ctx, cancel := context.WithCancel(context.Background())
+		g.Add(func() error {
+			level.Info(logger).Log("msg", "starting the webhook dispatcher", "interval", *webhookPollInterval)
+			return d.Run(ctx)
+		}, func(error) {
+			cancel()
+		})
+	}
+
+	{
+		ctx, cancel := context.WithCancel(context.Background())
+		g.Add(func() error {
+			level.Info(logger).Log("msg", "starting the statistics collector", "interval", *statisticsRefreshInterval)
+			return statistics.Run(ctx)
+		}, func(error) {
+			cancel()
 		})
 	}
 
@@ -158,15 +527,29 @@ func Main() error {
 		// Run the internal HTTP server.
 		healthchecks := healthcheck.NewMetricsHandler(healthcheck.NewHandler(), reg)
 		// Checks if the server is up.
-		healthchecks.AddLivenessCheck("http",
-			healthcheck.HTTPCheckClient(
-				http.DefaultClient,
-				*healthCheckURL,
-				http.MethodGet,
-				http.StatusNotFound,
-				time.Second,
-			),
-		)
+		if tlsConfig == nil {
+			healthchecks.AddLivenessCheck("http",
+				healthcheck.HTTPCheckClient(
+					http.DefaultClient,
+					*healthCheckURL,
+					http.MethodGet,
+					http.StatusNotFound,
+					time.Second,
+				),
+			)
+		} else {
+			// The server may require client certificates, so only check
+			// that it accepts connections.
+			u, err := url.Parse(*healthCheckURL)
+			if err != nil {
+				return fmt.Errorf("failed to parse --healthchecks-url: %w", err)
+			}
+			healthchecks.AddLivenessCheck("tcp", healthcheck.TCPDialCheck(u.Host, time.Second))
+		}
+		// Checks if the server can serve requests.
+		healthchecks.AddReadinessCheck("database", healthcheck.DatabasePingCheck(db, time.Second))
+		healthchecks.AddReadinessCheck("migrations", health.MigrationCheck(store.NewSQLStore(db).Migrations(), migrationVersion, time.Second))
+		healthchecks.AddReadinessCheck("shutdown", shutdown.Check)
 		h := internalserver.NewHandler(
 			internalserver.WithName("Internal - model-tracking"),
 			internalserver.WithHealthchecks(healthchecks),
@@ -178,15 +561,17 @@ func Main() error {
 			return fmt.Errorf("failed to listen on %s: %v", *listenInternal, err)
 		}
 
+		srv := newServer(h, timeouts)
+
 		g.Add(func() error {
 			level.Info(logger).Log("msg", "starting the model-tracking internal HTTP server", "addr", *listenInternal)
 
-			if err := http.Serve(l, h); err != nil && err != http.ErrServerClosed {
+			if err := srv.Serve(l); err != nil && err != http.ErrServerClosed {
 				return fmt.Errorf("error: internal server exited unexpectedly: %v", err)
 			}
 			return nil
 		}, func(error) {
-			l.Close()
+			shutdownServer(srv, *shutdownTimeout, log.With(logger, "component", "internal-server"))
 		})
 	}
 
@@ -200,6 +585,16 @@ func Main() error {
 				select {
 				case <-term:
 					level.Info(logger).Log("msg", "caught interrupt; gracefully cleaning up; see you next time!")
+					// Fail the readiness checks and give load balancers time to
+					// stop sending requests before the servers are stopped.
+					shutdown.Start()
+					level.Info(logger).Log("msg", "waiting for load balancers to drain traffic", "delay", *shutdownDelay)
+					select {
+					case <-time.After(*shutdownDelay):
+					case <-term:
+						level.Info(logger).Log("msg", "caught second interrupt; shutting down immediately")
+					case <-cancel:
+					}
 					return nil
 				case <-cancel:
 					return nil
@@ -213,6 +608,53 @@ func Main() error {
 	return g.Run()
 }
 
+// serverTimeouts are the timeouts of the HTTP servers.
+type serverTimeouts struct {
+	read       time.Duration
+	readHeader time.Duration
+	write      time.Duration
+	idle       time.Duration
+}
+
+func newServer(h http.Handler, t serverTimeouts) *http.Server {
+	return &http.Server{
+		Handler:           h,
+		ReadTimeout:       t.read,
+		ReadHeaderTimeout: t.readHeader,
+		WriteTimeout:      t.write,
+		IdleTimeout:       t.idle,
+	}
+}
+
+// shutdownServer stops the server from accepting new connections and waits
+// for in-flight requests to complete. Once the timeout expires, the remaining
+// connections are closed.
+func shutdownServer(srv *http.Server, timeout time.Duration, logger log.Logger) {
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+	if err := srv.Shutdown(ctx); err != nil {
+		level.Warn(logger).Log("msg", "failed to drain in-flight requests; closing remaining connections", "err", err.Error())
+		srv.Close()
+	}
+}
+
+// shutdownGRPCServer stops the server from accepting new connections and waits
+// for in-flight RPCs to complete. Once the timeout expires, the remaining
+// connections are closed.
+func shutdownGRPCServer(srv *grpc.Server, timeout time.Duration, logger log.Logger) {
+	stopped := make(chan struct{})
+	go func() {
+		srv.GracefulStop()
+		close(stopped)
+	}()
+	select {
+	case <-stopped:
+	case <-time.After(timeout):
+		level.Warn(logger).Log("msg", "failed to drain in-flight RPCs; closing remaining connections")
+		srv.Stop()
+	}
+}
+
 func main() {
 	if err := Main(); err != nil {
 		fmt.Fprintf(os.Stderr, "%v\n", err)
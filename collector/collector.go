@@ -0,0 +1,153 @@
+// Package collector exposes the quality of the results of all model versions as Prometheus metrics.
+package collector
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/go-kit/log"
+	"github.com/go-kit/log/level"
+	"github.com/prometheus/client_golang/prometheus"
+
+	"github.com/connylabs/model-tracking/store"
+)
+
+var labelNames = []string{"organization", "model", "version"}
+
+var (
+	resultsDesc = prometheus.NewDesc(
+		"model_tracking_results_total",
+		"The number of results of a model version.",
+		labelNames, nil,
+	)
+	labelledDesc = prometheus.NewDesc(
+		"model_tracking_labelled_results_total",
+		"The number of results of a model version with a known true output.",
+		labelNames, nil,
+	)
+	unlabelledDesc = prometheus.NewDesc(
+		"model_tracking_unlabelled_results_total",
+		"The number of results of a model version without a known true output.",
+		labelNames, nil,
+	)
+	correctDesc = prometheus.NewDesc(
+		"model_tracking_correct_results_total",
+		"The number of labelled results of a model version whose output equals the true output.",
+		labelNames, nil,
+	)
+	lastResultDesc = prometheus.NewDesc(
+		"model_tracking_last_result_timestamp_seconds",
+		"The time of the most recent result of a model version in seconds since the Unix epoch.",
+		labelNames, nil,
+	)
+	accuracyDesc = prometheus.NewDesc(
+		"model_tracking_accuracy",
+		"The fraction of labelled results of a model version that are correct.",
+		labelNames, nil,
+	)
+	errorRateDesc = prometheus.NewDesc(
+		"model_tracking_error_rate",
+		"The fraction of labelled results of a model version that are incorrect.",
+		labelNames, nil,
+	)
+	upDesc = prometheus.NewDesc(
+		"model_tracking_statistics_up",
+		"Whether the statistics of the results could be collected from the store.",
+		nil, nil,
+	)
+)
+
+// Collector exposes the statistics of the results of every version of every model in the store.
+// Aggregating all results is expensive, so the statistics are refreshed
+// in the background at an interval rather than whenever the collector is collected.
+type Collector struct {
+	store    store.Statistics
+	interval time.Duration
+	timeout  time.Duration
+	logger   log.Logger
+
+	mu sync.RWMutex
+	vs []*store.VersionStatistics
+	up bool
+}
+
+// New creates a Prometheus collector whose statistics are refreshed at the given interval
+// once it runs. Every refresh is canceled after the given timeout.
+func New(store store.Statistics, interval, timeout time.Duration, logger log.Logger) *Collector {
+	if logger == nil {
+		logger = log.NewNopLogger()
+	}
+	return &Collector{store: store, interval: interval, timeout: timeout, logger: logger}
+}
+
+// Run refreshes the statistics immediately and then at the interval until the context is canceled.
+func (c *Collector) Run(ctx context.Context) error {
+	t := time.NewTicker(c.interval)
+	defer t.Stop()
+	for {
+		if err := c.Refresh(ctx); err != nil {
+			level.Error(c.logger).Log("msg", "failed to refresh statistics", "err", err.Error())
+		}
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-t.C:
+		}
+	}
+}
+
+// Refresh aggregates the results in the store once.
+// If it fails, the previous statistics are still exposed, but the collector reports that it is down.
+func (c *Collector) Refresh(ctx context.Context) error {
+	ctx, cancel := context.WithTimeout(ctx, c.timeout)
+	defer cancel()
+
+	vs, err := c.store.List(ctx)
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if err != nil {
+		c.up = false
+		return err
+	}
+	c.vs, c.up = vs, true
+	return nil
+}
+
+func (c *Collector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- resultsDesc
+	ch <- labelledDesc
+	ch <- unlabelledDesc
+	ch <- correctDesc
+	ch <- lastResultDesc
+	ch <- accuracyDesc
+	ch <- errorRateDesc
+	ch <- upDesc
+}
+
+func (c *Collector) Collect(ch chan<- prometheus.Metric) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	up := 0.0
+	if c.up {
+		up = 1
+	}
+	ch <- prometheus.MustNewConstMetric(upDesc, prometheus.GaugeValue, up)
+
+	for _, v := range c.vs {
+		lvs := []string{v.Organization, v.Model, v.Version}
+		ch <- prometheus.MustNewConstMetric(resultsDesc, prometheus.CounterValue, float64(v.Results), lvs...)
+		ch <- prometheus.MustNewConstMetric(labelledDesc, prometheus.CounterValue, float64(v.Labelled), lvs...)
+		ch <- prometheus.MustNewConstMetric(unlabelledDesc, prometheus.CounterValue, float64(v.Results-v.Labelled), lvs...)
+		ch <- prometheus.MustNewConstMetric(correctDesc, prometheus.CounterValue, float64(v.Correct), lvs...)
+		if v.LastResult != nil {
+			ch <- prometheus.MustNewConstMetric(lastResultDesc, prometheus.GaugeValue, float64(v.LastResult.UnixNano())/1e9, lvs...)
+		}
+		if v.Labelled > 0 {
+			accuracy := float64(v.Correct) / float64(v.Labelled)
+			ch <- prometheus.MustNewConstMetric(accuracyDesc, prometheus.GaugeValue, accuracy, lvs...)
+			ch <- prometheus.MustNewConstMetric(errorRateDesc, prometheus.GaugeValue, 1-accuracy, lvs...)
+		}
+	}
+}
@@ -0,0 +1,86 @@
+package collector
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/efficientgo/core/testutil"
+	"github.com/prometheus/client_golang/prometheus"
+
+	"github.com/connylabs/model-tracking/store"
+)
+
+type fakeStatistics struct {
+	store.Statistics
+	vs  []*store.VersionStatistics
+	err error
+}
+
+func (fs *fakeStatistics) List(context.Context) ([]*store.VersionStatistics, error) {
+	return fs.vs, fs.err
+}
+
+func (fs *fakeStatistics) Count(context.Context, string, time.Time) (int64, error) {
+	return 0, fs.err
+}
+
+// gather collects the metrics of the collector and returns their values
+// indexed by metric name and version.
+func gather(t *testing.T, c prometheus.Collector) map[string]map[string]float64 {
+	reg := prometheus.NewPedanticRegistry()
+	testutil.Ok(t, reg.Register(c))
+	mfs, err := reg.Gather()
+	testutil.Ok(t, err)
+
+	values := make(map[string]map[string]float64)
+	for _, mf := range mfs {
+		values[mf.GetName()] = make(map[string]float64)
+		for _, m := range mf.GetMetric() {
+			var version string
+			for _, l := range m.GetLabel() {
+				if l.GetName() == "version" {
+					version = l.GetValue()
+				}
+			}
+			switch {
+			case m.GetCounter() != nil:
+				values[mf.GetName()][version] = m.GetCounter().GetValue()
+			case m.GetGauge() != nil:
+				values[mf.GetName()][version] = m.GetGauge().GetValue()
+			}
+		}
+	}
+	return values
+}
+
+func TestCollector(t *testing.T) {
+	last := time.Unix(1688990400, 0)
+	fs := &fakeStatistics{vs: []*store.VersionStatistics{
+		{Organization: "foo", Model: "bar", Version: "v1", Results: 10, Labelled: 8, Correct: 6, LastResult: &last},
+		{Organization: "foo", Model: "bar", Version: "v2"},
+	}}
+	c := New(fs, time.Minute, time.Second, nil)
+	values := gather(t, c)
+	testutil.Equals(t, map[string]float64{"": 0}, values["model_tracking_statistics_up"])
+	testutil.Equals(t, 1, len(values))
+
+	testutil.Ok(t, c.Refresh(context.Background()))
+	values = gather(t, c)
+
+	testutil.Equals(t, map[string]float64{"": 1}, values["model_tracking_statistics_up"])
+	testutil.Equals(t, map[string]float64{"v1": 10, "v2": 0}, values["model_tracking_results_total"])
+	testutil.Equals(t, map[string]float64{"v1": 8, "v2": 0}, values["model_tracking_labelled_results_total"])
+	testutil.Equals(t, map[string]float64{"v1": 2, "v2": 0}, values["model_tracking_unlabelled_results_total"])
+	testutil.Equals(t, map[string]float64{"v1": 6, "v2": 0}, values["model_tracking_correct_results_total"])
+	testutil.Equals(t, map[string]float64{"v1": 1688990400}, values["model_tracking_last_result_timestamp_seconds"])
+	testutil.Equals(t, map[string]float64{"v1": 0.75}, values["model_tracking_accuracy"])
+	testutil.Equals(t, map[string]float64{"v1": 0.25}, values["model_tracking_error_rate"])
+
+	fs.err = errors.New("connection refused")
+	testutil.NotOk(t, c.Refresh(context.Background()))
+	values = gather(t, c)
+	testutil.Equals(t, map[string]float64{"": 0}, values["model_tracking_statistics_up"])
+	testutil.Equals(t, map[string]float64{"v1": 10, "v2": 0}, values["model_tracking_results_total"])
+}
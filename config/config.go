@@ -0,0 +1,197 @@
+// Package config populates command-line flags from environment variables,
+// a YAML configuration file and files containing secrets.
+//
+// Flags are the single source of truth for the available options.
+// The value of a flag is taken from, in order of precedence:
+//  1. the command line, e.g. --database-max-open-conns=10;
+//  2. the environment variable named after the flag, e.g. MODEL_TRACKING_DATABASE_MAX_OPEN_CONNS=10;
+//  3. the file named by the environment variable with the suffix _FILE, e.g. MODEL_TRACKING_DATABASE_FILE=/run/secrets/database;
+//  4. the key named after the flag in the configuration file, e.g. database-max-open-conns: 10;
+//  5. the file named by the key with the suffix -file in the configuration file, e.g. database-file: /run/secrets/database;
+//  6. the default value of the flag.
+//
+// Reading values from files allows secrets, such as database connection strings,
+// to be mounted into the container rather than being visible in the process list
+// or the environment.
+package config
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"os"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// fileSuffix is the suffix of environment variables and keys
+// of the configuration file that name a file containing the value.
+const fileSuffix = "-file"
+
+// Parse parses the command-line arguments into the flags of the flag set
+// and then sets all flags that were not given on the command line
+// from the environment and the configuration file.
+// The environment variables are named after the flags with the given prefix,
+// e.g. the flag log-level with the prefix MODEL_TRACKING becomes MODEL_TRACKING_LOG_LEVEL.
+// The path of the configuration file is taken from the flag with the name configFlag,
+// which itself can be set from the environment. If it is empty, no file is read.
+func Parse(fs *flag.FlagSet, args []string, prefix, configFlag string) error {
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+
+	set := make(map[string]struct{})
+	fs.Visit(func(f *flag.Flag) {
+		set[f.Name] = struct{}{}
+	})
+
+	env := func(name string) (string, bool, error) {
+		key := envName(prefix, name)
+		if v, ok := os.LookupEnv(key); ok {
+			return v, true, nil
+		}
+		if path, ok := os.LookupEnv(key + envName("", fileSuffix)); ok {
+			v, err := readFile(path)
+			if err != nil {
+				return "", false, fmt.Errorf("failed to read the value of %s: %w", key, err)
+			}
+			return v, true, nil
+		}
+		return "", false, nil
+	}
+
+	// The configuration file is needed before the other flags can be set from it.
+	if _, ok := set[configFlag]; !ok {
+		if f := fs.Lookup(configFlag); f != nil {
+			v, ok, err := env(configFlag)
+			if err != nil {
+				return err
+			}
+			if ok {
+				if err := fs.Set(configFlag, v); err != nil {
+					return fmt.Errorf("invalid value %q for %s: %w", v, envName(prefix, configFlag), err)
+				}
+				set[configFlag] = struct{}{}
+			}
+		}
+	}
+
+	file := make(map[string]string)
+	if f := fs.Lookup(configFlag); f != nil && f.Value.String() != "" {
+		var err error
+		if file, err = load(fs, f.Value.String()); err != nil {
+			return err
+		}
+	}
+
+	var err error
+	fs.VisitAll(func(f *flag.Flag) {
+		if err != nil {
+			return
+		}
+		if _, ok := set[f.Name]; ok {
+			return
+		}
+		v, ok, e := env(f.Name)
+		if e != nil {
+			err = e
+			return
+		}
+		source := envName(prefix, f.Name)
+		if !ok {
+			if v, ok = file[f.Name]; !ok {
+				return
+			}
+			source = f.Name + " in the configuration file"
+		}
+		if e := fs.Set(f.Name, v); e != nil {
+			err = fmt.Errorf("invalid value %q for %s: %w", v, source, e)
+		}
+	})
+	return err
+}
+
+// load reads the YAML configuration file at the given path
+// and returns the values of the flags it sets.
+// Values of keys with the suffix -file are read from the named files.
+// Unknown keys are rejected to catch typos.
+func load(fs *flag.FlagSet, path string) (map[string]string, error) {
+	buf, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read the configuration file: %w", err)
+	}
+	var raw map[string]interface{}
+	if err := yaml.Unmarshal(buf, &raw); err != nil {
+		return nil, fmt.Errorf("failed to parse the configuration file %s: %w", path, err)
+	}
+
+	values := make(map[string]string, len(raw))
+	for k, v := range raw {
+		name := k
+		fromFile := false
+		if fs.Lookup(k) == nil && strings.HasSuffix(k, fileSuffix) {
+			name = strings.TrimSuffix(k, fileSuffix)
+			fromFile = true
+		}
+		if fs.Lookup(name) == nil {
+			return nil, fmt.Errorf("unknown key %q in the configuration file %s", k, path)
+		}
+		s, err := stringify(v)
+		if err != nil {
+			return nil, fmt.Errorf("invalid value for %q in the configuration file %s: %w", k, path, err)
+		}
+		if fromFile {
+			if _, ok := raw[name]; ok {
+				return nil, fmt.Errorf("both %q and %q are set in the configuration file %s", name, k, path)
+			}
+			if s, err = readFile(s); err != nil {
+				return nil, fmt.Errorf("failed to read the value of %q: %w", k, err)
+			}
+		}
+		values[name] = s
+	}
+	return values, nil
+}
+
+// stringify converts a YAML value to the string representation of a flag value.
+// Lists are joined with commas, like the values of the flags that accept lists.
+func stringify(v interface{}) (string, error) {
+	switch v := v.(type) {
+	case nil:
+		return "", nil
+	case []interface{}:
+		ss := make([]string, 0, len(v))
+		for _, e := range v {
+			s, err := stringify(e)
+			if err != nil {
+				return "", err
+			}
+			ss = append(ss, s)
+		}
+		return strings.Join(ss, ","), nil
+	case map[string]interface{}:
+		return "", errors.New("maps are not supported")
+	default:
+		return fmt.Sprint(v), nil
+	}
+}
+
+// readFile reads a value from a file, ignoring a trailing newline
+// as it is commonly added by editors and by Kubernetes secrets created from literals.
+func readFile(path string) (string, error) {
+	buf, err := os.ReadFile(path)
+	if err != nil {
+		return "", err
+	}
+	return strings.TrimRight(string(buf), "\r\n"), nil
+}
+
+// envName returns the name of the environment variable for the flag with the given name.
+func envName(prefix, name string) string {
+	n := strings.ToUpper(strings.ReplaceAll(name, "-", "_"))
+	if prefix == "" {
+		return n
+	}
+	return prefix + "_" + n
+}
@@ -0,0 +1,329 @@
+// Package consumer ingests results from the topics of Kafka-compatible message brokers.
+package consumer
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/go-kit/log"
+	"github.com/go-kit/log/level"
+	"github.com/prometheus/client_golang/prometheus"
+
+	"github.com/connylabs/model-tracking/api/v1alpha1"
+	"github.com/connylabs/model-tracking/ratelimit"
+	"github.com/connylabs/model-tracking/store"
+)
+
+// Record is a record of a topic.
+type Record struct {
+	Topic     string
+	Partition int32
+	Offset    int64
+	Key       []byte
+	Value     []byte
+}
+
+// Broker is a Kafka-compatible message broker.
+type Broker interface {
+	// Fetch returns the next records of the subscribed topics in order.
+	// It returns no records if none arrive within the timeout of the broker.
+	Fetch(context.Context) ([]Record, error)
+	// Commit commits the offsets of the given records,
+	// so that the consumer group resumes consuming after them.
+	Commit(context.Context, []Record) error
+	// Produce writes a record to a topic.
+	Produce(ctx context.Context, topic string, key, value []byte) error
+	// Close stops consuming.
+	Close(context.Context) error
+}
+
+// Message is the JSON encoding of the value of a record that holds a result.
+// Exactly one of the version and the stage must be set.
+type Message struct {
+	Organization string `json:"organization"`
+	Model        string `json:"model"`
+	Version      string `json:"version,omitempty"`
+	Stage        string `json:"stage,omitempty"`
+	v1alpha1.NewResult
+}
+
+// DeadLetter is the JSON encoding of the value of a record written to the dead-letter topic
+// for a record that cannot be ingested, e.g. because it does not match the schema of its version.
+// The key of the record is the key of the original record.
+type DeadLetter struct {
+	Topic     string `json:"topic"`
+	Partition int32  `json:"partition"`
+	Offset    int64  `json:"offset"`
+	Error     string `json:"error"`
+	// Value is the value of the original record.
+	Value []byte `json:"value"`
+}
+
+// Consumer consumes records holding results from a broker and creates the results in the store.
+// Offsets are only committed once the results of the records were created,
+// so results are created at least once. Records without a client ID are given
+// the ID "<topic>/<partition>/<offset>", so that records that are consumed again,
+// e.g. after a restart, do not create duplicate results.
+// Results count against the results quotas of their organizations like those created with the APIs;
+// records whose results do not fit are consumed again once the quota allows it.
+type Consumer struct {
+	broker          Broker
+	store           store.ModelTracking
+	deadLetterTopic string
+	limiter         *ratelimit.Limiter
+	backoff         time.Duration
+	logger          log.Logger
+
+	records *prometheus.CounterVec
+}
+
+// New creates a new consumer that consumes records from the broker.
+// Records that cannot be ingested are written to the dead-letter topic;
+// if it is empty, they are logged and skipped.
+// If the limiter is nil, results are not limited.
+func New(broker Broker, store store.ModelTracking, deadLetterTopic string, limiter *ratelimit.Limiter, reg prometheus.Registerer, logger log.Logger) *Consumer {
+	if logger == nil {
+		logger = log.NewNopLogger()
+	}
+	records := prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "model_tracking_consumer_records_total",
+		Help: "The number of consumed records by outcome.",
+	}, []string{"outcome"})
+	if reg != nil {
+		reg.MustRegister(records)
+	}
+	return &Consumer{
+		broker:          broker,
+		store:           store,
+		deadLetterTopic: deadLetterTopic,
+		limiter:         limiter,
+		backoff:         5 * time.Second,
+		logger:          logger,
+		records:         records,
+	}
+}
+
+// Run consumes records until the context is canceled.
+// Records that fail with errors that may be temporary, e.g. because the database is unavailable,
+// are retried after a backoff, so that the offsets of later records are never committed before them.
+func (c *Consumer) Run(ctx context.Context) error {
+	defer func() {
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		if err := c.broker.Close(ctx); err != nil {
+			level.Warn(c.logger).Log("msg", "failed to close the consumer", "err", err.Error())
+		}
+	}()
+	var pending []Record
+	for ctx.Err() == nil {
+		if len(pending) == 0 {
+			rs, err := c.broker.Fetch(ctx)
+			if err != nil {
+				c.wait(ctx, err)
+				continue
+			}
+			pending = rs
+		}
+		n, err := c.Consume(ctx, pending)
+		pending = pending[n:]
+		if err != nil {
+			c.wait(ctx, err)
+		}
+	}
+	return nil
+}
+
+// wait logs an error and waits for the backoff
+// or, if the results quota was exceeded, until the quota allows results again.
+func (c *Consumer) wait(ctx context.Context, err error) {
+	if ctx.Err() != nil {
+		return
+	}
+	backoff := c.backoff
+	var te *throttledError
+	if errors.As(err, &te) {
+		level.Warn(c.logger).Log("msg", "pausing consumption", "err", err.Error())
+		backoff = te.decision.RetryAfter
+	} else {
+		level.Error(c.logger).Log("msg", "failed to consume records", "err", err.Error())
+	}
+	select {
+	case <-ctx.Done():
+	case <-time.After(backoff):
+	}
+}
+
+// Consume ingests records in order and commits the offsets of the ingested records.
+// It stops at the first record that fails with an error that may be temporary
+// and returns the number of records that were ingested.
+func (c *Consumer) Consume(ctx context.Context, records []Record) (int, error) {
+	// The schemas of versions do not change,
+	// so their validators are reused for all records.
+	validators := make(map[string]*v1alpha1.ResultValidator)
+	var n int
+	var err error
+	for ; n < len(records); n++ {
+		if err = c.consume(ctx, records[n], validators); err != nil {
+			break
+		}
+	}
+	if n == 0 {
+		return 0, err
+	}
+	if cerr := c.broker.Commit(ctx, latest(records[:n])); cerr != nil {
+		// The results were created, so move on. The offsets are committed
+		// with those of the following records or else the records are consumed again.
+		return n, cerr
+	}
+	return n, err
+}
+
+// latest returns the record with the highest offset of every partition.
+func latest(records []Record) []Record {
+	type partition struct {
+		topic     string
+		partition int32
+	}
+	index := make(map[partition]int)
+	var rs []Record
+	for _, r := range records {
+		p := partition{r.Topic, r.Partition}
+		if i, ok := index[p]; ok {
+			if r.Offset > rs[i].Offset {
+				rs[i] = r
+			}
+			continue
+		}
+		index[p] = len(rs)
+		rs = append(rs, r)
+	}
+	return rs
+}
+
+// consume ingests a record. Records that cannot be ingested are dead-lettered.
+// It only returns errors that may be temporary.
+func (c *Consumer) consume(ctx context.Context, r Record, validators map[string]*v1alpha1.ResultValidator) error {
+	created, err := c.ingest(ctx, r, validators)
+	switch {
+	case err == nil && created:
+		c.records.WithLabelValues("created").Inc()
+		return nil
+	case err == nil:
+		c.records.WithLabelValues("skipped").Inc()
+		return nil
+	case errors.Is(err, store.ErrInvalid), errors.Is(err, store.ErrNotFound), errors.Is(err, store.ErrConflict):
+		return c.deadLetter(ctx, r, err)
+	default:
+		return err
+	}
+}
+
+// ingest validates the result of a record like ResultsCreateForVersion and creates it.
+// The validators of versions are cached in the given map.
+// It returns false if the result already exists.
+func (c *Consumer) ingest(ctx context.Context, r Record, validators map[string]*v1alpha1.ResultValidator) (bool, error) {
+	var m Message
+	d := json.NewDecoder(bytes.NewReader(r.Value))
+	d.DisallowUnknownFields()
+	if err := d.Decode(&m); err != nil {
+		return false, invalid("the record is malformed: %v", err)
+	}
+	if m.Organization == "" || m.Model == "" {
+		return false, invalid("the organization and model must be set")
+	}
+	if (m.Version == "") == (m.Stage == "") {
+		return false, invalid("exactly one of version and stage must be set")
+	}
+
+	version := m.Version
+	if m.Stage != "" {
+		st, err := c.store.Stages(m.Organization, m.Model).Get(ctx, m.Stage)
+		if err != nil {
+			return false, err
+		}
+		v, err := c.store.Versions(m.Organization, m.Model).GetByID(ctx, int(st.Version))
+		if err != nil {
+			return false, err
+		}
+		version = v.Name
+	}
+
+	key := strings.Join([]string{m.Organization, m.Model, version}, "/")
+	validator, ok := validators[key]
+	if !ok {
+		var err error
+		if validator, err = v1alpha1.NewResultValidator(ctx, c.store, m.Organization, m.Model, version); err != nil {
+			return false, err
+		}
+		validators[key] = validator
+	}
+	res, err := validator.Result(&m.NewResult)
+	if err != nil {
+		return false, err
+	}
+	if c.limiter != nil {
+		d, err := c.limiter.AllowResults(ctx, m.Organization, 1)
+		if err != nil {
+			// Do not reject results because quotas are temporarily unavailable.
+			level.Warn(c.logger).Log("msg", "failed to check the results quota", "organization", m.Organization, "err", err.Error())
+		} else if !d.Allowed {
+			return false, &throttledError{organization: m.Organization, decision: d}
+		}
+	}
+	if res.ClientID == nil {
+		id := fmt.Sprintf("%s/%d/%d", r.Topic, r.Partition, r.Offset)
+		res.ClientID = &id
+	}
+	if _, err := c.store.Results(m.Organization, m.Model, version).Create(ctx, res); err != nil {
+		if errors.Is(err, store.ErrConflict) {
+			// The result was already created, e.g. by an earlier delivery of the record.
+			return false, nil
+		}
+		return false, err
+	}
+	if c.limiter != nil {
+		c.limiter.AddResults(m.Organization, 1)
+	}
+	return true, nil
+}
+
+// throttledError is returned for a record whose result does not fit in the results quota of its organization.
+type throttledError struct {
+	organization string
+	decision     ratelimit.Decision
+}
+
+func (e *throttledError) Error() string {
+	return fmt.Sprintf("the results quota of organization %q is exceeded; retrying after %s", e.organization, e.decision.RetryAfter.Round(time.Second))
+}
+
+// deadLetter writes a record that cannot be ingested to the dead-letter topic.
+func (c *Consumer) deadLetter(ctx context.Context, r Record, cause error) error {
+	level.Warn(c.logger).Log("msg", "failed to ingest record", "topic", r.Topic, "partition", r.Partition, "offset", r.Offset, "err", cause.Error())
+	if c.deadLetterTopic == "" {
+		c.records.WithLabelValues("dead_lettered").Inc()
+		return nil
+	}
+	// Marshalling a dead letter cannot fail.
+	value, _ := json.Marshal(&DeadLetter{
+		Topic:     r.Topic,
+		Partition: r.Partition,
+		Offset:    r.Offset,
+		Error:     cause.Error(),
+		Value:     r.Value,
+	})
+	if err := c.broker.Produce(ctx, c.deadLetterTopic, r.Key, value); err != nil {
+		return fmt.Errorf("failed to write record to dead-letter topic: %w", err)
+	}
+	c.records.WithLabelValues("dead_lettered").Inc()
+	return nil
+}
+
+func invalid(format string, a ...interface{}) error {
+	return &store.Error{Kind: store.ErrInvalid, Message: fmt.Sprintf(format, a...)}
+}
@@ -0,0 +1,367 @@
+package consumer
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/efficientgo/core/testutil"
+
+	"github.com/connylabs/model-tracking/ratelimit"
+	"github.com/connylabs/model-tracking/store"
+	"github.com/connylabs/model-tracking/store/model-tracking/public/model"
+)
+
+// restProxy is an in-process stand-in for a Kafka REST proxy with a single partition per topic.
+type restProxy struct {
+	*httptest.Server
+	mu        sync.Mutex
+	topics    map[string][][]byte
+	committed map[string]int64
+	// positions holds the next offset to fetch for each topic of the consumer instance.
+	positions  map[string]int64
+	subscribed []string
+}
+
+func newRESTProxy(t *testing.T) *restProxy {
+	rp := &restProxy{
+		topics:    make(map[string][][]byte),
+		committed: make(map[string]int64),
+	}
+	rp.Server = httptest.NewServer(http.HandlerFunc(rp.serve))
+	t.Cleanup(rp.Close)
+	return rp
+}
+
+func (rp *restProxy) serve(w http.ResponseWriter, r *http.Request) {
+	rp.mu.Lock()
+	defer rp.mu.Unlock()
+	const instance = "/consumers/group/instances/test"
+	w.Header().Set("Content-Type", restProxyContentType)
+	switch {
+	case r.Method == http.MethodPost && r.URL.Path == "/consumers/group":
+		if rp.positions != nil {
+			w.WriteHeader(http.StatusConflict)
+			json.NewEncoder(w).Encode(map[string]interface{}{"error_code": 40902, "message": "Consumer instance already exists"}) //nolint:errcheck
+			return
+		}
+		rp.positions = make(map[string]int64)
+		json.NewEncoder(w).Encode(map[string]string{"instance_id": "test", "base_uri": rp.URL + instance}) //nolint:errcheck
+	case rp.positions == nil && strings.HasPrefix(r.URL.Path, instance):
+		w.WriteHeader(http.StatusNotFound)
+		json.NewEncoder(w).Encode(map[string]interface{}{"error_code": 40403, "message": "Consumer instance not found"}) //nolint:errcheck
+	case r.Method == http.MethodPost && r.URL.Path == instance+"/subscription":
+		var req struct {
+			Topics []string `json:"topics"`
+		}
+		json.NewDecoder(r.Body).Decode(&req) //nolint:errcheck
+		rp.subscribed = req.Topics
+		for _, t := range req.Topics {
+			rp.positions[t] = rp.committed[t]
+		}
+		w.WriteHeader(http.StatusNoContent)
+	case r.Method == http.MethodGet && r.URL.Path == instance+"/records":
+		type record struct {
+			Topic     string `json:"topic"`
+			Partition int32  `json:"partition"`
+			Offset    int64  `json:"offset"`
+			Value     []byte `json:"value"`
+		}
+		records := []record{}
+		for _, t := range rp.subscribed {
+			for o := rp.positions[t]; o < int64(len(rp.topics[t])); o++ {
+				records = append(records, record{Topic: t, Offset: o, Value: rp.topics[t][o]})
+			}
+			rp.positions[t] = int64(len(rp.topics[t]))
+		}
+		w.Header().Set("Content-Type", restProxyBinaryType)
+		json.NewEncoder(w).Encode(records) //nolint:errcheck
+	case r.Method == http.MethodPost && r.URL.Path == instance+"/offsets":
+		var req struct {
+			Offsets []struct {
+				Topic  string `json:"topic"`
+				Offset int64  `json:"offset"`
+			} `json:"offsets"`
+		}
+		json.NewDecoder(r.Body).Decode(&req) //nolint:errcheck
+		for _, o := range req.Offsets {
+			rp.committed[o.Topic] = o.Offset + 1
+		}
+		w.WriteHeader(http.StatusNoContent)
+	case r.Method == http.MethodDelete && r.URL.Path == instance:
+		rp.positions = nil
+		w.WriteHeader(http.StatusNoContent)
+	case r.Method == http.MethodPost && strings.HasPrefix(r.URL.Path, "/topics/"):
+		var req struct {
+			Records []struct {
+				Value []byte `json:"value"`
+			} `json:"records"`
+		}
+		json.NewDecoder(r.Body).Decode(&req) //nolint:errcheck
+		t := strings.TrimPrefix(r.URL.Path, "/topics/")
+		var offsets []map[string]int64
+		for _, rec := range req.Records {
+			offsets = append(offsets, map[string]int64{"partition": 0, "offset": int64(len(rp.topics[t]))})
+			rp.topics[t] = append(rp.topics[t], rec.Value)
+		}
+		json.NewEncoder(w).Encode(map[string]interface{}{"offsets": offsets}) //nolint:errcheck
+	default:
+		w.WriteHeader(http.StatusNotFound)
+		json.NewEncoder(w).Encode(map[string]interface{}{"error_code": 404, "message": "HTTP 404 Not Found"}) //nolint:errcheck
+	}
+}
+
+func (rp *restProxy) produce(topic string, values ...string) {
+	rp.mu.Lock()
+	defer rp.mu.Unlock()
+	for _, v := range values {
+		rp.topics[topic] = append(rp.topics[topic], []byte(v))
+	}
+}
+
+func (rp *restProxy) records(topic string) [][]byte {
+	rp.mu.Lock()
+	defer rp.mu.Unlock()
+	return rp.topics[topic]
+}
+
+func (rp *restProxy) offset(topic string) int64 {
+	rp.mu.Lock()
+	defer rp.mu.Unlock()
+	return rp.committed[topic]
+}
+
+type fakeStore struct {
+	store.ModelTracking
+	mu      sync.Mutex
+	results map[string][]*model.Result
+	// fail makes creating results fail as if the database were unavailable.
+	fail bool
+	// schemas is the number of times the schemas of versions were looked up.
+	schemas int
+}
+
+func (fs *fakeStore) Versions(string, string) store.Versions {
+	return fakeVersions{}
+}
+
+func (fs *fakeStore) Schemas(string) store.Schemas {
+	fs.mu.Lock()
+	defer fs.mu.Unlock()
+	fs.schemas++
+	return fakeSchemas{}
+}
+
+func (fs *fakeStore) Stages(string, string) store.Stages {
+	return fakeStages{}
+}
+
+func (fs *fakeStore) Results(_, _, version string) store.Results {
+	return &fakeResults{fs: fs, version: version}
+}
+
+func (fs *fakeStore) count(version string) int {
+	fs.mu.Lock()
+	defer fs.mu.Unlock()
+	return len(fs.results[version])
+}
+
+type fakeVersions struct {
+	store.Versions
+}
+
+func (fakeVersions) GetOrCreate(_ context.Context, name string) (*model.Version, error) {
+	if name == "archived" {
+		return nil, &store.Error{Kind: store.ErrConflict, Message: "version \"archived\" is archived"}
+	}
+	return &model.Version{Name: name, Schema: 1}, nil
+}
+
+func (fakeVersions) GetByID(_ context.Context, id int) (*model.Version, error) {
+	return &model.Version{ID: int32(id), Name: "v2", Schema: 1}, nil
+}
+
+type fakeSchemas struct {
+	store.Schemas
+}
+
+func (fakeSchemas) GetByID(_ context.Context, id int) (*model.Schema, error) {
+	return &model.Schema{ID: int32(id), Input: []byte(`{"type": "string"}`), Output: []byte(`{"type": "integer"}`)}, nil
+}
+
+type fakeStages struct {
+	store.Stages
+}
+
+func (fakeStages) Get(_ context.Context, name string) (*model.Stage, error) {
+	if name != "production" {
+		return nil, &store.Error{Kind: store.ErrNotFound, Message: "stage not found"}
+	}
+	return &model.Stage{Name: name, Version: 2}, nil
+}
+
+type fakeResults struct {
+	store.Results
+	fs      *fakeStore
+	version string
+}
+
+func (fr *fakeResults) Create(_ context.Context, r *model.Result) (*model.Result, error) {
+	fr.fs.mu.Lock()
+	defer fr.fs.mu.Unlock()
+	if fr.fs.fail {
+		return nil, errors.New("connection refused")
+	}
+	for _, e := range fr.fs.results[fr.version] {
+		if *e.ClientID == *r.ClientID {
+			return nil, &store.Error{Kind: store.ErrConflict, Message: "duplicate client ID"}
+		}
+	}
+	fr.fs.results[fr.version] = append(fr.fs.results[fr.version], r)
+	return r, nil
+}
+
+func TestConsumer(t *testing.T) {
+	rp := newRESTProxy(t)
+	fs := &fakeStore{results: make(map[string][]*model.Result)}
+	ctx := context.Background()
+	newConsumer := func() (*Consumer, *RESTProxy) {
+		b := NewRESTProxy(rp.URL, "group", "test", []string{"results"}, time.Second, nil)
+		return New(b, fs, "dead-letters", nil, nil, nil), b
+	}
+
+	rp.produce("results",
+		`{"organization": "foo", "model": "bar", "version": "v1", "input": "a", "output": 1, "trueOutput": 1}`,
+		`{"organization": "foo", "model": "bar", "stage": "production", "input": "a", "output": 1, "trueOutput": 1, "clientId": "x"}`,
+		`{"organization": "foo", "model": "bar", "version": "v1", "input": 1, "output": 1}`,
+		`{"organization": "foo", "model": "bar", "version": "archived", "input": "a", "output": 1}`,
+		`{"organization": "foo", "model": "bar", "version": "v1", "stage": "production", "input": "a", "output": 1}`,
+		`{"organization": "foo", "model": "bar", "version": "v1", "input": "a", "output": 1, "extra": true}`,
+		`not json`,
+	)
+	c, b := newConsumer()
+	rs, err := b.Fetch(ctx)
+	testutil.Ok(t, err)
+	testutil.Equals(t, 7, len(rs))
+	n, err := c.Consume(ctx, rs)
+	testutil.Ok(t, err)
+	testutil.Equals(t, 7, n)
+	testutil.Equals(t, int64(7), rp.offset("results"))
+	testutil.Equals(t, 1, fs.count("v1"))
+	testutil.Equals(t, 1, fs.count("v2"))
+	testutil.Equals(t, "results/0/0", *fs.results["v1"][0].ClientID)
+	testutil.Equals(t, "x", *fs.results["v2"][0].ClientID)
+	// The validators of versions are reused for the records of a batch.
+	testutil.Equals(t, 2, fs.schemas)
+
+	dls := rp.records("dead-letters")
+	testutil.Equals(t, 5, len(dls))
+	var dl DeadLetter
+	testutil.Ok(t, json.Unmarshal(dls[0], &dl))
+	testutil.Equals(t, "results", dl.Topic)
+	testutil.Equals(t, int64(2), dl.Offset)
+	testutil.Equals(t, string(rp.records("results")[2]), string(dl.Value))
+	testutil.Assert(t, dl.Error != "", "the dead letter should hold the error")
+
+	// Offsets are not committed while results cannot be created.
+	rp.produce("results", `{"organization": "foo", "model": "bar", "version": "v1", "input": "b", "output": 2, "trueOutput": 2}`)
+	fs.fail = true
+	rs, err = b.Fetch(ctx)
+	testutil.Ok(t, err)
+	testutil.Equals(t, 1, len(rs))
+	n, err = c.Consume(ctx, rs)
+	testutil.NotOk(t, err)
+	testutil.Equals(t, 0, n)
+	testutil.Equals(t, int64(7), rp.offset("results"))
+	testutil.Equals(t, 5, len(rp.records("dead-letters")))
+
+	// After a restart, uncommitted records are consumed again.
+	fs.fail = false
+	testutil.Ok(t, b.Close(ctx))
+	c, b = newConsumer()
+	rs, err = b.Fetch(ctx)
+	testutil.Ok(t, err)
+	testutil.Equals(t, 1, len(rs))
+	testutil.Equals(t, int64(7), rs[0].Offset)
+	n, err = c.Consume(ctx, rs)
+	testutil.Ok(t, err)
+	testutil.Equals(t, 1, n)
+	testutil.Equals(t, int64(8), rp.offset("results"))
+	testutil.Equals(t, 2, fs.count("v1"))
+
+	// Records that are delivered again do not create duplicate results.
+	n, err = c.Consume(ctx, rs)
+	testutil.Ok(t, err)
+	testutil.Equals(t, 1, n)
+	testutil.Equals(t, 2, fs.count("v1"))
+}
+
+type fakeStatistics struct {
+	store.Statistics
+}
+
+func (fakeStatistics) Count(context.Context, string, time.Time) (int64, error) {
+	return 0, nil
+}
+
+func TestConsumerQuota(t *testing.T) {
+	rp := newRESTProxy(t)
+	fs := &fakeStore{results: make(map[string][]*model.Result)}
+	ctx := context.Background()
+	limiter := ratelimit.NewLimiter(&ratelimit.Config{Default: ratelimit.Limits{ResultsPerDay: 1}}, fakeStatistics{}, time.Hour, nil)
+	b := NewRESTProxy(rp.URL, "group", "test", []string{"results"}, time.Second, nil)
+	c := New(b, fs, "dead-letters", limiter, nil, nil)
+
+	rp.produce("results",
+		`{"organization": "foo", "model": "bar", "version": "v1", "input": "a", "output": 1}`,
+		`{"organization": "foo", "model": "bar", "version": "v1", "input": "b", "output": 1}`,
+	)
+	rs, err := b.Fetch(ctx)
+	testutil.Ok(t, err)
+	testutil.Equals(t, 2, len(rs))
+
+	// Records whose results exceed the quota are neither committed nor dead-lettered.
+	n, err := c.Consume(ctx, rs)
+	var te *throttledError
+	testutil.Assert(t, errors.As(err, &te), "the consumer should be throttled")
+	testutil.Equals(t, ratelimit.ResultsQuota, te.decision.Reason)
+	testutil.Equals(t, 1, n)
+	testutil.Equals(t, int64(1), rp.offset("results"))
+	testutil.Equals(t, 1, fs.count("v1"))
+	testutil.Equals(t, 0, len(rp.records("dead-letters")))
+}
+
+func TestRun(t *testing.T) {
+	rp := newRESTProxy(t)
+	fs := &fakeStore{results: make(map[string][]*model.Result)}
+	c := New(NewRESTProxy(rp.URL, "group", "test", []string{"results"}, 10*time.Millisecond, nil), fs, "", nil, nil, nil)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan error)
+	go func() {
+		done <- c.Run(ctx)
+	}()
+	rp.produce("results",
+		`{"organization": "foo", "model": "bar", "version": "v1", "input": "a", "output": 1, "trueOutput": 1}`,
+		`{"organization": "foo", "model": "bar", "version": "v1", "input": 1, "output": 1}`,
+		`{"organization": "foo", "model": "bar", "version": "v1", "input": "b", "output": 2, "trueOutput": 2}`,
+	)
+	for i := 0; rp.offset("results") != 3; i++ {
+		testutil.Assert(t, i < 100, "the records should be consumed")
+		time.Sleep(10 * time.Millisecond)
+	}
+	cancel()
+	testutil.Ok(t, <-done)
+	testutil.Equals(t, 2, fs.count("v1"))
+
+	// The consumer instance is deleted when the consumer stops.
+	rp.mu.Lock()
+	defer rp.mu.Unlock()
+	testutil.Assert(t, rp.positions == nil, "the consumer instance should be deleted")
+}
@@ -0,0 +1,235 @@
+package consumer
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	restProxyContentType = "application/vnd.kafka.v2+json"
+	restProxyBinaryType  = "application/vnd.kafka.binary.v2+json"
+)
+
+// RESTProxy is a broker that consumes and produces records through the v2 API of a Kafka REST proxy,
+// such as the Confluent REST Proxy or the HTTP proxy of Redpanda.
+// It consumes as a member of a consumer group, so that the partitions of the topics
+// are shared by all replicas of model-tracking, and commits offsets explicitly.
+type RESTProxy struct {
+	url     string
+	group   string
+	name    string
+	topics  []string
+	timeout time.Duration
+	client  *http.Client
+
+	mu       sync.Mutex
+	instance string
+}
+
+// NewRESTProxy creates a new broker for the REST proxy at the given URL that consumes
+// the given topics as the consumer with the given name in the given consumer group.
+// Names must be unique within the group, e.g. the hostname of the replica.
+// Fetches wait for up to timeout for new records.
+// If client is nil, a client with a timeout that exceeds that of fetches is used.
+func NewRESTProxy(url, group, name string, topics []string, timeout time.Duration, client *http.Client) *RESTProxy {
+	if client == nil {
+		client = &http.Client{Timeout: timeout + 10*time.Second}
+	}
+	return &RESTProxy{
+		url:     strings.TrimSuffix(url, "/"),
+		group:   group,
+		name:    name,
+		topics:  topics,
+		timeout: timeout,
+		client:  client,
+	}
+}
+
+// restProxyError is an error returned by the REST proxy.
+type restProxyError struct {
+	status  int
+	Code    int    `json:"error_code"`
+	Message string `json:"message"`
+}
+
+func (e *restProxyError) Error() string {
+	return fmt.Sprintf("REST proxy responded with %d: %s (error code %d)", e.status, e.Message, e.Code)
+}
+
+// do sends a request to the REST proxy and decodes the JSON response into res, if it is not nil.
+func (rp *RESTProxy) do(ctx context.Context, method, u, contentType, accept string, body, res interface{}) error {
+	var r io.Reader
+	if body != nil {
+		b, err := json.Marshal(body)
+		if err != nil {
+			return err
+		}
+		r = bytes.NewReader(b)
+	}
+	req, err := http.NewRequestWithContext(ctx, method, u, r)
+	if err != nil {
+		return err
+	}
+	if body != nil {
+		req.Header.Set("Content-Type", contentType)
+	}
+	req.Header.Set("Accept", accept)
+	resp, err := rp.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode/100 != 2 {
+		e := &restProxyError{status: resp.StatusCode}
+		if err := json.NewDecoder(io.LimitReader(resp.Body, 1<<16)).Decode(e); err != nil {
+			e.Message = http.StatusText(resp.StatusCode)
+		}
+		return e
+	}
+	if res == nil {
+		return nil
+	}
+	return json.NewDecoder(resp.Body).Decode(res)
+}
+
+// consumer returns the URL of the consumer instance, creating and subscribing it if needed.
+func (rp *RESTProxy) consumer(ctx context.Context) (string, error) {
+	rp.mu.Lock()
+	defer rp.mu.Unlock()
+	if rp.instance != "" {
+		return rp.instance, nil
+	}
+	var res struct {
+		BaseURI string `json:"base_uri"`
+	}
+	err := rp.do(ctx, http.MethodPost, rp.url+"/consumers/"+url.PathEscape(rp.group), restProxyContentType, restProxyContentType, map[string]string{
+		"name":   rp.name,
+		"format": "binary",
+		// Records are consumed from the beginning of topics that the group has not consumed yet.
+		"auto.offset.reset":  "earliest",
+		"auto.commit.enable": "false",
+	}, &res)
+	var e *restProxyError
+	if errors.As(err, &e) && e.status == http.StatusConflict {
+		// The instance still exists, e.g. because the process restarted.
+		res.BaseURI = rp.url + "/consumers/" + url.PathEscape(rp.group) + "/instances/" + url.PathEscape(rp.name)
+	} else if err != nil {
+		return "", fmt.Errorf("failed to create consumer: %w", err)
+	}
+	if err := rp.do(ctx, http.MethodPost, res.BaseURI+"/subscription", restProxyContentType, restProxyContentType, map[string][]string{"topics": rp.topics}, nil); err != nil {
+		return "", fmt.Errorf("failed to subscribe to topics: %w", err)
+	}
+	rp.instance = res.BaseURI
+	return rp.instance, nil
+}
+
+// forget forgets the consumer instance if the REST proxy no longer knows it,
+// e.g. because it expired, so that it is created again.
+// Records that were fetched but not committed are then consumed again.
+func (rp *RESTProxy) forget(instance string, err error) {
+	var e *restProxyError
+	if !errors.As(err, &e) || e.status != http.StatusNotFound {
+		return
+	}
+	rp.mu.Lock()
+	defer rp.mu.Unlock()
+	if rp.instance == instance {
+		rp.instance = ""
+	}
+}
+
+// Fetch implements Broker.
+func (rp *RESTProxy) Fetch(ctx context.Context) ([]Record, error) {
+	instance, err := rp.consumer(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var res []struct {
+		Topic     string `json:"topic"`
+		Partition int32  `json:"partition"`
+		Offset    int64  `json:"offset"`
+		Key       []byte `json:"key"`
+		Value     []byte `json:"value"`
+	}
+	u := fmt.Sprintf("%s/records?timeout=%d", instance, rp.timeout.Milliseconds())
+	if err := rp.do(ctx, http.MethodGet, u, "", restProxyBinaryType, nil, &res); err != nil {
+		rp.forget(instance, err)
+		return nil, fmt.Errorf("failed to fetch records: %w", err)
+	}
+	records := make([]Record, 0, len(res))
+	for _, r := range res {
+		records = append(records, Record{Topic: r.Topic, Partition: r.Partition, Offset: r.Offset, Key: r.Key, Value: r.Value})
+	}
+	return records, nil
+}
+
+// Commit implements Broker.
+func (rp *RESTProxy) Commit(ctx context.Context, records []Record) error {
+	instance, err := rp.consumer(ctx)
+	if err != nil {
+		return err
+	}
+	type offset struct {
+		Topic     string `json:"topic"`
+		Partition int32  `json:"partition"`
+		Offset    int64  `json:"offset"`
+	}
+	offsets := make([]offset, 0, len(records))
+	for _, r := range records {
+		offsets = append(offsets, offset{Topic: r.Topic, Partition: r.Partition, Offset: r.Offset})
+	}
+	// The REST proxy commits the offsets following the given ones.
+	if err := rp.do(ctx, http.MethodPost, instance+"/offsets", restProxyContentType, restProxyContentType, map[string][]offset{"offsets": offsets}, nil); err != nil {
+		rp.forget(instance, err)
+		return fmt.Errorf("failed to commit offsets: %w", err)
+	}
+	return nil
+}
+
+// Produce implements Broker.
+func (rp *RESTProxy) Produce(ctx context.Context, topic string, key, value []byte) error {
+	type record struct {
+		Key   []byte `json:"key,omitempty"`
+		Value []byte `json:"value"`
+	}
+	var res struct {
+		Offsets []struct {
+			ErrorCode *int   `json:"error_code"`
+			Error     string `json:"error"`
+		} `json:"offsets"`
+	}
+	if err := rp.do(ctx, http.MethodPost, rp.url+"/topics/"+url.PathEscape(topic), restProxyBinaryType, restProxyContentType, map[string][]record{"records": {{Key: key, Value: value}}}, &res); err != nil {
+		return fmt.Errorf("failed to produce record: %w", err)
+	}
+	for _, o := range res.Offsets {
+		if o.ErrorCode != nil {
+			return fmt.Errorf("failed to produce record: %s (error code %d)", o.Error, *o.ErrorCode)
+		}
+	}
+	return nil
+}
+
+// Close implements Broker. It deletes the consumer instance,
+// so that its partitions are assigned to the other members of the group right away.
+func (rp *RESTProxy) Close(ctx context.Context) error {
+	rp.mu.Lock()
+	instance := rp.instance
+	rp.instance = ""
+	rp.mu.Unlock()
+	if instance == "" {
+		return nil
+	}
+	if err := rp.do(ctx, http.MethodDelete, instance, "", restProxyContentType, nil, nil); err != nil {
+		return fmt.Errorf("failed to delete consumer: %w", err)
+	}
+	return nil
+}
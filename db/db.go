@@ -0,0 +1,44 @@
+// Package db contains the migrations of the model-tracking database.
+package db
+
+import (
+	"embed"
+	"fmt"
+	"io/fs"
+	"path"
+	"strconv"
+	"strings"
+)
+
+// Migrations contains the goose migrations of the database.
+//
+//go:embed migrations/*.sql
+var Migrations embed.FS
+
+// Version returns the version of the latest migration,
+// i.e. the version of the database schema that this binary expects.
+func Version() (int64, error) {
+	files, err := fs.Glob(Migrations, "migrations/*.sql")
+	if err != nil {
+		return 0, err
+	}
+	var latest int64
+	for _, f := range files {
+		name := path.Base(f)
+		i := strings.Index(name, "_")
+		if i < 0 {
+			return 0, fmt.Errorf("migration %q is not prefixed with a version", name)
+		}
+		v, err := strconv.ParseInt(name[:i], 10, 64)
+		if err != nil {
+			return 0, fmt.Errorf("failed to parse the version of migration %q: %w", name, err)
+		}
+		if v > latest {
+			latest = v
+		}
+	}
+	if latest == 0 {
+		return 0, fmt.Errorf("no migrations found")
+	}
+	return latest, nil
+}
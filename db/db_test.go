@@ -0,0 +1,14 @@
+package db
+
+import (
+	"testing"
+
+	"github.com/efficientgo/core/testutil"
+)
+
+func TestVersion(t *testing.T) {
+	v, err := Version()
+	testutil.Ok(t, err)
+	// The initial migration is the oldest possible version.
+	testutil.Assert(t, v >= 20230101000000, "expected the latest version to be at least the initial version, got %d", v)
+}
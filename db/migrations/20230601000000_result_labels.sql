@@ -0,0 +1,8 @@
+-- +goose Up
+ALTER TABLE RESULT ADD COLUMN labels JSONB NOT NULL DEFAULT '{}';
+
+CREATE INDEX result_labels_index ON RESULT USING GIN (labels);
+
+-- +goose Down
+DROP INDEX IF EXISTS result_labels_index;
+ALTER TABLE RESULT DROP COLUMN labels;
@@ -0,0 +1,20 @@
+-- +goose Up
+ALTER TABLE VERSION
+	ADD COLUMN artifact_uri TEXT,
+	ADD COLUMN commit TEXT,
+	ADD COLUMN dataset TEXT,
+	ADD COLUMN hyperparameters JSONB,
+	ADD COLUMN description TEXT,
+	ADD COLUMN labels JSONB NOT NULL DEFAULT '{}';
+
+CREATE INDEX version_labels_index ON VERSION USING GIN (labels);
+
+-- +goose Down
+DROP INDEX IF EXISTS version_labels_index;
+ALTER TABLE VERSION
+	DROP COLUMN artifact_uri,
+	DROP COLUMN commit,
+	DROP COLUMN dataset,
+	DROP COLUMN hyperparameters,
+	DROP COLUMN description,
+	DROP COLUMN labels;
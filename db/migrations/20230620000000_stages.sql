@@ -0,0 +1,46 @@
+-- +goose Up
+CREATE TABLE STAGE (
+	id INT PRIMARY KEY GENERATED ALWAYS AS IDENTITY,
+	name TEXT NOT NULL,
+	organization INT NOT NULL,
+	model INT NOT NULL,
+	version INT NOT NULL,
+	created TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+	updated TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+	FOREIGN KEY (organization) REFERENCES ORGANIZATION (id),
+	FOREIGN KEY (model) REFERENCES MODEL (id),
+	FOREIGN KEY (version) REFERENCES VERSION (id)
+);
+
+CREATE UNIQUE INDEX stage_name_model_organization_index ON STAGE (name, model, organization);
+
+CREATE TRIGGER set_timestamp_insert
+  BEFORE INSERT ON STAGE
+  FOR EACH ROW
+  EXECUTE PROCEDURE trigger_set_timestamp();
+
+CREATE TRIGGER set_timestamp_update
+  BEFORE UPDATE ON STAGE
+  FOR EACH ROW
+  EXECUTE PROCEDURE trigger_set_timestamp();
+
+CREATE TABLE STAGE_HISTORY (
+	id INT PRIMARY KEY GENERATED ALWAYS AS IDENTITY,
+	organization INT NOT NULL,
+	model INT NOT NULL,
+	stage INT NOT NULL,
+	version INT NOT NULL,
+	previous_version INT,
+	created TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+	FOREIGN KEY (organization) REFERENCES ORGANIZATION (id),
+	FOREIGN KEY (model) REFERENCES MODEL (id),
+	FOREIGN KEY (stage) REFERENCES STAGE (id) ON DELETE CASCADE,
+	FOREIGN KEY (version) REFERENCES VERSION (id),
+	FOREIGN KEY (previous_version) REFERENCES VERSION (id)
+);
+
+CREATE INDEX stage_history_stage_index ON STAGE_HISTORY (stage);
+
+-- +goose Down
+DROP TABLE IF EXISTS STAGE_HISTORY;
+DROP TABLE IF EXISTS STAGE;
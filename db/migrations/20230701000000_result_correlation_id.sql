@@ -0,0 +1,8 @@
+-- +goose Up
+ALTER TABLE RESULT ADD COLUMN correlation_id TEXT;
+
+CREATE INDEX result_model_correlation_id_index ON RESULT (model, correlation_id) WHERE correlation_id IS NOT NULL;
+
+-- +goose Down
+DROP INDEX IF EXISTS result_model_correlation_id_index;
+ALTER TABLE RESULT DROP COLUMN correlation_id;
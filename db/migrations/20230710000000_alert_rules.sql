@@ -0,0 +1,35 @@
+-- +goose Up
+CREATE TABLE ALERT_RULE (
+	id INT PRIMARY KEY GENERATED ALWAYS AS IDENTITY,
+	name TEXT NOT NULL,
+	organization INT NOT NULL,
+	model INT NOT NULL,
+	stage TEXT NOT NULL,
+	metric TEXT NOT NULL,
+	field TEXT,
+	operator TEXT NOT NULL,
+	threshold DOUBLE PRECISION NOT NULL,
+	window_seconds INT NOT NULL,
+	firing BOOLEAN NOT NULL DEFAULT FALSE,
+	active_since TIMESTAMP,
+	last_value DOUBLE PRECISION,
+	created TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+	updated TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+	FOREIGN KEY (organization) REFERENCES ORGANIZATION (id),
+	FOREIGN KEY (model) REFERENCES MODEL (id)
+);
+
+CREATE UNIQUE INDEX alert_rule_name_model_organization_index ON ALERT_RULE (name, model, organization);
+
+CREATE TRIGGER set_timestamp_insert
+  BEFORE INSERT ON ALERT_RULE
+  FOR EACH ROW
+  EXECUTE PROCEDURE trigger_set_timestamp();
+
+CREATE TRIGGER set_timestamp_update
+  BEFORE UPDATE ON ALERT_RULE
+  FOR EACH ROW
+  EXECUTE PROCEDURE trigger_set_timestamp();
+
+-- +goose Down
+DROP TABLE IF EXISTS ALERT_RULE;
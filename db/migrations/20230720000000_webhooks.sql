@@ -0,0 +1,56 @@
+-- +goose Up
+CREATE TABLE WEBHOOK (
+	id INT PRIMARY KEY GENERATED ALWAYS AS IDENTITY,
+	name TEXT NOT NULL,
+	organization INT NOT NULL,
+	url TEXT NOT NULL,
+	secret TEXT NOT NULL,
+	events JSONB NOT NULL DEFAULT '[]',
+	created TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+	updated TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+	FOREIGN KEY (organization) REFERENCES ORGANIZATION (id)
+);
+
+CREATE UNIQUE INDEX webhook_name_organization_index ON WEBHOOK (name, organization);
+
+CREATE TRIGGER set_timestamp_insert
+  BEFORE INSERT ON WEBHOOK
+  FOR EACH ROW
+  EXECUTE PROCEDURE trigger_set_timestamp();
+
+CREATE TRIGGER set_timestamp_update
+  BEFORE UPDATE ON WEBHOOK
+  FOR EACH ROW
+  EXECUTE PROCEDURE trigger_set_timestamp();
+
+CREATE TABLE WEBHOOK_DELIVERY (
+	id INT PRIMARY KEY GENERATED ALWAYS AS IDENTITY,
+	webhook INT NOT NULL,
+	event TEXT NOT NULL,
+	payload JSONB NOT NULL,
+	status TEXT NOT NULL DEFAULT 'pending',
+	attempts INT NOT NULL DEFAULT 0,
+	next_attempt TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+	response_status INT,
+	error TEXT,
+	created TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+	updated TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+	FOREIGN KEY (webhook) REFERENCES WEBHOOK (id) ON DELETE CASCADE
+);
+
+CREATE INDEX webhook_delivery_webhook_index ON WEBHOOK_DELIVERY (webhook);
+CREATE INDEX webhook_delivery_pending_index ON WEBHOOK_DELIVERY (next_attempt) WHERE status = 'pending';
+
+CREATE TRIGGER set_timestamp_insert
+  BEFORE INSERT ON WEBHOOK_DELIVERY
+  FOR EACH ROW
+  EXECUTE PROCEDURE trigger_set_timestamp();
+
+CREATE TRIGGER set_timestamp_update
+  BEFORE UPDATE ON WEBHOOK_DELIVERY
+  FOR EACH ROW
+  EXECUTE PROCEDURE trigger_set_timestamp();
+
+-- +goose Down
+DROP TABLE IF EXISTS WEBHOOK_DELIVERY;
+DROP TABLE IF EXISTS WEBHOOK;
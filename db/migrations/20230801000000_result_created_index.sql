@@ -0,0 +1,6 @@
+-- +goose Up
+-- Support counting the results of an organization created in a period for quotas.
+CREATE INDEX result_organization_created_index ON RESULT (organization, created);
+
+-- +goose Down
+DROP INDEX IF EXISTS result_organization_created_index;
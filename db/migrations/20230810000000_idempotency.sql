@@ -0,0 +1,37 @@
+-- +goose Up
+-- Results may carry an ID chosen by the client, so that retried submissions are not recorded twice.
+ALTER TABLE RESULT ADD COLUMN client_id TEXT;
+
+CREATE UNIQUE INDEX result_version_client_id_index ON RESULT (version, client_id);
+
+CREATE TABLE IDEMPOTENCY_KEY (
+	id INT PRIMARY KEY GENERATED ALWAYS AS IDENTITY,
+	organization INT NOT NULL,
+	key TEXT NOT NULL,
+	-- The fingerprint, status and response are set once the request has completed.
+	fingerprint TEXT,
+	status INT,
+	response BYTEA,
+	expires TIMESTAMP NOT NULL,
+	created TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+	updated TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+	FOREIGN KEY (organization) REFERENCES ORGANIZATION (id)
+);
+
+CREATE UNIQUE INDEX idempotency_key_key_organization_index ON IDEMPOTENCY_KEY (key, organization);
+CREATE INDEX idempotency_key_organization_expires_index ON IDEMPOTENCY_KEY (organization, expires);
+
+CREATE TRIGGER set_timestamp_insert
+  BEFORE INSERT ON IDEMPOTENCY_KEY
+  FOR EACH ROW
+  EXECUTE PROCEDURE trigger_set_timestamp();
+
+CREATE TRIGGER set_timestamp_update
+  BEFORE UPDATE ON IDEMPOTENCY_KEY
+  FOR EACH ROW
+  EXECUTE PROCEDURE trigger_set_timestamp();
+
+-- +goose Down
+DROP TABLE IF EXISTS IDEMPOTENCY_KEY;
+DROP INDEX IF EXISTS result_version_client_id_index;
+ALTER TABLE RESULT DROP COLUMN client_id;
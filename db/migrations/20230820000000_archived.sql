@@ -0,0 +1,18 @@
+-- +goose Up
+ALTER TABLE MODEL
+	ADD COLUMN description TEXT,
+	ADD COLUMN labels JSONB NOT NULL DEFAULT '{}',
+	ADD COLUMN archived BOOLEAN NOT NULL DEFAULT FALSE;
+
+CREATE INDEX model_labels_index ON MODEL USING GIN (labels);
+
+-- Archived versions, and versions of archived models, no longer accept results.
+ALTER TABLE VERSION ADD COLUMN archived BOOLEAN NOT NULL DEFAULT FALSE;
+
+-- +goose Down
+ALTER TABLE VERSION DROP COLUMN archived;
+DROP INDEX IF EXISTS model_labels_index;
+ALTER TABLE MODEL
+	DROP COLUMN description,
+	DROP COLUMN labels,
+	DROP COLUMN archived;
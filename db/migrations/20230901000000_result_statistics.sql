@@ -0,0 +1,58 @@
+-- +goose Up
+-- Keep running statistics of the results of every version, so that they can be read
+-- without aggregating all results. Every statement that inserts results adds a row
+-- per version with the statistics of the inserted results, so that concurrent inserts
+-- do not contend for a row. The rows of a version are compacted when they are read.
+-- Results are never updated or deleted, so the statistics do not need to be adjusted.
+CREATE TABLE RESULT_STATISTICS (
+	id BIGINT PRIMARY KEY GENERATED ALWAYS AS IDENTITY,
+	version INT NOT NULL,
+	results BIGINT NOT NULL,
+	labelled BIGINT NOT NULL,
+	correct BIGINT NOT NULL,
+	last_result TIMESTAMP,
+	FOREIGN KEY (version) REFERENCES VERSION (id)
+);
+
+CREATE INDEX result_statistics_version_index ON RESULT_STATISTICS (version);
+
+-- Outputs are validated against JSON schemas when results are created,
+-- so they can be compared semantically as JSONB.
+-- +goose StatementBegin
+CREATE OR REPLACE FUNCTION trigger_count_results()
+RETURNS TRIGGER AS $$
+BEGIN
+  INSERT INTO RESULT_STATISTICS (version, results, labelled, correct, last_result)
+  SELECT
+    version,
+    COUNT(id),
+    COUNT(id) FILTER (WHERE convert_from(true_output, 'UTF8')::jsonb <> 'null'::jsonb),
+    COUNT(id) FILTER (WHERE convert_from(true_output, 'UTF8')::jsonb <> 'null'::jsonb AND convert_from(output, 'UTF8')::jsonb = convert_from(true_output, 'UTF8')::jsonb),
+    MAX(time)
+  FROM new_results
+  GROUP BY version;
+  RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
+-- +goose StatementEnd
+
+CREATE TRIGGER count_results
+  AFTER INSERT ON RESULT
+  REFERENCING NEW TABLE AS new_results
+  FOR EACH STATEMENT
+  EXECUTE PROCEDURE trigger_count_results();
+
+INSERT INTO RESULT_STATISTICS (version, results, labelled, correct, last_result)
+SELECT
+  version,
+  COUNT(id),
+  COUNT(id) FILTER (WHERE convert_from(true_output, 'UTF8')::jsonb <> 'null'::jsonb),
+  COUNT(id) FILTER (WHERE convert_from(true_output, 'UTF8')::jsonb <> 'null'::jsonb AND convert_from(output, 'UTF8')::jsonb = convert_from(true_output, 'UTF8')::jsonb),
+  MAX(time)
+FROM RESULT
+GROUP BY version;
+
+-- +goose Down
+DROP TRIGGER IF EXISTS count_results ON RESULT;
+DROP FUNCTION IF EXISTS trigger_count_results();
+DROP TABLE IF EXISTS RESULT_STATISTICS;
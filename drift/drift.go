@@ -0,0 +1,332 @@
+// Package drift detects changes in the distribution of the fields
+// of the inputs and outputs of a model between two sets of results.
+package drift
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"math"
+	"sort"
+	"strconv"
+)
+
+// DefaultBins is the default number of bins used to discretize numeric fields.
+const DefaultBins = 10
+
+// MaxSamples is the maximum number of results of a window that are compared.
+// Larger windows are sampled, so that the memory used does not grow with the number of results.
+const MaxSamples = 10000
+
+// epsilon is the probability assigned to empty bins and unseen categories
+// so that the divergences remain finite.
+const epsilon = 1e-4
+
+// Kind describes how the values of a field are compared.
+type Kind string
+
+const (
+	// Numeric fields only hold JSON numbers.
+	Numeric Kind = "numeric"
+	// Categorical fields hold strings, booleans or a mix of kinds.
+	Categorical Kind = "categorical"
+)
+
+// Sample holds the observed values of a single field.
+type Sample struct {
+	// Numbers are the numeric values of the field.
+	Numbers []float64
+	// Categories are the non-numeric values of the field, e.g. strings and booleans.
+	Categories []string
+}
+
+// Count returns the number of observed values.
+func (s *Sample) Count() int {
+	return len(s.Numbers) + len(s.Categories)
+}
+
+// categories returns all values of the sample as categories.
+func (s *Sample) categories() []string {
+	c := make([]string, 0, s.Count())
+	c = append(c, s.Categories...)
+	for _, n := range s.Numbers {
+		c = append(c, strconv.FormatFloat(n, 'g', -1, 64))
+	}
+	return c
+}
+
+// Samples maps the paths of fields, e.g. "input.age", to their observed values.
+type Samples map[string]*Sample
+
+// Add extracts all scalar fields from the given JSON document and adds them
+// to the samples. The paths of the fields are prefixed with the given prefix;
+// object keys and array indices are separated by dots.
+// Null values are ignored.
+func (s Samples) Add(prefix string, doc []byte) error {
+	if len(doc) == 0 {
+		return nil
+	}
+	var v interface{}
+	d := json.NewDecoder(bytes.NewReader(doc))
+	d.UseNumber()
+	if err := d.Decode(&v); err != nil {
+		return fmt.Errorf("failed to decode %s: %w", prefix, err)
+	}
+	s.add(prefix, v)
+	return nil
+}
+
+// AddResult adds the fields of the input and output of a result to the samples.
+// The paths of the fields are prefixed with "input" and "output" respectively.
+func (s Samples) AddResult(input, output []byte) error {
+	if err := s.Add("input", input); err != nil {
+		return err
+	}
+	return s.Add("output", output)
+}
+
+func (s Samples) add(path string, v interface{}) {
+	switch t := v.(type) {
+	case map[string]interface{}:
+		for k, e := range t {
+			s.add(path+"."+k, e)
+		}
+	case []interface{}:
+		for i, e := range t {
+			s.add(path+"."+strconv.Itoa(i), e)
+		}
+	case json.Number:
+		f, err := t.Float64()
+		if err != nil {
+			s.sample(path).Categories = append(s.sample(path).Categories, t.String())
+			return
+		}
+		s.sample(path).Numbers = append(s.sample(path).Numbers, f)
+	case string:
+		s.sample(path).Categories = append(s.sample(path).Categories, t)
+	case bool:
+		s.sample(path).Categories = append(s.sample(path).Categories, strconv.FormatBool(t))
+	}
+}
+
+func (s Samples) sample(path string) *Sample {
+	if _, ok := s[path]; !ok {
+		s[path] = new(Sample)
+	}
+	return s[path]
+}
+
+// Field describes the drift of a single field.
+type Field struct {
+	// Name is the path of the field.
+	Name string
+	// Kind is the kind of the field.
+	Kind Kind
+	// Reference is the number of values of the field in the reference samples.
+	Reference int
+	// Current is the number of values of the field in the current samples.
+	Current int
+	// PSI is the population stability index of the current distribution
+	// with respect to the reference distribution.
+	PSI float64
+	// KL is the Kullback-Leibler divergence of the current distribution
+	// from the reference distribution.
+	KL float64
+	// KS is the result of the two-sample Kolmogorov-Smirnov test.
+	// It is only set for numeric fields.
+	KS *KS
+}
+
+// KS is the result of a two-sample Kolmogorov-Smirnov test.
+type KS struct {
+	// Statistic is the maximum distance between the empirical distribution functions.
+	Statistic float64
+	// PValue is the asymptotic probability of observing a statistic at least
+	// as large if both samples were drawn from the same distribution.
+	PValue float64
+}
+
+// Compare computes the drift of all fields that were observed in both
+// the reference and the current samples. Numeric fields are discretized
+// into the given number of bins based on the quantiles of the reference values.
+// The fields are sorted by name.
+func Compare(reference, current Samples, bins int) []Field {
+	if bins < 1 {
+		bins = DefaultBins
+	}
+	fields := make([]Field, 0, len(reference))
+	for name, r := range reference {
+		c, ok := current[name]
+		if !ok || r.Count() == 0 || c.Count() == 0 {
+			continue
+		}
+		f := Field{
+			Name:      name,
+			Reference: r.Count(),
+			Current:   c.Count(),
+		}
+		var p, q []float64
+		if len(r.Categories) == 0 && len(c.Categories) == 0 {
+			f.Kind = Numeric
+			p, q = histograms(r.Numbers, c.Numbers, bins)
+			statistic, pValue := KolmogorovSmirnov(r.Numbers, c.Numbers)
+			f.KS = &KS{Statistic: statistic, PValue: pValue}
+		} else {
+			f.Kind = Categorical
+			p, q = frequencies(r.categories(), c.categories())
+		}
+		f.PSI = PSI(p, q)
+		f.KL = KL(q, p)
+		fields = append(fields, f)
+	}
+	sort.Slice(fields, func(i, j int) bool {
+		return fields[i].Name < fields[j].Name
+	})
+	return fields
+}
+
+// PSI returns the population stability index of the distribution q
+// with respect to the distribution p. Both distributions must have
+// the same support and no zero probabilities.
+func PSI(p, q []float64) float64 {
+	var psi float64
+	for i := range p {
+		psi += (q[i] - p[i]) * math.Log(q[i]/p[i])
+	}
+	return psi
+}
+
+// KL returns the Kullback-Leibler divergence of the distribution p
+// from the distribution q. Both distributions must have the same support
+// and q must not have zero probabilities.
+func KL(p, q []float64) float64 {
+	var kl float64
+	for i := range p {
+		if p[i] == 0 {
+			continue
+		}
+		kl += p[i] * math.Log(p[i]/q[i])
+	}
+	return kl
+}
+
+// KolmogorovSmirnov returns the statistic and the asymptotic p-value
+// of the two-sample Kolmogorov-Smirnov test for the given samples.
+func KolmogorovSmirnov(a, b []float64) (float64, float64) {
+	if len(a) == 0 || len(b) == 0 {
+		return 0, 1
+	}
+	a = sorted(a)
+	b = sorted(b)
+
+	var d float64
+	var i, j int
+	for i < len(a) && j < len(b) {
+		x := math.Min(a[i], b[j])
+		for i < len(a) && a[i] <= x {
+			i++
+		}
+		for j < len(b) && b[j] <= x {
+			j++
+		}
+		d = math.Max(d, math.Abs(float64(i)/float64(len(a))-float64(j)/float64(len(b))))
+	}
+
+	n := float64(len(a)) * float64(len(b)) / float64(len(a)+len(b))
+	en := math.Sqrt(n)
+	return d, kolmogorov((en + 0.12 + 0.11/en) * d)
+}
+
+// kolmogorov returns the complementary cumulative distribution function
+// of the Kolmogorov distribution at lambda.
+func kolmogorov(lambda float64) float64 {
+	if lambda < 1e-3 {
+		return 1
+	}
+	var sum, previous float64
+	sign := 1.0
+	for k := 1; k <= 100; k++ {
+		term := sign * 2 * math.Exp(-2*float64(k*k)*lambda*lambda)
+		sum += term
+		if math.Abs(term) <= 1e-10*math.Abs(previous) || math.Abs(term) <= 1e-16*sum {
+			return math.Min(math.Max(sum, 0), 1)
+		}
+		sign = -sign
+		previous = term
+	}
+	return 1
+}
+
+// histograms discretizes both samples into at most the given number of bins
+// with edges at the quantiles of the reference sample and returns
+// the smoothed relative frequencies of the bins.
+func histograms(reference, current []float64, bins int) ([]float64, []float64) {
+	r := sorted(reference)
+	edges := make([]float64, 0, bins-1)
+	for i := 1; i < bins; i++ {
+		e := r[i*len(r)/bins]
+		if len(edges) == 0 || e > edges[len(edges)-1] {
+			edges = append(edges, e)
+		}
+	}
+	p := make([]float64, len(edges)+1)
+	q := make([]float64, len(edges)+1)
+	for _, v := range reference {
+		p[bin(edges, v)]++
+	}
+	for _, v := range current {
+		q[bin(edges, v)]++
+	}
+	return normalize(p), normalize(q)
+}
+
+// bin returns the index of the bin that contains the given value.
+// Bins are closed on the left.
+func bin(edges []float64, v float64) int {
+	i := sort.SearchFloat64s(edges, v)
+	if i < len(edges) && edges[i] == v {
+		return i + 1
+	}
+	return i
+}
+
+// frequencies returns the smoothed relative frequencies of the categories
+// of both samples over the union of their categories.
+func frequencies(reference, current []string) ([]float64, []float64) {
+	index := make(map[string]int)
+	for _, s := range [][]string{reference, current} {
+		for _, c := range s {
+			if _, ok := index[c]; !ok {
+				index[c] = len(index)
+			}
+		}
+	}
+	p := make([]float64, len(index))
+	q := make([]float64, len(index))
+	for _, c := range reference {
+		p[index[c]]++
+	}
+	for _, c := range current {
+		q[index[c]]++
+	}
+	return normalize(p), normalize(q)
+}
+
+// normalize turns counts into relative frequencies, replacing zero
+// frequencies with a small probability.
+func normalize(counts []float64) []float64 {
+	var total float64
+	for _, c := range counts {
+		total += c
+	}
+	for i := range counts {
+		counts[i] = math.Max(counts[i]/total, epsilon)
+	}
+	return counts
+}
+
+func sorted(s []float64) []float64 {
+	c := append([]float64{}, s...)
+	sort.Float64s(c)
+	return c
+}
@@ -0,0 +1,83 @@
+package drift
+
+import (
+	"math"
+	"testing"
+
+	"github.com/efficientgo/core/testutil"
+)
+
+func TestSamplesAdd(t *testing.T) {
+	s := make(Samples)
+	testutil.Ok(t, s.Add("input", []byte(`{"age": 42, "country": "de", "vip": true, "scores": [1, 2], "note": null}`)))
+	testutil.Ok(t, s.Add("output", []byte(`"cat"`)))
+	testutil.Ok(t, s.Add("output", nil))
+	testutil.Equals(t, Samples{
+		"input.age":      {Numbers: []float64{42}},
+		"input.country":  {Categories: []string{"de"}},
+		"input.vip":      {Categories: []string{"true"}},
+		"input.scores.0": {Numbers: []float64{1}},
+		"input.scores.1": {Numbers: []float64{2}},
+		"output":         {Categories: []string{"cat"}},
+	}, s)
+	testutil.NotOk(t, s.Add("input", []byte(`{`)))
+}
+
+func TestKolmogorovSmirnov(t *testing.T) {
+	a := make([]float64, 0, 100)
+	b := make([]float64, 0, 100)
+	for i := 0; i < 100; i++ {
+		a = append(a, float64(i))
+		b = append(b, float64(i+50))
+	}
+
+	d, p := KolmogorovSmirnov(a, a)
+	testutil.Equals(t, 0.0, d)
+	testutil.Equals(t, 1.0, p)
+
+	d, p = KolmogorovSmirnov(a, b)
+	testutil.Equals(t, 0.5, d)
+	testutil.Assert(t, p < 1e-9, "expected a tiny p-value, got %v", p)
+}
+
+func TestCompare(t *testing.T) {
+	reference := make(Samples)
+	same := make(Samples)
+	shifted := make(Samples)
+	for i := 0; i < 1000; i++ {
+		reference.sample("input.x").Numbers = append(reference.sample("input.x").Numbers, float64(i%100))
+		same.sample("input.x").Numbers = append(same.sample("input.x").Numbers, float64(i%100))
+		shifted.sample("input.x").Numbers = append(shifted.sample("input.x").Numbers, float64(i%100+30))
+
+		category := "a"
+		if i%4 == 0 {
+			category = "b"
+		}
+		reference.sample("output").Categories = append(reference.sample("output").Categories, category)
+		same.sample("output").Categories = append(same.sample("output").Categories, category)
+		shifted.sample("output").Categories = append(shifted.sample("output").Categories, "c")
+	}
+	reference.sample("input.only-reference").Numbers = []float64{1}
+
+	fields := Compare(reference, same, DefaultBins)
+	testutil.Equals(t, 2, len(fields))
+	testutil.Equals(t, "input.x", fields[0].Name)
+	testutil.Equals(t, Numeric, fields[0].Kind)
+	testutil.Equals(t, "output", fields[1].Name)
+	testutil.Equals(t, Categorical, fields[1].Kind)
+	testutil.Assert(t, fields[1].KS == nil, "expected no KS test for categorical fields")
+	for _, f := range fields {
+		testutil.Assert(t, math.Abs(f.PSI) < 1e-9, "expected no drift of %s, got PSI %v", f.Name, f.PSI)
+		testutil.Assert(t, math.Abs(f.KL) < 1e-9, "expected no drift of %s, got KL %v", f.Name, f.KL)
+	}
+	testutil.Equals(t, 1.0, fields[0].KS.PValue)
+
+	fields = Compare(reference, shifted, DefaultBins)
+	testutil.Equals(t, 2, len(fields))
+	for _, f := range fields {
+		testutil.Assert(t, f.PSI > 0.25, "expected significant drift of %s, got PSI %v", f.Name, f.PSI)
+		testutil.Assert(t, f.KL > 0.1, "expected significant drift of %s, got KL %v", f.Name, f.KL)
+	}
+	testutil.Assert(t, math.Abs(fields[0].KS.Statistic-0.3) < 1e-9, "expected a KS statistic of 0.3, got %v", fields[0].KS.Statistic)
+	testutil.Assert(t, fields[0].KS.PValue < 0.01, "expected a small p-value, got %v", fields[0].KS.PValue)
+}
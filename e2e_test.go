@@ -9,6 +9,7 @@ import (
 	"net/http"
 	"os"
 	"os/exec"
+	"strings"
 	"testing"
 
 	"github.com/efficientgo/core/testutil"
@@ -151,7 +152,7 @@ func TestE2E(t *testing.T) {
 			name: "create result",
 			requests: []request{
 				{
-					request: mustRequest(v1alpha1.NewResultsCreateForVersionRequest(server, "foo", "bar", "qux", v1alpha1.ResultsCreateForVersionJSONRequestBody{Input: input, Output: output, TrueOutput: output})),
+					request: mustRequest(v1alpha1.NewResultsCreateForVersionRequest(server, "foo", "bar", "qux", nil, v1alpha1.ResultsCreateForVersionJSONRequestBody{Input: input, Output: output, TrueOutput: output})),
 					status:  201,
 				},
 			},
@@ -160,27 +161,266 @@ func TestE2E(t *testing.T) {
 			name: "invalid result",
 			requests: []request{
 				{
-					request: mustRequest(v1alpha1.NewResultsCreateForVersionRequest(server, "foo", "bar", "qux", v1alpha1.ResultsCreateForVersionJSONRequestBody{Input: []byte(`"cat"`), Output: output, TrueOutput: output})),
+					request: mustRequest(v1alpha1.NewResultsCreateForVersionRequest(server, "foo", "bar", "qux", nil, v1alpha1.ResultsCreateForVersionJSONRequestBody{Input: []byte(`"cat"`), Output: output, TrueOutput: output})),
 					status:  422,
 				},
 				{
-					request: mustRequest(v1alpha1.NewResultsCreateForVersionRequest(server, "foo", "bar", "qux", v1alpha1.ResultsCreateForVersionJSONRequestBody{Input: input, Output: []byte(`"cat"`), TrueOutput: output})),
+					request: mustRequest(v1alpha1.NewResultsCreateForVersionRequest(server, "foo", "bar", "qux", nil, v1alpha1.ResultsCreateForVersionJSONRequestBody{Input: input, Output: []byte(`"cat"`), TrueOutput: output})),
 					status:  422,
 				},
 				{
-					request: mustRequest(v1alpha1.NewResultsCreateForVersionRequest(server, "foo", "bar", "qux", v1alpha1.ResultsCreateForVersionJSONRequestBody{Input: input, Output: output, TrueOutput: []byte(`"cat"`)})),
+					request: mustRequest(v1alpha1.NewResultsCreateForVersionRequest(server, "foo", "bar", "qux", nil, v1alpha1.ResultsCreateForVersionJSONRequestBody{Input: input, Output: output, TrueOutput: []byte(`"cat"`)})),
 					status:  422,
 				},
 				{
-					request: mustRequest(v1alpha1.NewResultsCreateForVersionRequest(server, "nonexistent-organization", "bar", "qux", v1alpha1.ResultsCreateForVersionJSONRequestBody{Input: input, Output: output, TrueOutput: output})),
+					request: mustRequest(v1alpha1.NewResultsCreateForVersionRequest(server, "nonexistent-organization", "bar", "qux", nil, v1alpha1.ResultsCreateForVersionJSONRequestBody{Input: input, Output: output, TrueOutput: output})),
 					status:  404,
 				},
 				{
-					request: mustRequest(v1alpha1.NewResultsCreateForVersionRequest(server, "foo", "nonexistent-model", "qux", v1alpha1.ResultsCreateForVersionJSONRequestBody{Input: input, Output: output, TrueOutput: output})),
+					request: mustRequest(v1alpha1.NewResultsCreateForVersionRequest(server, "foo", "nonexistent-model", "qux", nil, v1alpha1.ResultsCreateForVersionJSONRequestBody{Input: input, Output: output, TrueOutput: output})),
 					status:  404,
 				},
 				{
-					request: mustRequest(v1alpha1.NewResultsCreateForVersionRequest(server, "foo", "bar", "nonexistent-version", v1alpha1.ResultsCreateForVersionJSONRequestBody{Input: input, Output: output, TrueOutput: output})),
+					request: mustRequest(v1alpha1.NewResultsCreateForVersionRequest(server, "foo", "bar", "nonexistent-version", nil, v1alpha1.ResultsCreateForVersionJSONRequestBody{Input: input, Output: output, TrueOutput: output})),
+					status:  404,
+				},
+			},
+		},
+		{
+			name: "bulk results",
+			requests: []request{
+				{
+					request: mustRequest(v1alpha1.NewResultsCreateBulkForVersionRequest(server, "foo", "bar", "qux", nil, v1alpha1.ResultsCreateBulkForVersionJSONRequestBody{{Input: input, Output: output, TrueOutput: output}, {Input: input, Output: output, TrueOutput: output}})),
+					status:  201,
+				},
+				{
+					request: mustRequest(v1alpha1.NewResultsCreateBulkForVersionRequest(server, "foo", "bar", "qux", nil, v1alpha1.ResultsCreateBulkForVersionJSONRequestBody{{Input: input, Output: output, TrueOutput: output}, {Input: []byte(`"cat"`), Output: output, TrueOutput: output}})),
+					status:  422,
+				},
+				{
+					request: mustRequest(v1alpha1.NewResultsCreateBulkForVersionRequestWithBody(server, "foo", "bar", "qux", nil, "application/json", strings.NewReader(`{}`))),
+					status:  422,
+				},
+				{
+					request: mustRequest(v1alpha1.NewResultsCreateBulkForVersionRequest(server, "foo", "nonexistent-model", "qux", nil, v1alpha1.ResultsCreateBulkForVersionJSONRequestBody{{Input: input, Output: output, TrueOutput: output}})),
+					status:  404,
+				},
+				{
+					request: mustRequest(v1alpha1.NewResultsCreateForVersionRequestWithBody(server, "foo", "bar", "qux", nil, "application/json", strings.NewReader(`{"input": "`+strings.Repeat("a", 2<<20)+`"}`))),
+					status:  413,
+				},
+			},
+		},
+		{
+			name: "idempotent results",
+			requests: []request{
+				{
+					request: mustRequest(v1alpha1.NewResultsCreateForVersionRequest(server, "foo", "bar", "qux", &v1alpha1.ResultsCreateForVersionParams{IdempotencyKey: stringPointer("retried")}, v1alpha1.ResultsCreateForVersionJSONRequestBody{Input: input, Output: output, TrueOutput: output})),
+					status:  201,
+				},
+				{
+					request: mustRequest(v1alpha1.NewResultsCreateForVersionRequest(server, "foo", "bar", "qux", &v1alpha1.ResultsCreateForVersionParams{IdempotencyKey: stringPointer("retried")}, v1alpha1.ResultsCreateForVersionJSONRequestBody{Input: input, Output: output, TrueOutput: output})),
+					status:  201,
+				},
+				{
+					request: mustRequest(v1alpha1.NewResultsCreateForVersionRequest(server, "foo", "bar", "qux", &v1alpha1.ResultsCreateForVersionParams{IdempotencyKey: stringPointer("retried")}, v1alpha1.ResultsCreateForVersionJSONRequestBody{Input: input, Output: output, TrueOutput: input})),
+					status:  422,
+				},
+				{
+					request: mustRequest(v1alpha1.NewResultsCreateForVersionRequest(server, "foo", "bar", "qux", nil, v1alpha1.ResultsCreateForVersionJSONRequestBody{Input: input, Output: output, TrueOutput: output, ClientID: stringPointer("result-1")})),
+					status:  201,
+				},
+				{
+					request: mustRequest(v1alpha1.NewResultsCreateForVersionRequest(server, "foo", "bar", "qux", nil, v1alpha1.ResultsCreateForVersionJSONRequestBody{Input: input, Output: output, TrueOutput: output, ClientID: stringPointer("result-1")})),
+					status:  201,
+				},
+				{
+					request: mustRequest(v1alpha1.NewResultsCreateBulkForVersionRequest(server, "foo", "bar", "qux", &v1alpha1.ResultsCreateBulkForVersionParams{IdempotencyKey: stringPointer("retried-bulk")}, v1alpha1.ResultsCreateBulkForVersionJSONRequestBody{{Input: input, Output: output, TrueOutput: output, ClientID: stringPointer("result-1")}, {Input: input, Output: output, TrueOutput: output, ClientID: stringPointer("result-2")}})),
+					status:  201,
+				},
+				{
+					request: mustRequest(v1alpha1.NewResultsCreateBulkForVersionRequest(server, "foo", "bar", "qux", &v1alpha1.ResultsCreateBulkForVersionParams{IdempotencyKey: stringPointer("retried-bulk")}, v1alpha1.ResultsCreateBulkForVersionJSONRequestBody{{Input: input, Output: output, TrueOutput: output, ClientID: stringPointer("result-1")}, {Input: input, Output: output, TrueOutput: output, ClientID: stringPointer("result-2")}})),
+					status:  201,
+				},
+			},
+		},
+		{
+			name: "labelled results",
+			requests: []request{
+				{
+					request: mustRequest(v1alpha1.NewResultsCreateForVersionRequest(server, "foo", "bar", "qux", nil, v1alpha1.ResultsCreateForVersionJSONRequestBody{Input: input, Output: output, TrueOutput: output, Labels: &v1alpha1.Labels{"region": "eu"}})),
+					status:  201,
+				},
+				{
+					request: mustRequest(v1alpha1.NewResultsCreateForVersionRequest(server, "foo", "bar", "qux", nil, v1alpha1.ResultsCreateForVersionJSONRequestBody{Input: input, Output: output, TrueOutput: output, Labels: &v1alpha1.Labels{"-invalid": "eu"}})),
+					status:  422,
+				},
+				{
+					request: mustRequest(v1alpha1.NewResultsListForVersionRequest(server, "foo", "bar", "qux", &v1alpha1.ResultsListForVersionParams{LabelSelector: stringPointer("region in (eu,us),!experimental")})),
+					status:  200,
+				},
+				{
+					request: mustRequest(v1alpha1.NewResultsListForVersionRequest(server, "foo", "bar", "qux", &v1alpha1.ResultsListForVersionParams{LabelSelector: stringPointer("region in (eu")})),
+					status:  422,
+				},
+			},
+		},
+		{
+			name: "stages",
+			requests: []request{
+				{
+					request: mustRequest(v1alpha1.NewStagesRollbackForModelRequest(server, "foo", "bar", "production")),
+					status:  404,
+				},
+				{
+					request: mustRequest(v1alpha1.NewResultsCreateForModelRequest(server, "foo", "bar", &v1alpha1.ResultsCreateForModelParams{Stage: "production"}, v1alpha1.ResultsCreateForModelJSONRequestBody{Input: input, Output: output, TrueOutput: output})),
+					status:  404,
+				},
+				{
+					request: mustRequest(v1alpha1.NewStagesPromoteForModelRequest(server, "foo", "bar", "production", v1alpha1.StagesPromoteForModelJSONRequestBody{Version: "qux"})),
+					status:  200,
+				},
+				{
+					request: mustRequest(v1alpha1.NewStagesRollbackForModelRequest(server, "foo", "bar", "production")),
+					status:  409,
+				},
+				{
+					request: mustRequest(v1alpha1.NewResultsCreateForModelRequest(server, "foo", "bar", &v1alpha1.ResultsCreateForModelParams{Stage: "production"}, v1alpha1.ResultsCreateForModelJSONRequestBody{Input: input, Output: output, TrueOutput: output})),
+					status:  201,
+				},
+				{
+					request: mustRequest(v1alpha1.NewResultsListForModelRequest(server, "foo", "bar", &v1alpha1.ResultsListForModelParams{Stage: "production"})),
+					status:  200,
+				},
+				{
+					request: mustRequest(v1alpha1.NewStagesPromoteForModelRequest(server, "foo", "bar", "production", v1alpha1.StagesPromoteForModelJSONRequestBody{Version: "nonexistent-version"})),
+					status:  404,
+				},
+				{
+					request: mustRequest(v1alpha1.NewStagesHistoryForModelRequest(server, "foo", "bar", "production")),
+					status:  200,
+				},
+			},
+		},
+		{
+			name: "shadow evaluation",
+			requests: []request{
+				{
+					request: mustRequest(v1alpha1.NewResultsCreateForVersionRequest(server, "foo", "bar", "qux", nil, v1alpha1.ResultsCreateForVersionJSONRequestBody{Input: input, Output: output, TrueOutput: output, CorrelationID: stringPointer("request-1")})),
+					status:  201,
+				},
+				{
+					request: mustRequest(v1alpha1.NewResultsCreateForVersionRequest(server, "foo", "bar", "challenger", nil, v1alpha1.ResultsCreateForVersionJSONRequestBody{Input: input, Output: output, TrueOutput: output, CorrelationID: stringPointer("request-1")})),
+					status:  201,
+				},
+				{
+					request: mustRequest(v1alpha1.NewMetricsGetForVersionRequest(server, "foo", "bar", "qux", &v1alpha1.MetricsGetForVersionParams{})),
+					status:  200,
+				},
+				{
+					request: mustRequest(v1alpha1.NewMetricsGetForModelRequest(server, "foo", "bar", &v1alpha1.MetricsGetForModelParams{Stage: "production"})),
+					status:  200,
+				},
+				{
+					request: mustRequest(v1alpha1.NewComparisonGetForModelRequest(server, "foo", "bar", &v1alpha1.ComparisonGetForModelParams{Champion: "qux", Challenger: "challenger"})),
+					status:  200,
+				},
+				{
+					request: mustRequest(v1alpha1.NewComparisonGetForModelRequest(server, "foo", "bar", &v1alpha1.ComparisonGetForModelParams{Champion: "qux", Challenger: "nonexistent-version"})),
+					status:  404,
+				},
+				{
+					request: mustRequest(v1alpha1.NewDriftGetForVersionRequest(server, "foo", "bar", "challenger", &v1alpha1.DriftGetForVersionParams{Reference: stringPointer("qux")})),
+					status:  200,
+				},
+				{
+					request: mustRequest(v1alpha1.NewDriftGetForVersionRequest(server, "foo", "bar", "qux", &v1alpha1.DriftGetForVersionParams{Reference: stringPointer("nonexistent-version")})),
+					status:  404,
+				},
+				{
+					request: mustRequest(v1alpha1.NewDriftGetForVersionRequest(server, "foo", "bar", "qux", &v1alpha1.DriftGetForVersionParams{Bins: intPointer(0)})),
+					status:  422,
+				},
+			},
+		},
+		{
+			name: "alert rules",
+			requests: []request{
+				{
+					request: mustRequest(v1alpha1.NewAlertRulesCreateForModelRequest(server, "foo", "bar", v1alpha1.AlertRulesCreateForModelJSONRequestBody{Name: "LowAccuracy", Stage: "production", Metric: "accuracy", Operator: "<", Threshold: 0.9, Window: "24h"})),
+					status:  201,
+				},
+				{
+					request: mustRequest(v1alpha1.NewAlertRulesCreateForModelRequest(server, "foo", "bar", v1alpha1.AlertRulesCreateForModelJSONRequestBody{Name: "InputDrift", Stage: "production", Metric: "psi", Field: stringPointer("input"), Operator: ">", Threshold: 0.2, Window: "1h"})),
+					status:  201,
+				},
+				{
+					request: mustRequest(v1alpha1.NewAlertRulesCreateForModelRequest(server, "foo", "bar", v1alpha1.AlertRulesCreateForModelJSONRequestBody{Name: "invalid-metric", Stage: "production", Metric: "precision", Operator: "<", Threshold: 0.9, Window: "24h"})),
+					status:  422,
+				},
+				{
+					request: mustRequest(v1alpha1.NewAlertRulesCreateForModelRequest(server, "foo", "bar", v1alpha1.AlertRulesCreateForModelJSONRequestBody{Name: "invalid-window", Stage: "production", Metric: "accuracy", Operator: "<", Threshold: 0.9, Window: "a day"})),
+					status:  422,
+				},
+				{
+					request: mustRequest(v1alpha1.NewAlertRulesCreateForModelRequest(server, "foo", "nonexistent-model", v1alpha1.AlertRulesCreateForModelJSONRequestBody{Name: "LowAccuracy", Stage: "production", Metric: "accuracy", Operator: "<", Threshold: 0.9, Window: "24h"})),
+					status:  404,
+				},
+				{
+					request: mustRequest(v1alpha1.NewAlertRulesListForModelRequest(server, "foo", "bar")),
+					status:  200,
+				},
+				{
+					request: mustRequest(v1alpha1.NewAlertRulesGetForModelRequest(server, "foo", "bar", "LowAccuracy", nil)),
+					status:  200,
+				},
+				{
+					request: mustRequest(v1alpha1.NewAlertRulesDeleteForModelRequest(server, "foo", "bar", "InputDrift", nil)),
+					status:  204,
+				},
+				{
+					request: mustRequest(v1alpha1.NewAlertRulesGetForModelRequest(server, "foo", "bar", "InputDrift", nil)),
+					status:  404,
+				},
+			},
+		},
+		{
+			name: "webhooks",
+			requests: []request{
+				{
+					request: mustRequest(v1alpha1.NewWebhooksCreateForOrganizationRequest(server, "foo", v1alpha1.WebhooksCreateForOrganizationJSONRequestBody{Name: "ci", URL: "http://ci.example.com/hooks", Secret: "secret", Events: &[]v1alpha1.EventType{"version.created"}})),
+					status:  201,
+				},
+				{
+					request: mustRequest(v1alpha1.NewWebhooksCreateForOrganizationRequest(server, "foo", v1alpha1.WebhooksCreateForOrganizationJSONRequestBody{Name: "invalid-url", URL: "ftp://ci.example.com", Secret: "secret"})),
+					status:  422,
+				},
+				{
+					request: mustRequest(v1alpha1.NewWebhooksCreateForOrganizationRequest(server, "foo", v1alpha1.WebhooksCreateForOrganizationJSONRequestBody{Name: "invalid-event", URL: "http://ci.example.com/hooks", Secret: "secret", Events: &[]v1alpha1.EventType{"model.deleted"}})),
+					status:  422,
+				},
+				{
+					request: mustRequest(v1alpha1.NewWebhooksCreateForOrganizationRequest(server, "nonexistent-organization", v1alpha1.WebhooksCreateForOrganizationJSONRequestBody{Name: "ci", URL: "http://ci.example.com/hooks", Secret: "secret"})),
+					status:  404,
+				},
+				{
+					request: mustRequest(v1alpha1.NewVersionsCreateForModelRequest(server, "foo", "bar", v1alpha1.VersionsCreateForModelJSONRequestBody{Name: "with-webhook", Schema: 1})),
+					status:  201,
+				},
+				{
+					request: mustRequest(v1alpha1.NewWebhooksListForOrganizationRequest(server, "foo")),
+					status:  200,
+				},
+				{
+					request: mustRequest(v1alpha1.NewWebhooksDeliveriesForOrganizationRequest(server, "foo", "ci", &v1alpha1.WebhooksDeliveriesForOrganizationParams{})),
+					status:  200,
+				},
+				{
+					request: mustRequest(v1alpha1.NewWebhooksDeleteForOrganizationRequest(server, "foo", "ci", nil)),
+					status:  204,
+				},
+				{
+					request: mustRequest(v1alpha1.NewWebhooksGetForOrganizationRequest(server, "foo", "ci", nil)),
 					status:  404,
 				},
 			},
@@ -194,16 +434,37 @@ func TestE2E(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "version metadata",
+			requests: []request{
+				{
+					request: mustRequest(v1alpha1.NewVersionsCreateForModelRequest(server, "foo", "bar", v1alpha1.VersionsCreateForModelJSONRequestBody{Name: "with-metadata", Schema: 1, Commit: stringPointer("8f14e45f"), Labels: &v1alpha1.Labels{"team": "vision"}})),
+					status:  201,
+				},
+				{
+					request: mustRequest(v1alpha1.NewVersionsUpdateForModelRequest(server, "foo", "bar", "with-metadata", nil, v1alpha1.VersionsUpdateForModelJSONRequestBody{Description: stringPointer("Retrained"), ArtifactURI: stringPointer("s3://bucket/model"), Hyperparameters: rawMessagePointer(`{"epochs":10}`)})),
+					status:  200,
+				},
+				{
+					request: mustRequest(v1alpha1.NewVersionsUpdateForModelRequest(server, "foo", "bar", "nonexistent-version", nil, v1alpha1.VersionsUpdateForModelJSONRequestBody{Description: stringPointer("Retrained")})),
+					status:  404,
+				},
+				{
+					request: mustRequest(v1alpha1.NewVersionsListForModelRequest(server, "foo", "bar", &v1alpha1.VersionsListForModelParams{LabelSelector: stringPointer("team=vision")})),
+					status:  200,
+				},
+			},
+		},
 		{
 			name: "invalid version",
 			requests: []request{
 				{
 					request: mustRequest(v1alpha1.NewVersionsCreateForModelRequest(server, "foo", "bar", v1alpha1.VersionsCreateForModelJSONRequestBody{Name: "qux", Schema: 1})),
-					status:  500,
+					status:  409,
 				},
 				{
 					request: mustRequest(v1alpha1.NewVersionsCreateForModelRequest(server, "foo", "bar", v1alpha1.VersionsCreateForModelJSONRequestBody{Name: "nonexistent-schema", Schema: 311})),
-					status:  500,
+					status:  422,
 				},
 				{
 					request: mustRequest(v1alpha1.NewVersionsCreateForModelRequest(server, "nonexistent-organization", "bar", v1alpha1.VersionsCreateForModelJSONRequestBody{Name: "ok", Schema: 1})),
@@ -229,7 +490,7 @@ func TestE2E(t *testing.T) {
 			requests: []request{
 				{
 					request: mustRequest(v1alpha1.NewSchemasCreateForOrganizationRequest(server, "foo", v1alpha1.SchemasCreateForOrganizationJSONRequestBody{Name: "baz", Input: inputSchema, Output: outputSchema})),
-					status:  500,
+					status:  409,
 				},
 				{
 					request: mustRequest(v1alpha1.NewSchemasCreateForOrganizationRequest(server, "foo", v1alpha1.SchemasCreateForOrganizationJSONRequestBody{Name: "invalidinput", Input: []byte("1"), Output: outputSchema})),
@@ -259,7 +520,19 @@ func TestE2E(t *testing.T) {
 			requests: []request{
 				{
 					request: mustRequest(v1alpha1.NewModelsCreateForOrganizationRequest(server, "foo", v1alpha1.ModelsCreateForOrganizationJSONRequestBody{Name: "bar"})),
-					status:  500,
+					status:  409,
+				},
+				{
+					request: mustRequest(v1alpha1.NewModelsCreateForOrganizationRequestWithBody(server, "foo", "application/json", strings.NewReader(`{"name": "malformed"`))),
+					status:  400,
+				},
+				{
+					request: mustRequest(v1alpha1.NewModelsCreateForOrganizationRequestWithBody(server, "foo", "application/json", strings.NewReader(`{"name": 1}`))),
+					status:  422,
+				},
+				{
+					request: mustRequest(v1alpha1.NewModelsCreateForOrganizationRequest(server, "foo", v1alpha1.ModelsCreateForOrganizationJSONRequestBody{Name: "nonexistent-default-schema", DefaultSchema: intPointer(311)})),
+					status:  422,
 				},
 				{
 					request: mustRequest(v1alpha1.NewModelsCreateForOrganizationRequest(server, "nonexistent-organization", v1alpha1.ModelsCreateForOrganizationJSONRequestBody{Name: "yup"})),
@@ -275,25 +548,102 @@ func TestE2E(t *testing.T) {
 					status:  201,
 				},
 				{
-					request: mustRequest(v1alpha1.NewResultsCreateForVersionRequest(server, "foo", "yup2", "nonexistent-version", v1alpha1.ResultsCreateForVersionJSONRequestBody{Input: input, Output: output, TrueOutput: output})),
+					request: mustRequest(v1alpha1.NewResultsCreateForVersionRequest(server, "foo", "yup2", "nonexistent-version", nil, v1alpha1.ResultsCreateForVersionJSONRequestBody{Input: input, Output: output, TrueOutput: output})),
 					status:  404,
 				},
 				{
-					request: mustRequest(v1alpha1.NewModelsUpdateForOrganizationRequest(server, "foo", "yup2", v1alpha1.ModelsUpdateForOrganizationJSONRequestBody{DefaultSchema: intPointer(1)})),
+					request: mustRequest(v1alpha1.NewModelsUpdateForOrganizationRequest(server, "foo", "yup2", nil, v1alpha1.ModelsUpdateForOrganizationJSONRequestBody{DefaultSchema: intPointer(1)})),
+					status:  200,
+				},
+				{
+					request: mustRequest(v1alpha1.NewResultsCreateForVersionRequest(server, "foo", "yup2", "nonexistent-version", nil, v1alpha1.ResultsCreateForVersionJSONRequestBody{Input: input, Output: output, TrueOutput: output})),
+					status:  201,
+				},
+			},
+		},
+		{
+			name: "patch",
+			requests: []request{
+				{
+					request: mustRequest(v1alpha1.NewModelsPatchForOrganizationRequestWithBody(server, "foo", "yup2", nil, "application/merge-patch+json", strings.NewReader(`{"description": "patched", "labels": {"team": "vision"}}`))),
+					status:  200,
+				},
+				{
+					request: mustRequest(v1alpha1.NewModelsPatchForOrganizationRequestWithBody(server, "foo", "yup2", nil, "application/merge-patch+json", strings.NewReader(`{"name": "renamed"}`))),
+					status:  400,
+				},
+				{
+					request: mustRequest(v1alpha1.NewModelsPatchForOrganizationRequestWithBody(server, "foo", "yup2", nil, "application/merge-patch+json", strings.NewReader(`{"defaultSchema": 311}`))),
+					status:  422,
+				},
+				{
+					request: mustRequest(v1alpha1.NewModelsPatchForOrganizationRequest(server, "foo", "yup2", nil, v1alpha1.ModelsPatchForOrganizationJSONRequestBody{Archived: boolPointer(true)})),
+					status:  200,
+				},
+				{
+					request: mustRequest(v1alpha1.NewResultsCreateForVersionRequest(server, "foo", "yup2", "nonexistent-version", nil, v1alpha1.ResultsCreateForVersionJSONRequestBody{Input: input, Output: output, TrueOutput: output})),
+					status:  409,
+				},
+				{
+					request: mustRequest(v1alpha1.NewModelsPatchForOrganizationRequestWithBody(server, "foo", "yup2", nil, "application/merge-patch+json", strings.NewReader(`{"archived": null}`))),
+					status:  200,
+				},
+				{
+					request: mustRequest(v1alpha1.NewVersionsPatchForModelRequest(server, "foo", "yup2", "nonexistent-version", nil, v1alpha1.VersionsPatchForModelJSONRequestBody{Archived: boolPointer(true), Stage: stringPointer("production")})),
+					status:  200,
+				},
+				{
+					request: mustRequest(v1alpha1.NewStagesGetForModelRequest(server, "foo", "yup2", "production", nil)),
+					status:  200,
+				},
+				{
+					request: mustRequest(v1alpha1.NewResultsCreateForVersionRequest(server, "foo", "yup2", "nonexistent-version", nil, v1alpha1.ResultsCreateForVersionJSONRequestBody{Input: input, Output: output, TrueOutput: output})),
+					status:  409,
+				},
+				{
+					request: mustRequest(v1alpha1.NewVersionsPatchForModelRequestWithBody(server, "foo", "yup2", "nonexistent-version", nil, "application/merge-patch+json", strings.NewReader(`{"archived": false}`))),
 					status:  200,
 				},
 				{
-					request: mustRequest(v1alpha1.NewResultsCreateForVersionRequest(server, "foo", "yup2", "nonexistent-version", v1alpha1.ResultsCreateForVersionJSONRequestBody{Input: input, Output: output, TrueOutput: output})),
+					request: mustRequest(v1alpha1.NewResultsCreateForVersionRequest(server, "foo", "yup2", "nonexistent-version", nil, v1alpha1.ResultsCreateForVersionJSONRequestBody{Input: input, Output: output, TrueOutput: output})),
 					status:  201,
 				},
 			},
 		},
+		{
+			name: "conditional requests",
+			requests: []request{
+				{
+					request: mustRequest(v1alpha1.NewModelsGetForOrganizationRequest(server, "foo", "yup2", &v1alpha1.ModelsGetForOrganizationParams{IfNoneMatch: stringPointer(`"0-0"`)})),
+					status:  200,
+				},
+				{
+					request: mustRequest(v1alpha1.NewModelsGetForOrganizationRequest(server, "foo", "yup2", &v1alpha1.ModelsGetForOrganizationParams{IfNoneMatch: stringPointer("*")})),
+					status:  304,
+				},
+				{
+					request: mustRequest(v1alpha1.NewModelsUpdateForOrganizationRequest(server, "foo", "yup2", &v1alpha1.ModelsUpdateForOrganizationParams{IfMatch: stringPointer(`"0-0"`)}, v1alpha1.ModelsUpdateForOrganizationJSONRequestBody{})),
+					status:  412,
+				},
+				{
+					request: mustRequest(v1alpha1.NewModelsUpdateForOrganizationRequest(server, "foo", "yup2", &v1alpha1.ModelsUpdateForOrganizationParams{IfMatch: stringPointer("*")}, v1alpha1.ModelsUpdateForOrganizationJSONRequestBody{DefaultSchema: intPointer(1)})),
+					status:  200,
+				},
+				{
+					request: mustRequest(v1alpha1.NewVersionsUpdateForModelRequest(server, "foo", "yup2", "nonexistent-version", &v1alpha1.VersionsUpdateForModelParams{IfMatch: stringPointer(`W/"0-0"`)}, v1alpha1.VersionsUpdateForModelJSONRequestBody{Description: stringPointer("Retrained")})),
+					status:  412,
+				},
+			},
+		},
 	} {
 		t.Run(tc.name, func(t *testing.T) {
 			for _, r := range tc.requests {
 				res, err := c.ClientInterface.(*v1alpha1.Client).Client.Do(r.request)
 				testutil.Ok(t, err)
 				testutil.Equals(t, r.status, res.StatusCode, "status should be %d, got %d with body:\n %s", r.status, res.StatusCode, mustBody(t, res.Body))
+				if res.StatusCode >= 400 {
+					testutil.Equals(t, "application/problem+json", res.Header.Get("Content-Type"))
+				}
 			}
 		})
 	}
@@ -365,3 +715,16 @@ var output = []byte(`{
 func intPointer(i int) *int {
 	return &i
 }
+
+func stringPointer(s string) *string {
+	return &s
+}
+
+func boolPointer(b bool) *bool {
+	return &b
+}
+
+func rawMessagePointer(s string) *json.RawMessage {
+	m := json.RawMessage(s)
+	return &m
+}
@@ -0,0 +1,154 @@
+// Package evaluation computes quality metrics from the results of model versions.
+package evaluation
+
+import (
+	"bytes"
+	"encoding/json"
+	"reflect"
+)
+
+// Summary describes the quality of a set of results.
+type Summary struct {
+	// Count is the number of results.
+	Count int
+	// Labelled is the number of results with a known true output.
+	Labelled int
+	// Correct is the number of labelled results whose output matches the true output.
+	Correct int
+}
+
+// Accuracy returns the fraction of labelled results that are correct.
+// It returns false if there are no labelled results.
+func (s Summary) Accuracy() (float64, bool) {
+	if s.Labelled == 0 {
+		return 0, false
+	}
+	return float64(s.Correct) / float64(s.Labelled), true
+}
+
+// Add accounts for a single result in the summary.
+func (s *Summary) Add(output, trueOutput []byte) {
+	s.Count++
+	if !IsLabelled(trueOutput) {
+		return
+	}
+	s.Labelled++
+	if Equal(output, trueOutput) {
+		s.Correct++
+	}
+}
+
+// IsLabelled returns true if the given true output is known,
+// i.e. it is neither empty nor JSON null.
+func IsLabelled(trueOutput []byte) bool {
+	t := bytes.TrimSpace(trueOutput)
+	return len(t) != 0 && !bytes.Equal(t, []byte("null"))
+}
+
+// Equal returns true if the given JSON documents are semantically equal,
+// i.e. they are equal regardless of whitespace and the order of object keys.
+func Equal(a, b []byte) bool {
+	if bytes.Equal(a, b) {
+		return true
+	}
+	var av, bv interface{}
+	if err := json.Unmarshal(a, &av); err != nil {
+		return false
+	}
+	if err := json.Unmarshal(b, &bv); err != nil {
+		return false
+	}
+	return reflect.DeepEqual(av, bv)
+}
+
+// Pair is a pair of results produced by a champion and a challenger version for the same request.
+type Pair struct {
+	ChampionOutput     []byte
+	ChampionTrueOutput []byte
+
+	ChallengerOutput     []byte
+	ChallengerTrueOutput []byte
+}
+
+// trueOutput returns the true output of the pair.
+// The champion's true output takes precedence since the challenger
+// usually runs in shadow mode and does not receive feedback.
+func (p Pair) trueOutput() []byte {
+	if IsLabelled(p.ChampionTrueOutput) {
+		return p.ChampionTrueOutput
+	}
+	return p.ChallengerTrueOutput
+}
+
+// Comparison describes a head-to-head comparison of a champion and a challenger version.
+type Comparison struct {
+	// Pairs is the number of paired results.
+	Pairs int
+	// Agreements is the number of pairs for which both versions produced the same output.
+	Agreements int
+	// ChampionWins is the number of labelled pairs for which only the champion was correct.
+	ChampionWins int
+	// ChallengerWins is the number of labelled pairs for which only the challenger was correct.
+	ChallengerWins int
+	// Champion summarizes the champion's paired results.
+	Champion Summary
+	// Challenger summarizes the challenger's paired results.
+	Challenger Summary
+}
+
+// Agreement returns the fraction of pairs for which both versions produced the same output.
+// It returns false if there are no pairs.
+func (c Comparison) Agreement() (float64, bool) {
+	if c.Pairs == 0 {
+		return 0, false
+	}
+	return float64(c.Agreements) / float64(c.Pairs), true
+}
+
+// WinRate returns the fraction of decided pairs that the challenger won.
+// It returns false if no pair was decided.
+func (c Comparison) WinRate() (float64, bool) {
+	decided := c.ChampionWins + c.ChallengerWins
+	if decided == 0 {
+		return 0, false
+	}
+	return float64(c.ChallengerWins) / float64(decided), true
+}
+
+// AccuracyDelta returns the challenger's accuracy minus the champion's accuracy.
+// It returns false if either accuracy is unknown.
+func (c Comparison) AccuracyDelta() (float64, bool) {
+	a, ok := c.Champion.Accuracy()
+	if !ok {
+		return 0, false
+	}
+	b, ok := c.Challenger.Accuracy()
+	if !ok {
+		return 0, false
+	}
+	return b - a, true
+}
+
+// Compare compares the paired results of a champion and a challenger version.
+func Compare(pairs []Pair) Comparison {
+	var c Comparison
+	for _, p := range pairs {
+		c.Pairs++
+		if Equal(p.ChampionOutput, p.ChallengerOutput) {
+			c.Agreements++
+		}
+		t := p.trueOutput()
+		c.Champion.Add(p.ChampionOutput, t)
+		c.Challenger.Add(p.ChallengerOutput, t)
+		if !IsLabelled(t) {
+			continue
+		}
+		switch champion, challenger := Equal(p.ChampionOutput, t), Equal(p.ChallengerOutput, t); {
+		case champion && !challenger:
+			c.ChampionWins++
+		case challenger && !champion:
+			c.ChallengerWins++
+		}
+	}
+	return c
+}
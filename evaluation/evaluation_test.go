@@ -0,0 +1,55 @@
+package evaluation
+
+import (
+	"testing"
+
+	"github.com/efficientgo/core/testutil"
+)
+
+func TestEqual(t *testing.T) {
+	testutil.Assert(t, Equal([]byte(`{"a":1,"b":[1,2]}`), []byte(`{ "b": [1, 2], "a": 1.0 }`)))
+	testutil.Assert(t, !Equal([]byte(`{"a":1}`), []byte(`{"a":2}`)))
+	testutil.Assert(t, !Equal([]byte(`{"a":1}`), []byte(`not json`)))
+}
+
+func TestSummary(t *testing.T) {
+	var s Summary
+	s.Add([]byte(`"cat"`), []byte(`"cat"`))
+	s.Add([]byte(`"cat"`), []byte(`"dog"`))
+	s.Add([]byte(`"cat"`), []byte(`null`))
+	testutil.Equals(t, Summary{Count: 3, Labelled: 2, Correct: 1}, s)
+	a, ok := s.Accuracy()
+	testutil.Assert(t, ok)
+	testutil.Equals(t, 0.5, a)
+
+	_, ok = Summary{Count: 1}.Accuracy()
+	testutil.Assert(t, !ok)
+}
+
+func TestCompare(t *testing.T) {
+	c := Compare([]Pair{
+		// Both correct.
+		{ChampionOutput: []byte(`1`), ChampionTrueOutput: []byte(`1`), ChallengerOutput: []byte(`1`)},
+		// Only the challenger is correct; the true output comes from the challenger.
+		{ChampionOutput: []byte(`0`), ChallengerOutput: []byte(`1`), ChallengerTrueOutput: []byte(`1`)},
+		// Only the challenger is correct.
+		{ChampionOutput: []byte(`0`), ChampionTrueOutput: []byte(`1`), ChallengerOutput: []byte(`1`)},
+		// Only the champion is correct.
+		{ChampionOutput: []byte(`1`), ChampionTrueOutput: []byte(`1`), ChallengerOutput: []byte(`0`)},
+		// Unlabelled disagreement.
+		{ChampionOutput: []byte(`1`), ChallengerOutput: []byte(`0`)},
+	})
+	testutil.Equals(t, 5, c.Pairs)
+	testutil.Equals(t, 1, c.Agreements)
+	testutil.Equals(t, 1, c.ChampionWins)
+	testutil.Equals(t, 2, c.ChallengerWins)
+	testutil.Equals(t, Summary{Count: 5, Labelled: 4, Correct: 2}, c.Champion)
+	testutil.Equals(t, Summary{Count: 5, Labelled: 4, Correct: 3}, c.Challenger)
+
+	w, ok := c.WinRate()
+	testutil.Assert(t, ok)
+	testutil.Equals(t, 2.0/3.0, w)
+	d, ok := c.AccuracyDelta()
+	testutil.Assert(t, ok)
+	testutil.Equals(t, 0.25, d)
+}
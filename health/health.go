@@ -0,0 +1,55 @@
+// Package health provides checks of the readiness of model-tracking to serve requests.
+package health
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync/atomic"
+	"time"
+
+	"github.com/metalmatze/signal/healthcheck"
+
+	"github.com/connylabs/model-tracking/store"
+)
+
+// MigrationCheck returns a check that fails unless the version of the latest migration
+// applied to the store is the expected version, i.e. until the database has been migrated
+// to the schema that the binary was built for.
+func MigrationCheck(migrations store.Migrations, expected int64, timeout time.Duration) healthcheck.Check {
+	return func() error {
+		ctx, cancel := context.WithTimeout(context.Background(), timeout)
+		defer cancel()
+		v, err := migrations.Version(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to get the migration version: %w", err)
+		}
+		if v != expected {
+			return fmt.Errorf("the database is at migration version %d but version %d is expected", v, expected)
+		}
+		return nil
+	}
+}
+
+// ErrShuttingDown is returned by the check of a Shutdown once shutdown has started.
+var ErrShuttingDown = errors.New("shutting down")
+
+// Shutdown tracks whether the process is shutting down.
+// Its check fails once shutdown has started so that load balancers
+// stop sending new requests before the servers stop accepting them.
+type Shutdown struct {
+	started atomic.Bool
+}
+
+// Start marks the start of the shutdown.
+func (s *Shutdown) Start() {
+	s.started.Store(true)
+}
+
+// Check fails if shutdown has started.
+func (s *Shutdown) Check() error {
+	if s.started.Load() {
+		return ErrShuttingDown
+	}
+	return nil
+}
@@ -0,0 +1,38 @@
+package health
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/efficientgo/core/testutil"
+)
+
+type fakeMigrations struct {
+	version int64
+	err     error
+}
+
+func (fm *fakeMigrations) Version(context.Context) (int64, error) {
+	return fm.version, fm.err
+}
+
+func TestMigrationCheck(t *testing.T) {
+	fm := &fakeMigrations{version: 20230720000000}
+	check := MigrationCheck(fm, 20230720000000, time.Second)
+	testutil.Ok(t, check())
+
+	fm.version = 20230710000000
+	testutil.NotOk(t, check())
+
+	fm.err = errors.New("relation \"goose_db_version\" does not exist")
+	testutil.NotOk(t, check())
+}
+
+func TestShutdown(t *testing.T) {
+	var s Shutdown
+	testutil.Ok(t, s.Check())
+	s.Start()
+	testutil.Equals(t, ErrShuttingDown, s.Check())
+}
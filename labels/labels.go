@@ -0,0 +1,315 @@
+// Package labels implements free-form string labels and
+// Kubernetes-style label selectors for filtering labelled resources.
+package labels
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+const (
+	maxNameLength   = 63
+	maxPrefixLength = 253
+	maxValueLength  = 63
+)
+
+var (
+	nameRegexp   = regexp.MustCompile(`^([A-Za-z0-9][-A-Za-z0-9_.]*)?[A-Za-z0-9]$`)
+	prefixRegexp = regexp.MustCompile(`^[a-z0-9]([-a-z0-9]*[a-z0-9])?(\.[a-z0-9]([-a-z0-9]*[a-z0-9])?)*$`)
+)
+
+// ValidateKey returns an error if the given label key is not valid.
+// Keys follow the Kubernetes convention of an optional DNS subdomain prefix
+// followed by a slash and a name of at most 63 characters.
+func ValidateKey(k string) error {
+	name := k
+	if i := strings.LastIndex(k, "/"); i >= 0 {
+		prefix := k[:i]
+		name = k[i+1:]
+		if len(prefix) == 0 || len(prefix) > maxPrefixLength || !prefixRegexp.MatchString(prefix) {
+			return fmt.Errorf("invalid label key %q: prefix must be a DNS subdomain", k)
+		}
+	}
+	if len(name) == 0 || len(name) > maxNameLength || !nameRegexp.MatchString(name) {
+		return fmt.Errorf("invalid label key %q: name must be at most %d alphanumeric characters, '-', '_' or '.'", k, maxNameLength)
+	}
+	return nil
+}
+
+// ValidateValue returns an error if the given label value is not valid.
+func ValidateValue(v string) error {
+	if len(v) == 0 {
+		return nil
+	}
+	if len(v) > maxValueLength || !nameRegexp.MatchString(v) {
+		return fmt.Errorf("invalid label value %q: value must be at most %d alphanumeric characters, '-', '_' or '.'", v, maxValueLength)
+	}
+	return nil
+}
+
+// Validate returns an error if any of the given labels is not valid.
+func Validate(l map[string]string) error {
+	for k, v := range l {
+		if err := ValidateKey(k); err != nil {
+			return err
+		}
+		if err := ValidateValue(v); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Operator is the relation between a label and the values of a requirement.
+type Operator string
+
+const (
+	// Equals requires the label to have the given value.
+	Equals Operator = "="
+	// NotEquals requires the label to be absent or to have a different value.
+	NotEquals Operator = "!="
+	// In requires the label to have one of the given values.
+	In Operator = "in"
+	// NotIn requires the label to be absent or to have none of the given values.
+	NotIn Operator = "notin"
+	// Exists requires the label to be present.
+	Exists Operator = "exists"
+	// DoesNotExist requires the label to be absent.
+	DoesNotExist Operator = "!"
+)
+
+// Requirement is a single condition of a selector.
+type Requirement struct {
+	Key      string
+	Operator Operator
+	Values   []string
+}
+
+// Matches returns true if the given labels satisfy the requirement.
+func (r Requirement) Matches(l map[string]string) bool {
+	v, ok := l[r.Key]
+	switch r.Operator {
+	case Equals, In:
+		return ok && contains(r.Values, v)
+	case NotEquals, NotIn:
+		return !ok || !contains(r.Values, v)
+	case Exists:
+		return ok
+	case DoesNotExist:
+		return !ok
+	}
+	return false
+}
+
+func (r Requirement) String() string {
+	switch r.Operator {
+	case Equals, NotEquals:
+		return r.Key + string(r.Operator) + r.Values[0]
+	case In, NotIn:
+		return fmt.Sprintf("%s %s (%s)", r.Key, r.Operator, strings.Join(r.Values, ","))
+	case DoesNotExist:
+		return "!" + r.Key
+	}
+	return r.Key
+}
+
+func contains(values []string, v string) bool {
+	for i := range values {
+		if values[i] == v {
+			return true
+		}
+	}
+	return false
+}
+
+// Selector is a conjunction of requirements.
+// The empty selector matches all sets of labels.
+type Selector []Requirement
+
+// Matches returns true if the given labels satisfy all requirements of the selector.
+func (s Selector) Matches(l map[string]string) bool {
+	for i := range s {
+		if !s[i].Matches(l) {
+			return false
+		}
+	}
+	return true
+}
+
+// Empty returns true if the selector has no requirements.
+func (s Selector) Empty() bool {
+	return len(s) == 0
+}
+
+func (s Selector) String() string {
+	rs := make([]string, 0, len(s))
+	for i := range s {
+		rs = append(rs, s[i].String())
+	}
+	return strings.Join(rs, ",")
+}
+
+// SelectorFromSet returns a selector that requires all of the given labels.
+func SelectorFromSet(l map[string]string) Selector {
+	keys := make([]string, 0, len(l))
+	for k := range l {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	s := make(Selector, 0, len(keys))
+	for _, k := range keys {
+		s = append(s, Requirement{Key: k, Operator: Equals, Values: []string{l[k]}})
+	}
+	return s
+}
+
+// ErrInvalidSelector is returned when a selector cannot be parsed.
+var ErrInvalidSelector = errors.New("invalid label selector")
+
+// Parse parses a Kubernetes-style label selector, e.g.
+// "region=eu,tier!=canary,env in (prod,staging),!experimental".
+func Parse(selector string) (Selector, error) {
+	p := &parser{tokens: lex(selector)}
+	var s Selector
+	if p.peek() == "" {
+		return s, nil
+	}
+	for {
+		r, err := p.requirement()
+		if err != nil {
+			return nil, fmt.Errorf("%w %q: %v", ErrInvalidSelector, selector, err)
+		}
+		s = append(s, r)
+		switch t := p.next(); t {
+		case "":
+			return s, nil
+		case ",":
+		default:
+			return nil, fmt.Errorf("%w %q: expected ',' but got %q", ErrInvalidSelector, selector, t)
+		}
+	}
+}
+
+func lex(s string) []string {
+	var tokens []string
+	for i := 0; i < len(s); {
+		switch c := s[i]; {
+		case c == ' ' || c == '\t':
+			i++
+		case strings.HasPrefix(s[i:], "!=") || strings.HasPrefix(s[i:], "=="):
+			tokens = append(tokens, s[i:i+2])
+			i += 2
+		case strings.ContainsRune("!=(),", rune(c)):
+			tokens = append(tokens, s[i:i+1])
+			i++
+		default:
+			j := i
+			for j < len(s) && !strings.ContainsRune(" \t!=(),", rune(s[j])) {
+				j++
+			}
+			tokens = append(tokens, s[i:j])
+			i = j
+		}
+	}
+	return tokens
+}
+
+type parser struct {
+	tokens []string
+	pos    int
+}
+
+func (p *parser) peek() string {
+	if p.pos >= len(p.tokens) {
+		return ""
+	}
+	return p.tokens[p.pos]
+}
+
+func (p *parser) next() string {
+	t := p.peek()
+	p.pos++
+	return t
+}
+
+func isOperatorToken(t string) bool {
+	switch t {
+	case "", "!", "=", "==", "!=", "(", ")", ",":
+		return true
+	}
+	return false
+}
+
+func (p *parser) key() (string, error) {
+	k := p.next()
+	if isOperatorToken(k) {
+		return "", fmt.Errorf("expected label key but got %q", k)
+	}
+	return k, ValidateKey(k)
+}
+
+func (p *parser) value() (string, error) {
+	// An empty value is allowed, e.g. "key=".
+	if t := p.peek(); t == "," || t == "" {
+		return "", nil
+	}
+	v := p.next()
+	if isOperatorToken(v) {
+		return "", fmt.Errorf("expected label value but got %q", v)
+	}
+	return v, ValidateValue(v)
+}
+
+func (p *parser) requirement() (Requirement, error) {
+	if p.peek() == "!" {
+		p.next()
+		k, err := p.key()
+		return Requirement{Key: k, Operator: DoesNotExist}, err
+	}
+	k, err := p.key()
+	if err != nil {
+		return Requirement{}, err
+	}
+	switch t := p.peek(); t {
+	case "", ",":
+		return Requirement{Key: k, Operator: Exists}, nil
+	case "=", "==", "!=":
+		p.next()
+		v, err := p.value()
+		op := Equals
+		if t == "!=" {
+			op = NotEquals
+		}
+		return Requirement{Key: k, Operator: op, Values: []string{v}}, err
+	case string(In), string(NotIn):
+		p.next()
+		vs, err := p.set()
+		return Requirement{Key: k, Operator: Operator(t), Values: vs}, err
+	default:
+		return Requirement{}, fmt.Errorf("unexpected %q after label key %q", t, k)
+	}
+}
+
+func (p *parser) set() ([]string, error) {
+	if t := p.next(); t != "(" {
+		return nil, fmt.Errorf("expected '(' but got %q", t)
+	}
+	var vs []string
+	for {
+		v, err := p.value()
+		if err != nil {
+			return nil, err
+		}
+		vs = append(vs, v)
+		switch t := p.next(); t {
+		case ")":
+			return vs, nil
+		case ",":
+		default:
+			return nil, fmt.Errorf("expected ',' or ')' but got %q", t)
+		}
+	}
+}
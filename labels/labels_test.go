@@ -0,0 +1,106 @@
+package labels
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/efficientgo/core/testutil"
+)
+
+func TestParse(t *testing.T) {
+	for _, tc := range []struct {
+		name     string
+		selector string
+		out      Selector
+		err      bool
+	}{
+		{
+			name:     "empty",
+			selector: "",
+		},
+		{
+			name:     "equality",
+			selector: "region=eu, tier==gold,stage!=canary",
+			out: Selector{
+				{Key: "region", Operator: Equals, Values: []string{"eu"}},
+				{Key: "tier", Operator: Equals, Values: []string{"gold"}},
+				{Key: "stage", Operator: NotEquals, Values: []string{"canary"}},
+			},
+		},
+		{
+			name:     "sets",
+			selector: "env in (prod, staging),example.com/team notin (a)",
+			out: Selector{
+				{Key: "env", Operator: In, Values: []string{"prod", "staging"}},
+				{Key: "example.com/team", Operator: NotIn, Values: []string{"a"}},
+			},
+		},
+		{
+			name:     "existence",
+			selector: "request_id,!experimental",
+			out: Selector{
+				{Key: "request_id", Operator: Exists},
+				{Key: "experimental", Operator: DoesNotExist},
+			},
+		},
+		{
+			name:     "empty value",
+			selector: "region=",
+			out: Selector{
+				{Key: "region", Operator: Equals, Values: []string{""}},
+			},
+		},
+		{
+			name:     "missing key",
+			selector: "=eu",
+			err:      true,
+		},
+		{
+			name:     "unterminated set",
+			selector: "env in (prod",
+			err:      true,
+		},
+		{
+			name:     "invalid key",
+			selector: "-region=eu",
+			err:      true,
+		},
+		{
+			name:     "trailing comma",
+			selector: "region=eu,",
+			err:      true,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			s, err := Parse(tc.selector)
+			if tc.err {
+				testutil.Assert(t, errors.Is(err, ErrInvalidSelector), "expected invalid selector error, got %v", err)
+				return
+			}
+			testutil.Ok(t, err)
+			testutil.Equals(t, tc.out, s)
+		})
+	}
+}
+
+func TestMatches(t *testing.T) {
+	l := map[string]string{"region": "eu", "env": "prod"}
+	for selector, matches := range map[string]bool{
+		"":                       true,
+		"region=eu":              true,
+		"region=us":              false,
+		"region!=us":             true,
+		"missing!=us":            true,
+		"env in (prod,staging)":  true,
+		"env notin (prod)":       false,
+		"missing notin (prod)":   true,
+		"region,!missing":        true,
+		"missing":                false,
+		"region=eu,env=staging":  false,
+		"region=eu,env in (dev)": false,
+	} {
+		s, err := Parse(selector)
+		testutil.Ok(t, err)
+		testutil.Equals(t, matches, s.Matches(l), "selector %q", selector)
+	}
+}
@@ -0,0 +1,188 @@
+// Package notify distributes the events of the store to subscribers within the process.
+// Events are received from PostgreSQL with LISTEN, so subscribers see the events emitted by all replicas.
+package notify
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/go-kit/log"
+	"github.com/go-kit/log/level"
+	"github.com/jackc/pgx"
+	"github.com/prometheus/client_golang/prometheus"
+
+	"github.com/connylabs/model-tracking/store"
+)
+
+// Hub distributes events to the subscribers of their organizations.
+type Hub struct {
+	buffer int
+
+	mu          sync.Mutex
+	subscribers map[string]map[*subscription]struct{}
+
+	subscriptions prometheus.Gauge
+	dropped       prometheus.Counter
+}
+
+type subscription struct {
+	ch     chan store.Event
+	closed bool
+}
+
+// NewHub creates a new hub that buffers up to the given number of events per subscriber.
+func NewHub(buffer int, reg prometheus.Registerer) *Hub {
+	subscriptions := prometheus.NewGauge(prometheus.GaugeOpts{
+		Name: "model_tracking_event_subscriptions",
+		Help: "The number of subscriptions to events.",
+	})
+	dropped := prometheus.NewCounter(prometheus.CounterOpts{
+		Name: "model_tracking_event_subscriptions_dropped_total",
+		Help: "The number of subscriptions to events that were closed because events were lost, e.g. because the subscriber fell behind.",
+	})
+	if reg != nil {
+		reg.MustRegister(subscriptions, dropped)
+	}
+	return &Hub{
+		buffer:        buffer,
+		subscribers:   make(map[string]map[*subscription]struct{}),
+		subscriptions: subscriptions,
+		dropped:       dropped,
+	}
+}
+
+// Subscribe subscribes to the events of an organization.
+// Events are sent on the returned channel until the subscription is canceled.
+// If events are lost, e.g. because the subscriber falls behind by more events than the buffer holds,
+// the channel is closed, so that the subscriber can catch up from the store and subscribe again.
+func (h *Hub) Subscribe(organization string) (<-chan store.Event, func()) {
+	s := &subscription{ch: make(chan store.Event, h.buffer)}
+	h.mu.Lock()
+	if h.subscribers[organization] == nil {
+		h.subscribers[organization] = make(map[*subscription]struct{})
+	}
+	h.subscribers[organization][s] = struct{}{}
+	h.mu.Unlock()
+	h.subscriptions.Inc()
+
+	var once sync.Once
+	return s.ch, func() {
+		once.Do(func() {
+			h.mu.Lock()
+			defer h.mu.Unlock()
+			h.remove(organization, s)
+			h.subscriptions.Dec()
+		})
+	}
+}
+
+// Publish sends an event to the subscribers of its organization without blocking.
+func (h *Hub) Publish(e store.Event) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	for s := range h.subscribers[e.Organization] {
+		select {
+		case s.ch <- e:
+		default:
+			h.remove(e.Organization, s)
+			h.dropped.Inc()
+		}
+	}
+}
+
+// Reset closes all subscriptions, e.g. because events may have been lost.
+func (h *Hub) Reset() {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	for organization := range h.subscribers {
+		for s := range h.subscribers[organization] {
+			h.remove(organization, s)
+			h.dropped.Inc()
+		}
+	}
+}
+
+// remove closes a subscription and removes it from the hub.
+// The hub must be locked.
+func (h *Hub) remove(organization string, s *subscription) {
+	if s.closed {
+		return
+	}
+	s.closed = true
+	close(s.ch)
+	delete(h.subscribers[organization], s)
+	if len(h.subscribers[organization]) == 0 {
+		delete(h.subscribers, organization)
+	}
+}
+
+// Listener receives the events emitted by the store from the database and publishes them to a hub.
+type Listener struct {
+	config  pgx.ConnConfig
+	hub     *Hub
+	backoff time.Duration
+	logger  log.Logger
+}
+
+// NewListener creates a new listener that connects to the database with the given connection string.
+func NewListener(connString string, hub *Hub, logger log.Logger) (*Listener, error) {
+	config, err := pgx.ParseConnectionString(connString)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse the database connection string: %w", err)
+	}
+	if logger == nil {
+		logger = log.NewNopLogger()
+	}
+	return &Listener{
+		config:  config,
+		hub:     hub,
+		backoff: 5 * time.Second,
+		logger:  logger,
+	}, nil
+}
+
+// Run receives events until the context is canceled.
+// If the connection to the database fails, it reconnects after a backoff
+// and resets the hub, since events may have been lost in the meantime.
+func (l *Listener) Run(ctx context.Context) error {
+	for {
+		err := l.listen(ctx)
+		if ctx.Err() != nil {
+			return nil
+		}
+		level.Error(l.logger).Log("msg", "failed to listen for events", "err", err.Error())
+		l.hub.Reset()
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-time.After(l.backoff):
+		}
+	}
+}
+
+func (l *Listener) listen(ctx context.Context) error {
+	conn, err := pgx.Connect(l.config)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+	if err := conn.Listen(store.EventsChannel); err != nil {
+		return err
+	}
+	level.Debug(l.logger).Log("msg", "listening for events", "channel", store.EventsChannel)
+	for {
+		n, err := conn.WaitForNotification(ctx)
+		if err != nil {
+			return err
+		}
+		var e store.Event
+		if err := json.Unmarshal([]byte(n.Payload), &e); err != nil {
+			level.Warn(l.logger).Log("msg", "failed to decode event", "err", err.Error())
+			continue
+		}
+		l.hub.Publish(e)
+	}
+}
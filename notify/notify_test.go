@@ -0,0 +1,46 @@
+package notify
+
+import (
+	"testing"
+
+	"github.com/efficientgo/core/testutil"
+
+	"github.com/connylabs/model-tracking/store"
+)
+
+func TestHub(t *testing.T) {
+	h := NewHub(2, nil)
+	foo, cancelFoo := h.Subscribe("foo")
+	bar, cancelBar := h.Subscribe("bar")
+	defer cancelBar()
+
+	// Events are only sent to the subscribers of their organization.
+	h.Publish(store.Event{Type: store.EventResultBatchCreated, Organization: "foo", ID: 1})
+	testutil.Equals(t, store.Event{Type: store.EventResultBatchCreated, Organization: "foo", ID: 1}, <-foo)
+	testutil.Equals(t, 0, len(bar))
+
+	// Subscribers that fall behind are closed.
+	for i := 0; i < 3; i++ {
+		h.Publish(store.Event{Type: store.EventResultBatchCreated, Organization: "foo", ID: int32(i)})
+	}
+	var n int
+	for range foo {
+		n++
+	}
+	testutil.Equals(t, 2, n)
+	// Canceling a closed subscription is fine.
+	cancelFoo()
+	cancelFoo()
+
+	// Resetting closes all subscriptions.
+	h.Reset()
+	_, ok := <-bar
+	testutil.Assert(t, !ok)
+
+	// Canceled subscriptions receive no more events.
+	baz, cancelBaz := h.Subscribe("foo")
+	cancelBaz()
+	h.Publish(store.Event{Type: store.EventResultBatchCreated, Organization: "foo", ID: 1})
+	_, ok = <-baz
+	testutil.Assert(t, !ok)
+}
@@ -0,0 +1,321 @@
+// Package queue creates results asynchronously in batches,
+// so that clients need not wait for the database to acknowledge results.
+package queue
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/go-kit/log"
+	"github.com/go-kit/log/level"
+	"github.com/prometheus/client_golang/prometheus"
+
+	"github.com/connylabs/model-tracking/store"
+	"github.com/connylabs/model-tracking/store/model-tracking/public/model"
+)
+
+var (
+	// ErrFull is returned when a result is enqueued while the queue is full.
+	// Clients should retry later.
+	ErrFull = errors.New("the ingestion queue is full")
+	// ErrClosed is returned when a result is enqueued while the queue shuts down.
+	ErrClosed = errors.New("the ingestion queue is shutting down")
+)
+
+// Item is a result to create for a version of a model.
+type Item struct {
+	Organization string        `json:"organization"`
+	Model        string        `json:"model"`
+	Version      string        `json:"version"`
+	Result       *model.Result `json:"result"`
+}
+
+// Queue buffers validated results in memory and creates them in batches.
+// A batch is created once it holds batchSize results or its oldest result
+// has waited for the flush interval. While the store is unavailable,
+// batches are retried and the queue fills up, so that enqueueing fails with ErrFull.
+//
+// When the queue shuts down, it creates the results it still holds.
+// Those that cannot be created are appended to a local file,
+// from which they are created when the queue runs again.
+type Queue struct {
+	store        store.ModelTracking
+	items        chan Item
+	batchSize    int
+	interval     time.Duration
+	path         string
+	backoff      time.Duration
+	flushTimeout time.Duration
+	logger       log.Logger
+
+	mu     sync.RWMutex
+	closed bool
+
+	results *prometheus.CounterVec
+}
+
+// New creates a new queue that holds up to capacity results, creates them in batches
+// of up to batchSize results and waits for up to interval to fill a batch.
+// Results that cannot be created on shutdown are persisted to the file at path;
+// if path is empty, they are logged and lost.
+func New(store store.ModelTracking, capacity, batchSize int, interval time.Duration, path string, reg prometheus.Registerer, logger log.Logger) *Queue {
+	if logger == nil {
+		logger = log.NewNopLogger()
+	}
+	q := &Queue{
+		store:        store,
+		items:        make(chan Item, capacity),
+		batchSize:    batchSize,
+		interval:     interval,
+		path:         path,
+		backoff:      time.Second,
+		flushTimeout: 10 * time.Second,
+		logger:       logger,
+		results: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Name: "model_tracking_ingestion_queue_results_total",
+			Help: "The number of results handled by the ingestion queue by outcome.",
+		}, []string{"outcome"}),
+	}
+	if reg != nil {
+		reg.MustRegister(q.results, prometheus.NewGaugeFunc(prometheus.GaugeOpts{
+			Name: "model_tracking_ingestion_queue_length",
+			Help: "The number of results waiting in the ingestion queue.",
+		}, func() float64 {
+			return float64(len(q.items))
+		}))
+	}
+	return q
+}
+
+// Enqueue adds a validated result for a version of a model to the queue without blocking.
+// It returns ErrFull if the queue is full and ErrClosed if the queue shuts down.
+func (q *Queue) Enqueue(organization, model, version string, result *model.Result) error {
+	q.mu.RLock()
+	defer q.mu.RUnlock()
+	if q.closed {
+		return ErrClosed
+	}
+	select {
+	case q.items <- Item{Organization: organization, Model: model, Version: version, Result: result}:
+		q.results.WithLabelValues("accepted").Inc()
+		return nil
+	default:
+		q.results.WithLabelValues("rejected").Inc()
+		return ErrFull
+	}
+}
+
+// Run creates the enqueued results until the context is canceled.
+// It first creates the results persisted by an earlier shutdown;
+// until then, the queue fills up.
+func (q *Queue) Run(ctx context.Context) error {
+	if err := q.replay(ctx); err != nil {
+		return err
+	}
+	var batch []Item
+	var flush <-chan time.Time
+	for {
+		select {
+		case <-ctx.Done():
+			return q.shutdown(batch)
+		case it := <-q.items:
+			if len(batch) == 0 {
+				flush = time.After(q.interval)
+			}
+			if batch = append(batch, it); len(batch) < q.batchSize {
+				continue
+			}
+		case <-flush:
+		}
+		// Results that could not be created because the context was canceled
+		// are handled on shutdown.
+		batch = q.flush(ctx, batch)
+		flush = nil
+	}
+}
+
+// flush creates a batch of results, retrying while the store is unavailable.
+// It returns the results that were not created before the context was canceled.
+func (q *Queue) flush(ctx context.Context, batch []Item) []Item {
+	for len(batch) != 0 {
+		var err error
+		if batch, err = q.insert(ctx, batch); err == nil {
+			return nil
+		}
+		if ctx.Err() != nil {
+			return batch
+		}
+		level.Error(q.logger).Log("msg", "failed to create results", "results", len(batch), "err", err.Error())
+		select {
+		case <-ctx.Done():
+		case <-time.After(q.backoff):
+		}
+	}
+	return batch
+}
+
+// insert creates the results of every version in the batch in a single transaction.
+// It returns the results that could not be created because of errors that may be temporary
+// and the last such error. Results that can never be created, e.g. because their version
+// was archived after they were enqueued, are logged and dropped.
+func (q *Queue) insert(ctx context.Context, batch []Item) ([]Item, error) {
+	var keys []string
+	versions := make(map[string][]Item)
+	for _, it := range batch {
+		key := strings.Join([]string{it.Organization, it.Model, it.Version}, "/")
+		if _, ok := versions[key]; !ok {
+			keys = append(keys, key)
+		}
+		versions[key] = append(versions[key], it)
+	}
+
+	var remaining []Item
+	var lastErr error
+	for _, key := range keys {
+		items := versions[key]
+		var i int
+		next := func() (*model.Result, error) {
+			if i == len(items) {
+				return nil, io.EOF
+			}
+			i++
+			return items[i-1].Result, nil
+		}
+		created, skipped, err := q.store.Results(items[0].Organization, items[0].Model, items[0].Version).CreateBulk(ctx, next)
+		switch {
+		case err == nil:
+			q.results.WithLabelValues("created").Add(float64(created))
+			q.results.WithLabelValues("skipped").Add(float64(skipped))
+		case errors.Is(err, store.ErrInvalid), errors.Is(err, store.ErrNotFound), errors.Is(err, store.ErrConflict), errors.Is(err, store.ErrPreconditionFailed):
+			level.Error(q.logger).Log("msg", "dropped results that cannot be created", "version", key, "results", len(items), "err", err.Error())
+			q.results.WithLabelValues("dropped").Add(float64(len(items)))
+		default:
+			remaining = append(remaining, items...)
+			lastErr = err
+		}
+	}
+	return remaining, lastErr
+}
+
+// shutdown stops accepting results and creates the remaining ones.
+// Those that cannot be created in time are persisted.
+func (q *Queue) shutdown(batch []Item) error {
+	q.mu.Lock()
+	q.closed = true
+	q.mu.Unlock()
+	for len(q.items) != 0 {
+		batch = append(batch, <-q.items)
+	}
+	if len(batch) == 0 {
+		return nil
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), q.flushTimeout)
+	defer cancel()
+	if batch = q.flush(ctx, batch); len(batch) == 0 {
+		return nil
+	}
+	if q.path == "" {
+		q.results.WithLabelValues("dropped").Add(float64(len(batch)))
+		return fmt.Errorf("failed to create %d results before shutting down", len(batch))
+	}
+	if err := persist(q.path, batch); err != nil {
+		q.results.WithLabelValues("dropped").Add(float64(len(batch)))
+		return fmt.Errorf("failed to persist %d results: %w", len(batch), err)
+	}
+	q.results.WithLabelValues("persisted").Add(float64(len(batch)))
+	level.Warn(q.logger).Log("msg", "persisted results that could not be created before shutting down", "results", len(batch), "path", q.path)
+	return nil
+}
+
+// persist appends results to the file at path as JSON lines.
+func persist(path string, items []Item) error {
+	f, err := os.OpenFile(path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0o600)
+	if err != nil {
+		return err
+	}
+	w := bufio.NewWriter(f)
+	e := json.NewEncoder(w)
+	for i := range items {
+		if err := e.Encode(&items[i]); err != nil {
+			f.Close()
+			return err
+		}
+	}
+	if err := w.Flush(); err != nil {
+		f.Close()
+		return err
+	}
+	if err := f.Sync(); err != nil {
+		f.Close()
+		return err
+	}
+	return f.Close()
+}
+
+// replay creates the results persisted to the file in batches.
+// After every batch, the file is replaced with the remaining results,
+// so that results are not created twice if the process stops while replaying.
+func (q *Queue) replay(ctx context.Context) error {
+	if q.path == "" {
+		return nil
+	}
+	f, err := os.Open(q.path)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("failed to open persisted results: %w", err)
+	}
+	var items []Item
+	d := json.NewDecoder(f)
+	for {
+		var it Item
+		if err := d.Decode(&it); err == io.EOF {
+			break
+		} else if err != nil {
+			f.Close()
+			return fmt.Errorf("failed to read persisted results from %s: %w", q.path, err)
+		}
+		items = append(items, it)
+	}
+	f.Close()
+
+	level.Info(q.logger).Log("msg", "creating persisted results", "results", len(items), "path", q.path)
+	for len(items) != 0 && ctx.Err() == nil {
+		n := q.batchSize
+		if n > len(items) {
+			n = len(items)
+		}
+		// Results that were not created are retried with the rest.
+		rest := q.flush(ctx, items[:n])
+		items = append(rest, items[n:]...)
+		if err := q.rewrite(items); err != nil {
+			return fmt.Errorf("failed to update persisted results: %w", err)
+		}
+	}
+	return nil
+}
+
+// rewrite atomically replaces the file with the given results or removes it if there are none.
+func (q *Queue) rewrite(items []Item) error {
+	if len(items) == 0 {
+		return os.Remove(q.path)
+	}
+	tmp := q.path + ".tmp"
+	if err := os.Remove(tmp); err != nil && !errors.Is(err, os.ErrNotExist) {
+		return err
+	}
+	if err := persist(tmp, items); err != nil {
+		return err
+	}
+	return os.Rename(tmp, q.path)
+}
@@ -0,0 +1,186 @@
+package queue
+
+import (
+	"context"
+	"errors"
+	"io"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/efficientgo/core/testutil"
+
+	"github.com/connylabs/model-tracking/store"
+	"github.com/connylabs/model-tracking/store/model-tracking/public/model"
+)
+
+type fakeStore struct {
+	store.ModelTracking
+	mu      sync.Mutex
+	results map[string][]*model.Result
+	batches int
+	// failures is the number of failed attempts to create results.
+	failures int
+	// fail makes creating results fail as if the database were unavailable.
+	fail bool
+}
+
+func (fs *fakeStore) Results(_, _, version string) store.Results {
+	return &fakeResults{fs: fs, version: version}
+}
+
+func (fs *fakeStore) count(version string) int {
+	fs.mu.Lock()
+	defer fs.mu.Unlock()
+	return len(fs.results[version])
+}
+
+func (fs *fakeStore) setFail(fail bool) {
+	fs.mu.Lock()
+	defer fs.mu.Unlock()
+	fs.fail = fail
+}
+
+type fakeResults struct {
+	store.Results
+	fs      *fakeStore
+	version string
+}
+
+func (fr *fakeResults) CreateBulk(_ context.Context, next func() (*model.Result, error)) (int, int, error) {
+	fr.fs.mu.Lock()
+	defer fr.fs.mu.Unlock()
+	if fr.fs.fail {
+		fr.fs.failures++
+		return 0, 0, errors.New("connection refused")
+	}
+	if fr.version == "archived" {
+		return 0, 0, &store.Error{Kind: store.ErrConflict, Message: "version \"archived\" is archived"}
+	}
+	var n int
+	for {
+		r, err := next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return 0, 0, err
+		}
+		fr.fs.results[fr.version] = append(fr.fs.results[fr.version], r)
+		n++
+	}
+	fr.fs.batches++
+	return n, 0, nil
+}
+
+func result(input string) *model.Result {
+	return &model.Result{Input: []byte(input), Output: []byte(`1`), Time: time.Unix(0, 0).UTC(), Labels: "{}"}
+}
+
+func eventually(t *testing.T, f func() bool) {
+	t.Helper()
+	for i := 0; !f(); i++ {
+		testutil.Assert(t, i < 200, "the condition should be met")
+		time.Sleep(10 * time.Millisecond)
+	}
+}
+
+func TestQueue(t *testing.T) {
+	fs := &fakeStore{results: make(map[string][]*model.Result)}
+	q := New(fs, 4, 2, time.Hour, "", nil, nil)
+	q.backoff = 10 * time.Millisecond
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan error)
+	go func() {
+		done <- q.Run(ctx)
+	}()
+
+	// Full batches are created right away.
+	testutil.Ok(t, q.Enqueue("foo", "bar", "v1", result(`"a"`)))
+	testutil.Ok(t, q.Enqueue("foo", "bar", "v2", result(`"b"`)))
+	eventually(t, func() bool { return fs.count("v1") == 1 && fs.count("v2") == 1 })
+
+	// Results are dropped if their version is archived in the meantime.
+	testutil.Ok(t, q.Enqueue("foo", "bar", "archived", result(`"a"`)))
+	testutil.Ok(t, q.Enqueue("foo", "bar", "v1", result(`"b"`)))
+	eventually(t, func() bool { return fs.count("v1") == 2 })
+
+	// The queue fills up while the store is unavailable.
+	fs.setFail(true)
+	for i := 0; i < 2; i++ {
+		testutil.Ok(t, q.Enqueue("foo", "bar", "v1", result(`"c"`)))
+	}
+	eventually(t, func() bool {
+		fs.mu.Lock()
+		defer fs.mu.Unlock()
+		return fs.failures != 0
+	})
+	for i := 0; i < 4; i++ {
+		testutil.Ok(t, q.Enqueue("foo", "bar", "v1", result(`"c"`)))
+	}
+	testutil.Equals(t, ErrFull, q.Enqueue("foo", "bar", "v1", result(`"d"`)))
+	fs.setFail(false)
+	eventually(t, func() bool { return fs.count("v1") == 8 })
+
+	// The results that were not part of a full batch are created on shutdown.
+	testutil.Ok(t, q.Enqueue("foo", "bar", "v1", result(`"e"`)))
+	cancel()
+	testutil.Ok(t, <-done)
+	testutil.Equals(t, 9, fs.count("v1"))
+	testutil.Equals(t, ErrClosed, q.Enqueue("foo", "bar", "v1", result(`"f"`)))
+}
+
+func TestQueueInterval(t *testing.T) {
+	fs := &fakeStore{results: make(map[string][]*model.Result)}
+	q := New(fs, 10, 10, 10*time.Millisecond, "", nil, nil)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go q.Run(ctx) //nolint:errcheck
+
+	testutil.Ok(t, q.Enqueue("foo", "bar", "v1", result(`"a"`)))
+	testutil.Ok(t, q.Enqueue("foo", "bar", "v1", result(`"b"`)))
+	eventually(t, func() bool { return fs.count("v1") == 2 })
+	fs.mu.Lock()
+	defer fs.mu.Unlock()
+	testutil.Equals(t, 1, fs.batches)
+}
+
+func TestQueuePersist(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "results.jsonl")
+	fs := &fakeStore{results: make(map[string][]*model.Result), fail: true}
+	q := New(fs, 10, 10, time.Hour, path, nil, nil)
+	q.backoff = 10 * time.Millisecond
+	q.flushTimeout = 50 * time.Millisecond
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan error)
+	go func() {
+		done <- q.Run(ctx)
+	}()
+
+	testutil.Ok(t, q.Enqueue("foo", "bar", "v1", result(`"a"`)))
+	testutil.Ok(t, q.Enqueue("foo", "bar", "v2", result(`"b"`)))
+	testutil.Ok(t, q.Enqueue("foo", "bar", "v1", result(`"c"`)))
+	cancel()
+	testutil.Ok(t, <-done)
+	testutil.Equals(t, 0, fs.count("v1"))
+	_, err := os.Stat(path)
+	testutil.Ok(t, err)
+
+	// The persisted results are created when the queue runs again.
+	fs.setFail(false)
+	q = New(fs, 10, 2, time.Hour, path, nil, nil)
+	ctx, cancel = context.WithCancel(context.Background())
+	defer cancel()
+	go q.Run(ctx) //nolint:errcheck
+	eventually(t, func() bool { return fs.count("v1") == 2 && fs.count("v2") == 1 })
+	fs.mu.Lock()
+	testutil.Equals(t, `"c"`, string(fs.results["v1"][1].Input))
+	testutil.Equals(t, time.Unix(0, 0).UTC(), fs.results["v1"][1].Time)
+	fs.mu.Unlock()
+	eventually(t, func() bool {
+		_, err := os.Stat(path)
+		return errors.Is(err, os.ErrNotExist)
+	})
+}
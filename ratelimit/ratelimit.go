@@ -0,0 +1,299 @@
+// Package ratelimit limits the rate of requests of organizations and their clients
+// and enforces quotas on the results they record.
+package ratelimit
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"golang.org/x/time/rate"
+	"gopkg.in/yaml.v3"
+
+	"github.com/connylabs/model-tracking/store"
+)
+
+// Limits are the limits of an organization.
+// A value of zero means that the respective limit is disabled.
+type Limits struct {
+	// RequestsPerSecond is the rate of requests of the whole organization.
+	RequestsPerSecond float64 `yaml:"requestsPerSecond"`
+	// Burst is the number of requests of the whole organization
+	// that may exceed the rate at once. It defaults to the rate.
+	Burst int `yaml:"burst"`
+	// ClientRequestsPerSecond is the rate of requests of every client of the organization,
+	// where clients are identified by their credentials.
+	ClientRequestsPerSecond float64 `yaml:"clientRequestsPerSecond"`
+	// ClientBurst is the number of requests of every client
+	// that may exceed the rate at once. It defaults to the rate.
+	ClientBurst int `yaml:"clientBurst"`
+	// ResultsPerDay is the number of results that the organization
+	// may record per day. Days start at midnight UTC.
+	ResultsPerDay int64 `yaml:"resultsPerDay"`
+	// MaxPayloadBytes is the maximum size of the body of a request.
+	MaxPayloadBytes int64 `yaml:"maxPayloadBytes"`
+}
+
+// Config configures the limits of all organizations.
+type Config struct {
+	// Default are the limits of organizations that are not configured explicitly.
+	Default Limits `yaml:"default"`
+	// Organizations are the limits of individual organizations by name.
+	// They replace the default limits entirely rather than being merged with them.
+	Organizations map[string]Limits `yaml:"organizations"`
+}
+
+// LoadConfig reads the configuration from a YAML file, e.g.:
+//
+//	default:
+//	  requestsPerSecond: 50
+//	  clientRequestsPerSecond: 10
+//	  resultsPerDay: 1000000
+//	organizations:
+//	  acme:
+//	    requestsPerSecond: 200
+//	    maxPayloadBytes: 1048576
+func LoadConfig(path string) (*Config, error) {
+	buf, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	var c Config
+	if err := yaml.Unmarshal(buf, &c); err != nil {
+		return nil, fmt.Errorf("failed to parse %s: %w", path, err)
+	}
+	for name, l := range c.Organizations {
+		if err := l.validate(); err != nil {
+			return nil, fmt.Errorf("invalid limits for organization %q: %w", name, err)
+		}
+	}
+	if err := c.Default.validate(); err != nil {
+		return nil, fmt.Errorf("invalid default limits: %w", err)
+	}
+	return &c, nil
+}
+
+func (l Limits) validate() error {
+	if l.RequestsPerSecond < 0 || l.ClientRequestsPerSecond < 0 || l.Burst < 0 || l.ClientBurst < 0 || l.ResultsPerDay < 0 || l.MaxPayloadBytes < 0 {
+		return fmt.Errorf("limits must not be negative")
+	}
+	return nil
+}
+
+// Limits returns the limits of the organization.
+func (c *Config) Limits(organization string) Limits {
+	if l, ok := c.Organizations[organization]; ok {
+		return l
+	}
+	return c.Default
+}
+
+// Reason is the reason why a request was throttled.
+type Reason string
+
+const (
+	// RateLimit means that the organization made too many requests.
+	RateLimit Reason = "rate_limit"
+	// ClientRateLimit means that the client made too many requests.
+	ClientRateLimit Reason = "client_rate_limit"
+	// ResultsQuota means that the organization recorded too many results today.
+	ResultsQuota Reason = "results_quota"
+	// PayloadSize means that the body of the request was too large.
+	PayloadSize Reason = "payload_size"
+)
+
+// Decision is the outcome of checking a limit.
+type Decision struct {
+	Allowed bool
+	// Reason is the reason why the request is not allowed.
+	Reason Reason
+	// RetryAfter is the time after which the request may be retried.
+	RetryAfter time.Duration
+}
+
+var allowed = Decision{Allowed: true}
+
+// idleTimeout is the time after which the bucket of an idle organization or client is discarded.
+const idleTimeout = 10 * time.Minute
+
+type bucket struct {
+	limiter  *rate.Limiter
+	lastSeen time.Time
+}
+
+type quota struct {
+	day    time.Time
+	count  int64
+	synced time.Time
+}
+
+// Limiter enforces the limits of organizations.
+// Rate limits are enforced per replica. Quotas are synchronized
+// with the store periodically, so all replicas observe the results of each other.
+// In between, each replica counts the results that it created itself.
+type Limiter struct {
+	config       *Config
+	statistics   store.Statistics
+	syncInterval time.Duration
+	now          func() time.Time
+
+	mu        sync.Mutex
+	buckets   map[string]*bucket
+	quotas    map[string]*quota
+	lastSweep time.Time
+
+	throttled *prometheus.CounterVec
+}
+
+// NewLimiter creates a new limiter for the given configuration that counts results
+// in the store at most once per syncInterval per organization.
+func NewLimiter(c *Config, statistics store.Statistics, syncInterval time.Duration, reg prometheus.Registerer) *Limiter {
+	throttled := prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "model_tracking_throttled_requests_total",
+		Help: "The number of requests that were rejected because of rate limits or quotas.",
+	}, []string{"organization", "reason"})
+	if reg != nil {
+		reg.MustRegister(throttled)
+	}
+	return &Limiter{
+		config:       c,
+		statistics:   statistics,
+		syncInterval: syncInterval,
+		now:          time.Now,
+		buckets:      make(map[string]*bucket),
+		quotas:       make(map[string]*quota),
+		throttled:    throttled,
+	}
+}
+
+// Allow takes a token from the buckets of the organization and of the client.
+// If client is empty, only the limit of the organization is enforced.
+func (l *Limiter) Allow(organization, client string) Decision {
+	limits := l.config.Limits(organization)
+	now := l.now()
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.sweep(now)
+
+	d, r := l.take(organization, limits.RequestsPerSecond, limits.Burst, RateLimit, now)
+	if d.Allowed && client != "" {
+		d, _ = l.take(organization+"\x00"+client, limits.ClientRequestsPerSecond, limits.ClientBurst, ClientRateLimit, now)
+		if !d.Allowed && r != nil {
+			// Give back the token of the organization, since the request is rejected anyway.
+			r.CancelAt(now)
+		}
+	}
+	if !d.Allowed {
+		l.throttled.WithLabelValues(organization, string(d.Reason)).Inc()
+	}
+	return d
+}
+
+// take takes a token from the bucket with the given key, creating it if needed,
+// and returns the reservation of the token, if any.
+// It must be called with the lock held.
+func (l *Limiter) take(key string, rps float64, burst int, reason Reason, now time.Time) (Decision, *rate.Reservation) {
+	if rps <= 0 {
+		return allowed, nil
+	}
+	if burst <= 0 {
+		burst = int(math.Ceil(rps))
+	}
+	b, ok := l.buckets[key]
+	if !ok {
+		b = &bucket{limiter: rate.NewLimiter(rate.Limit(rps), burst)}
+		l.buckets[key] = b
+	}
+	b.lastSeen = now
+	r := b.limiter.ReserveN(now, 1)
+	if delay := r.DelayFrom(now); delay > 0 {
+		r.CancelAt(now)
+		return Decision{Reason: reason, RetryAfter: delay}, nil
+	}
+	return allowed, r
+}
+
+// sweep discards the buckets of idle organizations and clients
+// to bound the memory used for clients that stopped making requests.
+// It must be called with the lock held.
+func (l *Limiter) sweep(now time.Time) {
+	if now.Sub(l.lastSweep) < time.Minute {
+		return
+	}
+	l.lastSweep = now
+	for k, b := range l.buckets {
+		if now.Sub(b.lastSeen) > idleTimeout {
+			delete(l.buckets, k)
+		}
+	}
+}
+
+// AllowResults checks whether n more results fit in the daily quota of the organization.
+// The results are not counted until they are created and passed to AddResults,
+// so that results that are rejected or fail to be created do not use up the quota.
+func (l *Limiter) AllowResults(ctx context.Context, organization string, n int64) (Decision, error) {
+	limit := l.config.Limits(organization).ResultsPerDay
+	if limit <= 0 {
+		return allowed, nil
+	}
+	now := l.now().UTC()
+	day := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC)
+
+	l.mu.Lock()
+	q, ok := l.quotas[organization]
+	sync := !ok || !q.day.Equal(day) || now.Sub(q.synced) >= l.syncInterval
+	l.mu.Unlock()
+
+	if sync {
+		// Query the store without holding the lock; concurrent requests
+		// may sync at the same time, which is harmless.
+		count, err := l.statistics.Count(ctx, organization, day)
+		if err != nil {
+			return Decision{}, fmt.Errorf("failed to count the results of organization %q: %w", organization, err)
+		}
+		l.mu.Lock()
+		q = &quota{day: day, count: count, synced: now}
+		l.quotas[organization] = q
+		l.mu.Unlock()
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if q.count+n > limit {
+		l.throttled.WithLabelValues(organization, string(ResultsQuota)).Inc()
+		return Decision{Reason: ResultsQuota, RetryAfter: day.Add(24 * time.Hour).Sub(now)}, nil
+	}
+	return allowed, nil
+}
+
+// AddResults counts n created results against the daily quota of the organization.
+func (l *Limiter) AddResults(organization string, n int64) {
+	now := l.now().UTC()
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	// Results of other days, or of organizations whose quota was never synchronized,
+	// are counted in the store when it is synchronized next.
+	if q, ok := l.quotas[organization]; ok && q.day.Equal(time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC)) {
+		q.count += n
+	}
+}
+
+// MaxPayloadBytes returns the maximum size of the body of a request of the organization.
+// Zero means that the size is unlimited.
+func (l *Limiter) MaxPayloadBytes(organization string) int64 {
+	return l.config.Limits(organization).MaxPayloadBytes
+}
+
+// AllowPayload checks the size of the body of a request of the organization.
+func (l *Limiter) AllowPayload(organization string, size int64) Decision {
+	if max := l.MaxPayloadBytes(organization); max > 0 && size > max {
+		l.throttled.WithLabelValues(organization, string(PayloadSize)).Inc()
+		return Decision{Reason: PayloadSize}
+	}
+	return allowed
+}
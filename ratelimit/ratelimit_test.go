@@ -0,0 +1,116 @@
+package ratelimit
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/efficientgo/core/testutil"
+
+	"github.com/connylabs/model-tracking/store"
+)
+
+// fakeStatistics counts a fixed number of results per organization.
+type fakeStatistics struct {
+	store.Statistics
+	counts map[string]int64
+	calls  int
+}
+
+func (fs *fakeStatistics) Count(_ context.Context, organization string, _ time.Time) (int64, error) {
+	fs.calls++
+	return fs.counts[organization], nil
+}
+
+func TestAllow(t *testing.T) {
+	now := time.Date(2023, 8, 1, 12, 0, 0, 0, time.UTC)
+	l := NewLimiter(&Config{
+		Default: Limits{RequestsPerSecond: 2, ClientRequestsPerSecond: 1},
+		Organizations: map[string]Limits{
+			"unlimited": {},
+		},
+	}, nil, time.Minute, nil)
+	l.now = func() time.Time { return now }
+
+	testutil.Equals(t, allowed, l.Allow("foo", "a"))
+	d := l.Allow("foo", "a")
+	testutil.Equals(t, ClientRateLimit, d.Reason)
+	testutil.Equals(t, time.Second, d.RetryAfter)
+	// The rejected request of the client does not count against the organization.
+	testutil.Equals(t, allowed, l.Allow("foo", "b"))
+	d = l.Allow("foo", "")
+	testutil.Equals(t, RateLimit, d.Reason)
+	testutil.Equals(t, 500*time.Millisecond, d.RetryAfter)
+	// Other organizations have their own buckets.
+	testutil.Equals(t, allowed, l.Allow("bar", "a"))
+	for i := 0; i < 10; i++ {
+		testutil.Equals(t, allowed, l.Allow("unlimited", "a"))
+	}
+
+	now = now.Add(time.Second)
+	testutil.Equals(t, allowed, l.Allow("foo", "a"))
+
+	// Idle buckets are discarded.
+	now = now.Add(time.Hour)
+	l.Allow("foo", "")
+	testutil.Equals(t, 1, len(l.buckets))
+}
+
+func TestAllowResults(t *testing.T) {
+	now := time.Date(2023, 8, 1, 18, 0, 0, 0, time.UTC)
+	fs := &fakeStatistics{counts: map[string]int64{"foo": 8}}
+	l := NewLimiter(&Config{Default: Limits{ResultsPerDay: 10}}, fs, time.Minute, nil)
+	l.now = func() time.Time { return now }
+
+	// Results count against the quota only once they are created.
+	for i := 0; i < 3; i++ {
+		d, err := l.AllowResults(context.Background(), "foo", 2)
+		testutil.Ok(t, err)
+		testutil.Equals(t, allowed, d)
+	}
+	l.AddResults("foo", 2)
+	d, err := l.AllowResults(context.Background(), "foo", 1)
+	testutil.Ok(t, err)
+	testutil.Equals(t, ResultsQuota, d.Reason)
+	testutil.Equals(t, 6*time.Hour, d.RetryAfter)
+	testutil.Equals(t, 1, fs.calls)
+
+	// The quota resets at midnight.
+	now = now.Add(6 * time.Hour)
+	fs.counts["foo"] = 0
+	d, err = l.AllowResults(context.Background(), "foo", 1)
+	testutil.Ok(t, err)
+	testutil.Equals(t, allowed, d)
+	testutil.Equals(t, 2, fs.calls)
+}
+
+func TestAllowPayload(t *testing.T) {
+	l := NewLimiter(&Config{Default: Limits{MaxPayloadBytes: 1024}}, nil, time.Minute, nil)
+	testutil.Equals(t, allowed, l.AllowPayload("foo", 1024))
+	// The size of chunked requests is unknown.
+	testutil.Equals(t, allowed, l.AllowPayload("foo", -1))
+	testutil.Equals(t, PayloadSize, l.AllowPayload("foo", 1025).Reason)
+}
+
+func TestLoadConfig(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "limits.yaml")
+	testutil.Ok(t, os.WriteFile(path, []byte(`
+default:
+  requestsPerSecond: 50
+  resultsPerDay: 1000000
+organizations:
+  acme:
+    requestsPerSecond: 200
+    maxPayloadBytes: 1048576
+`), 0o600))
+	c, err := LoadConfig(path)
+	testutil.Ok(t, err)
+	testutil.Equals(t, Limits{RequestsPerSecond: 200, MaxPayloadBytes: 1048576}, c.Limits("acme"))
+	testutil.Equals(t, Limits{RequestsPerSecond: 50, ResultsPerDay: 1000000}, c.Limits("other"))
+
+	testutil.Ok(t, os.WriteFile(path, []byte("default: {requestsPerSecond: -1}"), 0o600))
+	_, err = LoadConfig(path)
+	testutil.NotOk(t, err)
+}
@@ -0,0 +1,177 @@
+package reporter
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"math/rand"
+	"net/http"
+
+	"github.com/connylabs/model-tracking/api/v1alpha1"
+)
+
+// Redactor removes sensitive data from the input and output of a result before it is recorded,
+// e.g. personal data. It is given the request that produced the result.
+// If it returns an error, the result is not recorded.
+type Redactor func(r *http.Request, input, output []byte) (redactedInput, redactedOutput []byte, err error)
+
+// MiddlewareOption configures the middleware of a reporter.
+type MiddlewareOption func(*middleware)
+
+// WithSampleRate sets the fraction of requests whose results are recorded. Defaults to 1.
+func WithSampleRate(rate float64) MiddlewareOption {
+	return func(m *middleware) {
+		m.rate = rate
+	}
+}
+
+// WithRedactor sets a function that redacts the inputs and outputs of results before they are recorded.
+// Redactors are applied in the order in which they are given.
+func WithRedactor(redact Redactor) MiddlewareOption {
+	return func(m *middleware) {
+		m.redactors = append(m.redactors, redact)
+	}
+}
+
+// WithMaxBodyBytes sets the maximum size in bytes of the request and response bodies to capture.
+// The results of requests with larger bodies are not recorded. Defaults to 1MiB.
+func WithMaxBodyBytes(n int) MiddlewareOption {
+	return func(m *middleware) {
+		m.maxBodyBytes = n
+	}
+}
+
+// WithCorrelationIDHeader sets the request header whose value is recorded as the correlation ID of results,
+// e.g. X-Request-Id, so that the results of different versions for the same request can be compared.
+func WithCorrelationIDHeader(header string) MiddlewareOption {
+	return func(m *middleware) {
+		m.correlationIDHeader = header
+	}
+}
+
+type middleware struct {
+	reporter            *Reporter
+	rate                float64
+	redactors           []Redactor
+	maxBodyBytes        int
+	correlationIDHeader string
+}
+
+// Middleware returns middleware for the net/http handler of a model that records
+// the JSON body of every request as the input and the JSON body of the response as the output
+// of a result with an unknown true output. Only successful responses are recorded.
+// Results are recorded asynchronously, so the middleware only adds the time
+// to copy the bodies to the latency of the model.
+func (r *Reporter) Middleware(opts ...MiddlewareOption) func(http.Handler) http.Handler {
+	m := &middleware{
+		reporter:     r,
+		rate:         1,
+		maxBodyBytes: 1 << 20,
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+			if m.rate < 1 && rand.Float64() >= m.rate {
+				next.ServeHTTP(w, req)
+				return
+			}
+
+			input, ok := m.captureRequest(req)
+			cw := &captureWriter{ResponseWriter: w, status: http.StatusOK, max: m.maxBodyBytes}
+			next.ServeHTTP(cw, req)
+			if !ok || cw.overflow || cw.status/100 != 2 {
+				return
+			}
+			m.record(req, input, cw.body.Bytes())
+		})
+	}
+}
+
+// captureRequest reads the body of the request, if it is not too large, and replaces it,
+// so that the handler can read it again.
+func (m *middleware) captureRequest(req *http.Request) ([]byte, bool) {
+	if req.Body == nil || req.Body == http.NoBody {
+		return nil, false
+	}
+	b, err := io.ReadAll(io.LimitReader(req.Body, int64(m.maxBodyBytes)+1))
+	body := struct {
+		io.Reader
+		io.Closer
+	}{io.MultiReader(bytes.NewReader(b), req.Body), req.Body}
+	req.Body = body
+	if err != nil || len(b) > m.maxBodyBytes {
+		return nil, false
+	}
+	return b, true
+}
+
+// record redacts and records a result.
+func (m *middleware) record(req *http.Request, input, output []byte) {
+	var err error
+	for _, redact := range m.redactors {
+		if input, output, err = redact(req, input, output); err != nil {
+			return
+		}
+	}
+	if !json.Valid(input) || !json.Valid(output) {
+		return
+	}
+	res := v1alpha1.NewResult{
+		Input:  json.RawMessage(bytes.TrimSpace(input)),
+		Output: json.RawMessage(bytes.TrimSpace(output)),
+		// The true output is not known yet.
+		TrueOutput: json.RawMessage("null"),
+	}
+	if m.correlationIDHeader != "" {
+		if id := req.Header.Get(m.correlationIDHeader); id != "" {
+			res.CorrelationID = &id
+		}
+	}
+	// Results that do not fit in the buffer are dropped and counted by the reporter.
+	m.reporter.RecordResult(res) //nolint:errcheck
+}
+
+// captureWriter is a response writer that keeps a copy of the status code and the body.
+type captureWriter struct {
+	http.ResponseWriter
+	status   int
+	wrote    bool
+	max      int
+	overflow bool
+	body     bytes.Buffer
+}
+
+func (cw *captureWriter) WriteHeader(status int) {
+	if !cw.wrote {
+		cw.status = status
+		cw.wrote = true
+	}
+	cw.ResponseWriter.WriteHeader(status)
+}
+
+func (cw *captureWriter) Write(b []byte) (int, error) {
+	cw.wrote = true
+	if !cw.overflow {
+		if cw.body.Len()+len(b) > cw.max {
+			cw.overflow = true
+			cw.body = bytes.Buffer{}
+		} else {
+			cw.body.Write(b)
+		}
+	}
+	return cw.ResponseWriter.Write(b)
+}
+
+// Flush implements http.Flusher for handlers that stream their responses.
+func (cw *captureWriter) Flush() {
+	if f, ok := cw.ResponseWriter.(http.Flusher); ok {
+		f.Flush()
+	}
+}
+
+// Unwrap returns the original response writer, so that http.ResponseController can use it.
+func (cw *captureWriter) Unwrap() http.ResponseWriter {
+	return cw.ResponseWriter
+}
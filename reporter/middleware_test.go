@@ -0,0 +1,89 @@
+package reporter
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/efficientgo/core/testutil"
+)
+
+func TestMiddleware(t *testing.T) {
+	fa := newFakeAPI(t)
+	r, err := New(fa.URL, "foo", "bar", "v1")
+	testutil.Ok(t, err)
+
+	model := http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		var in struct {
+			Amount int    `json:"amount"`
+			Email  string `json:"email"`
+		}
+		if err := json.NewDecoder(req.Body).Decode(&in); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(map[string]bool{"fraud": in.Amount > 100}) //nolint:errcheck
+	})
+	redact := func(_ *http.Request, input, output []byte) ([]byte, []byte, error) {
+		if bytes.Contains(input, []byte("secret")) {
+			return nil, nil, errors.New("the input holds a secret")
+		}
+		return bytes.ReplaceAll(input, []byte("alice@example.com"), []byte("REDACTED")), output, nil
+	}
+	h := r.Middleware(WithRedactor(redact), WithMaxBodyBytes(64), WithCorrelationIDHeader("X-Request-Id"))(model)
+
+	for _, tc := range []struct {
+		name   string
+		body   string
+		status int
+	}{
+		{name: "recorded", body: `{"amount": 200, "email": "alice@example.com"}`, status: http.StatusOK},
+		{name: "failed", body: `{"amount": "200"}`, status: http.StatusBadRequest},
+		{name: "redactor error", body: `{"amount": 1, "email": "secret"}`, status: http.StatusOK},
+		{name: "too large", body: `{"amount": 1, "email": "` + strings.Repeat("a", 64) + `"}`, status: http.StatusOK},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodPost, "/predict", strings.NewReader(tc.body))
+			req.Header.Set("X-Request-Id", tc.name)
+			w := httptest.NewRecorder()
+			h.ServeHTTP(w, req)
+			testutil.Equals(t, tc.status, w.Code)
+		})
+	}
+	testutil.Ok(t, r.Close(context.Background()))
+
+	testutil.Equals(t, 1, fa.count())
+	res := fa.results[0]
+	testutil.Equals(t, `{"amount":200,"email":"REDACTED"}`, string(res.Input))
+	testutil.Equals(t, `{"fraud":true}`, string(res.Output))
+	testutil.Equals(t, `null`, string(res.TrueOutput))
+	testutil.Equals(t, "recorded", *res.CorrelationID)
+}
+
+func TestMiddlewareSampling(t *testing.T) {
+	fa := newFakeAPI(t)
+	r, err := New(fa.URL, "foo", "bar", "v1")
+	testutil.Ok(t, err)
+
+	var bodies []string
+	h := r.Middleware(WithSampleRate(0))(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		b, err := io.ReadAll(req.Body)
+		testutil.Ok(t, err)
+		bodies = append(bodies, string(b))
+		w.Write([]byte(`1`)) //nolint:errcheck
+	}))
+	for i := 0; i < 10; i++ {
+		h.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodPost, "/", strings.NewReader(`"a"`)))
+	}
+	testutil.Ok(t, r.Close(context.Background()))
+	testutil.Equals(t, 0, fa.count())
+	testutil.Equals(t, 10, len(bodies))
+	testutil.Equals(t, `"a"`, bodies[0])
+}
@@ -0,0 +1,449 @@
+// Package reporter records the results of models with model-tracking from the services that serve them.
+//
+// A reporter buffers results and creates them in batches in the background, e.g.:
+//
+//	r, err := reporter.New("http://model-tracking:8080/api/v1alpha1", "acme", "fraud-detector", "v1")
+//	defer r.Close(context.Background())
+//	r.Record(input, output, trueOutput)
+package reporter
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	mathrand "math/rand"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/go-kit/log"
+	"github.com/go-kit/log/level"
+	"github.com/prometheus/client_golang/prometheus"
+
+	"github.com/connylabs/model-tracking/api/v1alpha1"
+)
+
+var (
+	// ErrBufferFull is returned when a result is recorded while the buffer is full,
+	// e.g. because model-tracking is unavailable. The result is dropped.
+	ErrBufferFull = errors.New("the buffer of the reporter is full")
+	// ErrClosed is returned when a result is recorded after the reporter was closed.
+	ErrClosed = errors.New("the reporter is closed")
+)
+
+// Option configures a reporter.
+type Option func(*Reporter)
+
+// WithHTTPClient sets the client with which to send requests to model-tracking,
+// e.g. to use TLS client certificates. Defaults to a client with a timeout of 30 seconds.
+func WithHTTPClient(client v1alpha1.HttpRequestDoer) Option {
+	return func(r *Reporter) {
+		r.client = client
+	}
+}
+
+// WithRequestEditorFn sets a function that edits every request to model-tracking,
+// e.g. to add an authorization header.
+func WithRequestEditorFn(fn v1alpha1.RequestEditorFn) Option {
+	return func(r *Reporter) {
+		r.editors = append(r.editors, fn)
+	}
+}
+
+// WithBufferSize sets the maximum number of results that wait to be sent. Defaults to 10000.
+func WithBufferSize(n int) Option {
+	return func(r *Reporter) {
+		r.bufferSize = n
+	}
+}
+
+// WithBatchSize sets the maximum number of results sent in a single request. Defaults to 500.
+func WithBatchSize(n int) Option {
+	return func(r *Reporter) {
+		r.batchSize = n
+	}
+}
+
+// WithFlushInterval sets the maximum time that results wait before they are sent. Defaults to 5 seconds.
+func WithFlushInterval(d time.Duration) Option {
+	return func(r *Reporter) {
+		r.interval = d
+	}
+}
+
+// WithMaxAttempts sets the number of attempts to send a batch after which it is dropped. Defaults to 5.
+func WithMaxAttempts(n int) Option {
+	return func(r *Reporter) {
+		r.maxAttempts = n
+	}
+}
+
+// WithRegisterer sets the registerer with which to register the metrics of the reporter.
+// By default, the metrics are not registered.
+func WithRegisterer(reg prometheus.Registerer) Option {
+	return func(r *Reporter) {
+		r.reg = reg
+	}
+}
+
+// WithLogger sets the logger of the reporter. By default, nothing is logged.
+func WithLogger(logger log.Logger) Option {
+	return func(r *Reporter) {
+		r.logger = logger
+	}
+}
+
+// Reporter records the results of a version of a model.
+// Results are buffered and created in batches in the background.
+// Batches are retried with exponential backoff and jitter when model-tracking is unavailable;
+// every batch is sent with an Idempotency-Key header, so retries do not create duplicate results.
+// A Reporter is safe for concurrent use.
+type Reporter struct {
+	organization string
+	model        string
+	version      string
+
+	client      v1alpha1.HttpRequestDoer
+	editors     []v1alpha1.RequestEditorFn
+	api         *v1alpha1.Client
+	bufferSize  int
+	batchSize   int
+	interval    time.Duration
+	maxAttempts int
+	backoff     time.Duration
+	maxBackoff  time.Duration
+	reg         prometheus.Registerer
+	logger      log.Logger
+
+	results chan v1alpha1.NewResult
+	flushes chan chan struct{}
+	stop    chan struct{}
+	done    chan struct{}
+	ctx     context.Context
+	cancel  context.CancelFunc
+	mu      sync.RWMutex
+	closed  bool
+
+	resultsTotal *prometheus.CounterVec
+	retries      prometheus.Counter
+	duration     prometheus.Histogram
+}
+
+// New creates a new reporter for a version of a model of an organization
+// that sends results to the model-tracking API at the given URL, e.g. http://model-tracking:8080/api/v1alpha1,
+// and starts sending results in the background. Close must be called to send the remaining results.
+func New(url, organization, model, version string, opts ...Option) (*Reporter, error) {
+	r := &Reporter{
+		organization: organization,
+		model:        model,
+		version:      version,
+		client:       &http.Client{Timeout: 30 * time.Second},
+		bufferSize:   10000,
+		batchSize:    500,
+		interval:     5 * time.Second,
+		maxAttempts:  5,
+		backoff:      100 * time.Millisecond,
+		maxBackoff:   30 * time.Second,
+		logger:       log.NewNopLogger(),
+	}
+	for _, opt := range opts {
+		opt(r)
+	}
+	if r.bufferSize <= 0 || r.batchSize <= 0 || r.interval <= 0 || r.maxAttempts <= 0 {
+		return nil, errors.New("the buffer size, batch size, flush interval and maximum number of attempts must be positive")
+	}
+	api, err := v1alpha1.NewClient(url, v1alpha1.WithHTTPClient(r.client))
+	if err != nil {
+		return nil, err
+	}
+	r.api = api
+
+	r.resultsTotal = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "model_tracking_reporter_results_total",
+		Help: "The number of results handled by the reporter by outcome.",
+	}, []string{"outcome"})
+	r.retries = prometheus.NewCounter(prometheus.CounterOpts{
+		Name: "model_tracking_reporter_retries_total",
+		Help: "The number of retried requests to create batches of results.",
+	})
+	r.duration = prometheus.NewHistogram(prometheus.HistogramOpts{
+		Name:    "model_tracking_reporter_request_duration_seconds",
+		Help:    "The duration of requests to create batches of results.",
+		Buckets: prometheus.DefBuckets,
+	})
+	r.results = make(chan v1alpha1.NewResult, r.bufferSize)
+	if r.reg != nil {
+		r.reg.MustRegister(r.resultsTotal, r.retries, r.duration, prometheus.NewGaugeFunc(prometheus.GaugeOpts{
+			Name: "model_tracking_reporter_buffered_results",
+			Help: "The number of results waiting to be sent.",
+		}, func() float64 {
+			return float64(len(r.results))
+		}))
+	}
+
+	r.flushes = make(chan chan struct{})
+	r.stop = make(chan struct{})
+	r.done = make(chan struct{})
+	r.ctx, r.cancel = context.WithCancel(context.Background())
+	go r.run()
+	return r, nil
+}
+
+// Record records a result with the given input, output and true output,
+// which are encoded as JSON. If the true output is not known yet, it may be nil.
+// Record does not block; it returns ErrBufferFull if the result cannot be buffered.
+func (r *Reporter) Record(input, output, trueOutput interface{}) error {
+	var res v1alpha1.NewResult
+	var err error
+	if res.Input, err = json.Marshal(input); err != nil {
+		return fmt.Errorf("failed to encode input: %w", err)
+	}
+	if res.Output, err = json.Marshal(output); err != nil {
+		return fmt.Errorf("failed to encode output: %w", err)
+	}
+	if res.TrueOutput, err = json.Marshal(trueOutput); err != nil {
+		return fmt.Errorf("failed to encode true output: %w", err)
+	}
+	return r.RecordResult(res)
+}
+
+// RecordResult records a result, e.g. with labels or a correlation ID.
+// If the time of the result is not set, it is set to the current time.
+// RecordResult does not block; it returns ErrBufferFull if the result cannot be buffered.
+func (r *Reporter) RecordResult(res v1alpha1.NewResult) error {
+	if res.Time == nil {
+		now := time.Now()
+		res.Time = &now
+	}
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	if r.closed {
+		return ErrClosed
+	}
+	select {
+	case r.results <- res:
+		r.resultsTotal.WithLabelValues("recorded").Inc()
+		return nil
+	default:
+		r.resultsTotal.WithLabelValues("dropped").Inc()
+		return ErrBufferFull
+	}
+}
+
+// Flush sends the buffered results and waits until they were sent,
+// dropped or the context is canceled.
+func (r *Reporter) Flush(ctx context.Context) error {
+	flushed := make(chan struct{})
+	select {
+	case r.flushes <- flushed:
+	case <-r.done:
+		return ErrClosed
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+	select {
+	case <-flushed:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// Close stops accepting results and sends the buffered ones.
+// If the context is canceled first, the remaining results are dropped
+// and the context's error is returned.
+func (r *Reporter) Close(ctx context.Context) error {
+	r.mu.Lock()
+	if r.closed {
+		r.mu.Unlock()
+		return ErrClosed
+	}
+	r.closed = true
+	r.mu.Unlock()
+	close(r.stop)
+	defer r.cancel()
+	select {
+	case <-r.done:
+		return nil
+	case <-ctx.Done():
+		// Abort the request in flight and drop the remaining results.
+		r.cancel()
+		<-r.done
+		return ctx.Err()
+	}
+}
+
+// run sends batches of results until the reporter is closed.
+func (r *Reporter) run() {
+	defer close(r.done)
+	batch := make([]v1alpha1.NewResult, 0, r.batchSize)
+	var tick <-chan time.Time
+	for {
+		var flushed chan struct{}
+		select {
+		case res := <-r.results:
+			if len(batch) == 0 {
+				tick = time.After(r.interval)
+			}
+			if batch = append(batch, res); len(batch) < r.batchSize {
+				continue
+			}
+		case <-tick:
+		case flushed = <-r.flushes:
+			batch = r.drain(batch)
+		case <-r.stop:
+			// No results can be recorded once the reporter is closed.
+			r.send(r.drain(batch))
+			return
+		}
+		r.send(batch)
+		batch, tick = batch[:0], nil
+		if flushed != nil {
+			close(flushed)
+		}
+	}
+}
+
+// drain sends all buffered results in batches and returns the last, partial batch.
+func (r *Reporter) drain(batch []v1alpha1.NewResult) []v1alpha1.NewResult {
+	for {
+		select {
+		case res := <-r.results:
+			if batch = append(batch, res); len(batch) == r.batchSize {
+				r.send(batch)
+				batch = batch[:0]
+			}
+		default:
+			return batch
+		}
+	}
+}
+
+// send sends a batch of results, retrying with exponential backoff and full jitter.
+// Batches that cannot be sent are dropped.
+func (r *Reporter) send(batch []v1alpha1.NewResult) {
+	if len(batch) == 0 {
+		return
+	}
+	key, err := idempotencyKey()
+	if err != nil {
+		r.drop(batch, err)
+		return
+	}
+	for attempt := 1; ; attempt++ {
+		retryAfter, err := r.create(batch, key)
+		if err == nil {
+			r.resultsTotal.WithLabelValues("sent").Add(float64(len(batch)))
+			return
+		}
+		var pe *permanentError
+		// A conflict after the first attempt may be due to the earlier attempt
+		// with the same idempotency key still being in progress.
+		permanent := errors.As(err, &pe) && !(pe.status == http.StatusConflict && attempt > 1)
+		if permanent || attempt == r.maxAttempts || r.ctx.Err() != nil {
+			r.drop(batch, err)
+			return
+		}
+		level.Debug(r.logger).Log("msg", "failed to send results; retrying", "attempt", attempt, "err", err.Error())
+		r.retries.Inc()
+		if retryAfter == 0 || retryAfter > r.maxBackoff {
+			retryAfter = r.delay(attempt)
+		}
+		select {
+		case <-r.ctx.Done():
+		case <-time.After(retryAfter):
+		}
+	}
+}
+
+func (r *Reporter) drop(batch []v1alpha1.NewResult, err error) {
+	level.Error(r.logger).Log("msg", "dropped results", "results", len(batch), "err", err.Error())
+	r.resultsTotal.WithLabelValues("dropped").Add(float64(len(batch)))
+}
+
+// delay returns a random delay before the given retry of up to
+// the backoff doubled with every attempt, so that clients do not retry in lockstep.
+func (r *Reporter) delay(attempt int) time.Duration {
+	max := time.Duration(float64(r.backoff) * math.Pow(2, float64(attempt-1)))
+	if max <= 0 || max > r.maxBackoff {
+		max = r.maxBackoff
+	}
+	return time.Duration(mathrand.Int63n(int64(max) + 1))
+}
+
+// permanentError is an error after which a request must not be retried,
+// e.g. because the results do not match the schema of their version.
+type permanentError struct {
+	status int
+	detail string
+}
+
+func (e *permanentError) Error() string {
+	return fmt.Sprintf("model-tracking responded with %d: %s", e.status, e.detail)
+}
+
+// create sends a request to create a batch of results. For responses that may be retried,
+// it returns the delay requested by the server in a Retry-After header, if any.
+func (r *Reporter) create(batch []v1alpha1.NewResult, key string) (time.Duration, error) {
+	start := time.Now()
+	defer func() {
+		r.duration.Observe(time.Since(start).Seconds())
+	}()
+	ctx, cancel := context.WithTimeout(r.ctx, r.requestTimeout())
+	defer cancel()
+	res, err := r.api.ResultsCreateBulkForVersion(ctx, r.organization, r.model, r.version, &v1alpha1.ResultsCreateBulkForVersionParams{IdempotencyKey: &key}, batch, r.editors...)
+	if err != nil {
+		return 0, err
+	}
+	defer res.Body.Close()
+	if res.StatusCode == http.StatusCreated {
+		// Drain the body so that the connection can be reused.
+		io.Copy(io.Discard, res.Body) //nolint:errcheck
+		return 0, nil
+	}
+
+	detail := http.StatusText(res.StatusCode)
+	var p v1alpha1.Problem
+	if b, err := io.ReadAll(io.LimitReader(res.Body, 1<<16)); err == nil {
+		if json.Unmarshal(b, &p) == nil && p.Detail != "" {
+			detail = p.Detail
+		} else if len(bytes.TrimSpace(b)) != 0 {
+			detail = string(bytes.TrimSpace(b))
+		}
+	}
+	switch {
+	case res.StatusCode == http.StatusTooManyRequests, res.StatusCode/100 == 5:
+		var retryAfter time.Duration
+		if s, err := strconv.Atoi(res.Header.Get("Retry-After")); err == nil && s > 0 {
+			retryAfter = time.Duration(s) * time.Second
+		}
+		return retryAfter, fmt.Errorf("model-tracking responded with %d: %s", res.StatusCode, detail)
+	default:
+		return 0, &permanentError{status: res.StatusCode, detail: detail}
+	}
+}
+
+// requestTimeout returns the timeout of a single request.
+// Clients with their own timeouts are not limited further.
+func (r *Reporter) requestTimeout() time.Duration {
+	if c, ok := r.client.(*http.Client); ok && c.Timeout > 0 {
+		return c.Timeout
+	}
+	return time.Minute
+}
+
+// idempotencyKey returns a random key for a batch of results.
+func idempotencyKey() (string, error) {
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		return "", fmt.Errorf("failed to generate idempotency key: %w", err)
+	}
+	return hex.EncodeToString(b), nil
+}
@@ -0,0 +1,149 @@
+package reporter
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/efficientgo/core/testutil"
+	"github.com/prometheus/client_golang/prometheus"
+
+	"github.com/connylabs/model-tracking/api/v1alpha1"
+)
+
+// fakeAPI is a stand-in for the endpoint of model-tracking that creates results in bulk.
+type fakeAPI struct {
+	*httptest.Server
+	mu sync.Mutex
+	// failures is the number of requests to fail with the status code.
+	failures int
+	status   int
+	keys     []string
+	results  []v1alpha1.NewResult
+}
+
+func newFakeAPI(t *testing.T) *fakeAPI {
+	fa := new(fakeAPI)
+	fa.Server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		testutil.Equals(t, "/organizations/foo/models/bar/versions/v1/results/bulk", r.URL.Path)
+		fa.mu.Lock()
+		defer fa.mu.Unlock()
+		fa.keys = append(fa.keys, r.Header.Get("Idempotency-Key"))
+		if fa.failures > 0 {
+			fa.failures--
+			w.Header().Set("Content-Type", "application/problem+json")
+			w.WriteHeader(fa.status)
+			json.NewEncoder(w).Encode(&v1alpha1.Problem{Status: fa.status, Detail: "failed"}) //nolint:errcheck
+			return
+		}
+		var rs []v1alpha1.NewResult
+		testutil.Ok(t, json.NewDecoder(r.Body).Decode(&rs))
+		fa.results = append(fa.results, rs...)
+		w.WriteHeader(http.StatusCreated)
+		json.NewEncoder(w).Encode(&v1alpha1.BulkResults{Count: len(rs)}) //nolint:errcheck
+	}))
+	t.Cleanup(fa.Close)
+	return fa
+}
+
+func (fa *fakeAPI) fail(n, status int) {
+	fa.mu.Lock()
+	defer fa.mu.Unlock()
+	fa.failures, fa.status = n, status
+}
+
+func (fa *fakeAPI) count() int {
+	fa.mu.Lock()
+	defer fa.mu.Unlock()
+	return len(fa.results)
+}
+
+func TestReporter(t *testing.T) {
+	fa := newFakeAPI(t)
+	reg := prometheus.NewRegistry()
+	r, err := New(fa.URL, "foo", "bar", "v1", WithBatchSize(2), WithFlushInterval(time.Hour), WithRegisterer(reg))
+	testutil.Ok(t, err)
+	r.backoff = time.Millisecond
+	ctx := context.Background()
+
+	// Full batches are sent right away.
+	testutil.Ok(t, r.Record("a", 1, 1))
+	testutil.Ok(t, r.Record("b", 2, nil))
+	for i := 0; fa.count() != 2; i++ {
+		testutil.Assert(t, i < 100, "the batch should be sent")
+		time.Sleep(10 * time.Millisecond)
+	}
+	fa.mu.Lock()
+	testutil.Equals(t, `"a"`, string(fa.results[0].Input))
+	testutil.Equals(t, `null`, string(fa.results[1].TrueOutput))
+	testutil.Assert(t, fa.results[0].Time != nil, "the time of the result should be set")
+	fa.mu.Unlock()
+
+	// Batches are retried with the same idempotency key.
+	fa.fail(2, http.StatusServiceUnavailable)
+	testutil.Ok(t, r.Record("c", 3, 3))
+	testutil.Ok(t, r.Flush(ctx))
+	testutil.Equals(t, 3, fa.count())
+	fa.mu.Lock()
+	keys := fa.keys[len(fa.keys)-3:]
+	fa.mu.Unlock()
+	testutil.Assert(t, keys[0] != "" && keys[0] == keys[1] && keys[1] == keys[2], "retries should use the same idempotency key")
+
+	// Invalid batches are dropped without retrying.
+	fa.fail(1, http.StatusUnprocessableEntity)
+	testutil.Ok(t, r.Record("d", 4, 4))
+	testutil.Ok(t, r.Flush(ctx))
+	testutil.Equals(t, 3, fa.count())
+
+	// Batches are dropped after the maximum number of attempts.
+	fa.fail(5, http.StatusInternalServerError)
+	testutil.Ok(t, r.Record("e", 5, 5))
+	testutil.Ok(t, r.Flush(ctx))
+	testutil.Equals(t, 3, fa.count())
+
+	// Closing sends the remaining results.
+	testutil.Ok(t, r.RecordResult(v1alpha1.NewResult{Input: json.RawMessage(`"f"`), Output: json.RawMessage(`6`), TrueOutput: json.RawMessage(`6`), CorrelationID: &[]string{"x"}[0]}))
+	testutil.Ok(t, r.Close(ctx))
+	testutil.Equals(t, 4, fa.count())
+	testutil.Equals(t, "x", *fa.results[3].CorrelationID)
+	testutil.Equals(t, ErrClosed, r.Record("g", 7, 7))
+
+	mfs, err := reg.Gather()
+	testutil.Ok(t, err)
+	for _, mf := range mfs {
+		if mf.GetName() != "model_tracking_reporter_results_total" {
+			continue
+		}
+		outcomes := make(map[string]float64)
+		for _, m := range mf.GetMetric() {
+			outcomes[m.GetLabel()[0].GetValue()] = m.GetCounter().GetValue()
+		}
+		testutil.Equals(t, map[string]float64{"recorded": 6, "sent": 4, "dropped": 2}, outcomes)
+	}
+}
+
+func TestReporterBufferFull(t *testing.T) {
+	fa := newFakeAPI(t)
+	fa.fail(1000, http.StatusServiceUnavailable)
+	r, err := New(fa.URL, "foo", "bar", "v1", WithBufferSize(1), WithBatchSize(1), WithMaxAttempts(1000))
+	testutil.Ok(t, err)
+
+	// The first result is sent and retried, so the next one fills the buffer.
+	testutil.Ok(t, r.Record("a", 1, 1))
+	for i := 0; len(r.results) != 0; i++ {
+		testutil.Assert(t, i < 100, "the result should be taken from the buffer")
+		time.Sleep(10 * time.Millisecond)
+	}
+	testutil.Ok(t, r.Record("b", 2, 2))
+	testutil.Equals(t, ErrBufferFull, r.Record("c", 3, 3))
+
+	// Closing gives up once the context is canceled.
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	testutil.Equals(t, context.DeadlineExceeded, r.Close(ctx))
+	testutil.Equals(t, 0, fa.count())
+}
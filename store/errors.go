@@ -0,0 +1,106 @@
+package store
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/go-jet/jet/v2/qrm"
+	"github.com/jackc/pgx"
+)
+
+// The kinds of errors returned by stores.
+// Use errors.Is to check whether an error is of a kind.
+var (
+	// ErrNotFound is returned when a resource does not exist.
+	ErrNotFound = errors.New("not found")
+	// ErrConflict is returned when a change conflicts with the current state of the store,
+	// e.g. when a resource with the same name already exists.
+	ErrConflict = errors.New("conflict")
+	// ErrInvalid is returned when a resource is invalid,
+	// e.g. when it references a resource that does not exist.
+	ErrInvalid = errors.New("invalid")
+	// ErrPreconditionFailed is returned when a resource was changed
+	// since the version that a change was based on.
+	ErrPreconditionFailed = errors.New("precondition failed")
+)
+
+// Error is an error of a kind, e.g. ErrNotFound or ErrConflict,
+// with a message that may be shown to users.
+type Error struct {
+	// Kind is the kind of the error.
+	Kind error
+	// Message describes the error for users.
+	Message string
+	// Err is the underlying error, if any.
+	Err error
+}
+
+func (e *Error) Error() string {
+	return e.Message
+}
+
+// Unwrap returns the kind and the underlying error.
+func (e *Error) Unwrap() []error {
+	if e.Err == nil {
+		return []error{e.Kind}
+	}
+	return []error{e.Kind, e.Err}
+}
+
+// notFound returns an ErrNotFound error for the described resource.
+func notFound(err error, format string, a ...interface{}) error {
+	return &Error{Kind: ErrNotFound, Message: fmt.Sprintf(format, a...) + " does not exist", Err: err}
+}
+
+// changed returns an ErrPreconditionFailed error for the described resource.
+func changed(format string, a ...interface{}) error {
+	return &Error{Kind: ErrPreconditionFailed, Message: fmt.Sprintf(format, a...) + " was changed in the meantime"}
+}
+
+// PostgreSQL error codes, see https://www.postgresql.org/docs/current/errcodes-appendix.html.
+const (
+	pgNotNullViolation    = "23502"
+	pgForeignKeyViolation = "23503"
+	pgUniqueViolation     = "23505"
+	pgCheckViolation      = "23514"
+	// pgDataException is the class of errors caused by invalid values.
+	pgDataException = "22"
+)
+
+// sqlError translates an error of the database into an error of the store.
+// If rows are expected, missing rows mean that the described resource does not exist.
+// Errors that are already errors of the store and unexpected errors are returned as is.
+func sqlError(err error, format string, a ...interface{}) error {
+	if err == nil {
+		return nil
+	}
+	var se *Error
+	if errors.As(err, &se) {
+		return err
+	}
+	if errors.Is(err, qrm.ErrNoRows) {
+		return notFound(err, format, a...)
+	}
+	var pe pgx.PgError
+	if !errors.As(err, &pe) {
+		return err
+	}
+	resource := strings.ReplaceAll(strings.ToLower(pe.TableName), "_", " ")
+	if resource == "" {
+		resource = "resource"
+	}
+	switch {
+	case pe.Code == pgUniqueViolation:
+		article := "a"
+		if strings.ContainsAny(resource[:1], "aeiou") {
+			article = "an"
+		}
+		return &Error{Kind: ErrConflict, Message: fmt.Sprintf("%s %s with the same name already exists", article, resource), Err: err}
+	case pe.Code == pgForeignKeyViolation:
+		return &Error{Kind: ErrInvalid, Message: fmt.Sprintf("the %s references a resource that does not exist", resource), Err: err}
+	case pe.Code == pgNotNullViolation, pe.Code == pgCheckViolation, strings.HasPrefix(pe.Code, pgDataException):
+		return &Error{Kind: ErrInvalid, Message: pe.Message, Err: err}
+	}
+	return err
+}
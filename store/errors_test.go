@@ -0,0 +1,62 @@
+package store
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/efficientgo/core/testutil"
+	"github.com/go-jet/jet/v2/qrm"
+	"github.com/jackc/pgx"
+)
+
+func TestSQLError(t *testing.T) {
+	for _, tc := range []struct {
+		name    string
+		err     error
+		kind    error
+		message string
+	}{
+		{
+			name:    "no rows",
+			err:     qrm.ErrNoRows,
+			kind:    ErrNotFound,
+			message: `model "foo" does not exist`,
+		},
+		{
+			name:    "unique violation",
+			err:     pgx.PgError{Code: pgUniqueViolation, TableName: "alert_rule"},
+			kind:    ErrConflict,
+			message: "an alert rule with the same name already exists",
+		},
+		{
+			name:    "foreign key violation",
+			err:     fmt.Errorf("wrapped: %w", pgx.PgError{Code: pgForeignKeyViolation, TableName: "version"}),
+			kind:    ErrInvalid,
+			message: "the version references a resource that does not exist",
+		},
+		{
+			name:    "invalid value",
+			err:     pgx.PgError{Code: "22P02", Message: "invalid input syntax for type json"},
+			kind:    ErrInvalid,
+			message: "invalid input syntax for type json",
+		},
+		{
+			name:    "translated",
+			err:     ErrNoPreviousVersion,
+			kind:    ErrConflict,
+			message: "the stage has no previous version",
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			err := sqlError(tc.err, "model %q", "foo")
+			testutil.Assert(t, errors.Is(err, tc.kind), "expected an error of kind %v, got %v", tc.kind, err)
+			testutil.Assert(t, errors.Is(err, tc.err))
+			testutil.Equals(t, tc.message, err.Error())
+		})
+	}
+
+	err := errors.New("connection refused")
+	testutil.Equals(t, err, sqlError(err, "model %q", "foo"))
+	testutil.Ok(t, sqlError(nil, "model %q", "foo"))
+}
@@ -0,0 +1,97 @@
+package store
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/go-jet/jet/v2/postgres"
+	"github.com/go-jet/jet/v2/qrm"
+
+	"github.com/connylabs/model-tracking/store/model-tracking/public/table"
+)
+
+// EventType is the type of an event emitted when a resource changes.
+type EventType string
+
+const (
+	// EventModelCreated is emitted when a model is created.
+	EventModelCreated EventType = "model.created"
+	// EventModelUpdated is emitted when a model is updated.
+	EventModelUpdated EventType = "model.updated"
+	// EventSchemaCreated is emitted when a schema is created.
+	EventSchemaCreated EventType = "schema.created"
+	// EventVersionCreated is emitted when a version is created,
+	// including when it is created automatically for a new result.
+	EventVersionCreated EventType = "version.created"
+	// EventVersionUpdated is emitted when the metadata of a version is updated.
+	EventVersionUpdated EventType = "version.updated"
+	// EventResultBatchCreated is emitted once for all results that are created together,
+	// e.g. in bulk, rather than once for every result.
+	EventResultBatchCreated EventType = "result.batch_created"
+	// EventStageUpdated is emitted when a stage is promoted or rolled back.
+	EventStageUpdated EventType = "stage.updated"
+)
+
+// EventsChannel is the PostgreSQL notification channel on which all events are published
+// when the transactions emitting them are committed, so that they can be received with LISTEN.
+const EventsChannel = "model_tracking_events"
+
+// EventTypes contains all types of events.
+var EventTypes = []EventType{
+	EventModelCreated,
+	EventModelUpdated,
+	EventSchemaCreated,
+	EventVersionCreated,
+	EventVersionUpdated,
+	EventResultBatchCreated,
+	EventStageUpdated,
+}
+
+// Event describes a change to a resource of an organization.
+// It is the payload delivered to webhooks.
+type Event struct {
+	Type         EventType `json:"type"`
+	Organization string    `json:"organization"`
+	Model        string    `json:"model,omitempty"`
+	Schema       string    `json:"schema,omitempty"`
+	Version      string    `json:"version,omitempty"`
+	Stage        string    `json:"stage,omitempty"`
+	// ID is the ID of the resource that changed.
+	// For a batch of results, it is the lowest ID of the batch.
+	ID int32 `json:"id"`
+	// LastID is the highest ID of a batch of results.
+	// Results of other batches created concurrently may have IDs between ID and LastID.
+	LastID int32 `json:"lastId,omitempty"`
+	// Count is the number of results in a batch.
+	Count int `json:"count,omitempty"`
+}
+
+// emit enqueues a delivery of the event to every webhook of the organization
+// that is subscribed to the type of the event and publishes it on the EventsChannel.
+// It must be called within the transaction that changes the resource,
+// so that events are emitted if and only if the change is committed.
+func emit(ctx context.Context, tx qrm.DB, organization int32, e Event) error {
+	// Marshalling an event cannot fail.
+	payload, _ := json.Marshal(e)
+	_, err := table.WebhookDelivery.INSERT(
+		table.WebhookDelivery.Webhook,
+		table.WebhookDelivery.Event,
+		table.WebhookDelivery.Payload,
+	).QUERY(
+		postgres.SELECT(
+			table.Webhook.ID,
+			postgres.String(string(e.Type)),
+			postgres.StringExp(postgres.Raw("#payload::jsonb", postgres.RawArgs{"#payload": string(payload)})),
+		).FROM(
+			table.Webhook,
+		).WHERE(
+			table.Webhook.Organization.EQ(postgres.Int32(organization)).
+				AND(postgres.BoolExp(postgres.Raw("(webhook.events = '[]'::jsonb OR webhook.events ? #type)", postgres.RawArgs{"#type": string(e.Type)}))),
+		),
+	).ExecContext(ctx, tx)
+	if err != nil {
+		return err
+	}
+	_, err = tx.ExecContext(ctx, "SELECT pg_notify($1, $2)", EventsChannel, string(payload))
+	return err
+}
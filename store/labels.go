@@ -0,0 +1,60 @@
+package store
+
+import (
+	"encoding/json"
+
+	"github.com/go-jet/jet/v2/postgres"
+
+	"github.com/connylabs/model-tracking/labels"
+)
+
+// labelsExpression translates a label selector into a boolean expression
+// on the JSONB labels column with the given qualified name, e.g. "result.labels".
+// Equality requirements use containment so that they can be served by a GIN index.
+func labelsExpression(column string, s labels.Selector) postgres.BoolExpression {
+	exp := postgres.Bool(true)
+	for _, r := range s {
+		exp = exp.AND(requirementExpression(column, r))
+	}
+	return exp
+}
+
+func requirementExpression(column string, r labels.Requirement) postgres.BoolExpression {
+	exists := postgres.BoolExp(postgres.Raw(column+" ? #key", postgres.RawArgs{"#key": r.Key}))
+	value := postgres.RawString(column+" ->> #key", postgres.RawArgs{"#key": r.Key})
+	values := make([]postgres.Expression, 0, len(r.Values))
+	for _, v := range r.Values {
+		values = append(values, postgres.String(v))
+	}
+
+	switch r.Operator {
+	case labels.Equals:
+		return contains(column, r.Key, r.Values[0])
+	case labels.NotEquals:
+		return postgres.NOT(contains(column, r.Key, r.Values[0]))
+	case labels.In:
+		return value.IN(values...)
+	case labels.NotIn:
+		return postgres.NOT(exists).OR(value.NOT_IN(values...))
+	case labels.Exists:
+		return exists
+	case labels.DoesNotExist:
+		return postgres.NOT(exists)
+	}
+	return postgres.Bool(false)
+}
+
+// labelsOrEmpty returns the given JSON-encoded labels or an empty JSON object
+// if no labels were given.
+func labelsOrEmpty(l string) string {
+	if l == "" {
+		return "{}"
+	}
+	return l
+}
+
+func contains(column, key, value string) postgres.BoolExpression {
+	// Marshalling a map of strings cannot fail.
+	l, _ := json.Marshal(map[string]string{key: value})
+	return postgres.BoolExp(postgres.Raw(column+" @> #labels::jsonb", postgres.RawArgs{"#labels": string(l)}))
+}
@@ -18,4 +18,7 @@ type Model struct {
 	Created       *time.Time
 	Updated       *time.Time
 	DefaultSchema *int32
+	Description   *string
+	Labels        string
+	Archived      bool
 }
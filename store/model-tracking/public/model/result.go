@@ -12,14 +12,17 @@ import (
 )
 
 type Result struct {
-	ID           int32 `sql:"primary_key"`
-	Organization int32
-	Model        int32
-	Version      int32
-	Input        []byte
-	Output       []byte
-	TrueOutput   []byte
-	Time         time.Time
-	Created      *time.Time
-	Updated      *time.Time
+	ID            int32 `sql:"primary_key"`
+	Organization  int32
+	Model         int32
+	Version       int32
+	Input         []byte
+	Output        []byte
+	TrueOutput    []byte
+	Time          time.Time
+	Created       *time.Time
+	Updated       *time.Time
+	Labels        string
+	CorrelationID *string
+	ClientID      *string
 }
@@ -12,11 +12,18 @@ import (
 )
 
 type Version struct {
-	ID           int32 `sql:"primary_key"`
-	Name         string
-	Organization int32
-	Model        int32
-	Schema       int32
-	Created      *time.Time
-	Updated      *time.Time
+	ID              int32 `sql:"primary_key"`
+	Name            string
+	Organization    int32
+	Model           int32
+	Schema          int32
+	Created         *time.Time
+	Updated         *time.Time
+	ArtifactURI     *string
+	Commit          *string
+	Dataset         *string
+	Hyperparameters *string
+	Description     *string
+	Labels          string
+	Archived        bool
 }
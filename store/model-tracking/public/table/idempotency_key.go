@@ -0,0 +1,99 @@
+//
+// Code generated by go-jet DO NOT EDIT.
+//
+// WARNING: Changes to this file may cause incorrect behavior
+// and will be lost if the code is regenerated
+//
+
+package table
+
+import (
+	"github.com/go-jet/jet/v2/postgres"
+)
+
+var IdempotencyKey = newIdempotencyKeyTable("public", "idempotency_key", "")
+
+type idempotencyKeyTable struct {
+	postgres.Table
+
+	//Columns
+	ID           postgres.ColumnInteger
+	Organization postgres.ColumnInteger
+	Key          postgres.ColumnString
+	Fingerprint  postgres.ColumnString
+	Status       postgres.ColumnInteger
+	Response     postgres.ColumnString
+	Expires      postgres.ColumnTimestamp
+	Created      postgres.ColumnTimestamp
+	Updated      postgres.ColumnTimestamp
+
+	AllColumns     postgres.ColumnList
+	MutableColumns postgres.ColumnList
+}
+
+type IdempotencyKeyTable struct {
+	idempotencyKeyTable
+
+	EXCLUDED idempotencyKeyTable
+}
+
+// AS creates new IdempotencyKeyTable with assigned alias
+func (a IdempotencyKeyTable) AS(alias string) *IdempotencyKeyTable {
+	return newIdempotencyKeyTable(a.SchemaName(), a.TableName(), alias)
+}
+
+// Schema creates new IdempotencyKeyTable with assigned schema name
+func (a IdempotencyKeyTable) FromSchema(schemaName string) *IdempotencyKeyTable {
+	return newIdempotencyKeyTable(schemaName, a.TableName(), a.Alias())
+}
+
+// WithPrefix creates new IdempotencyKeyTable with assigned table prefix
+func (a IdempotencyKeyTable) WithPrefix(prefix string) *IdempotencyKeyTable {
+	return newIdempotencyKeyTable(a.SchemaName(), prefix+a.TableName(), a.TableName())
+}
+
+// WithSuffix creates new IdempotencyKeyTable with assigned table suffix
+func (a IdempotencyKeyTable) WithSuffix(suffix string) *IdempotencyKeyTable {
+	return newIdempotencyKeyTable(a.SchemaName(), a.TableName()+suffix, a.TableName())
+}
+
+func newIdempotencyKeyTable(schemaName, tableName, alias string) *IdempotencyKeyTable {
+	return &IdempotencyKeyTable{
+		idempotencyKeyTable: newIdempotencyKeyTableImpl(schemaName, tableName, alias),
+		EXCLUDED:            newIdempotencyKeyTableImpl("", "excluded", ""),
+	}
+}
+
+func newIdempotencyKeyTableImpl(schemaName, tableName, alias string) idempotencyKeyTable {
+	var (
+		IDColumn           = postgres.IntegerColumn("id")
+		OrganizationColumn = postgres.IntegerColumn("organization")
+		KeyColumn          = postgres.StringColumn("key")
+		FingerprintColumn  = postgres.StringColumn("fingerprint")
+		StatusColumn       = postgres.IntegerColumn("status")
+		ResponseColumn     = postgres.StringColumn("response")
+		ExpiresColumn      = postgres.TimestampColumn("expires")
+		CreatedColumn      = postgres.TimestampColumn("created")
+		UpdatedColumn      = postgres.TimestampColumn("updated")
+		allColumns         = postgres.ColumnList{IDColumn, OrganizationColumn, KeyColumn, FingerprintColumn, StatusColumn, ResponseColumn, ExpiresColumn, CreatedColumn, UpdatedColumn}
+		mutableColumns     = postgres.ColumnList{OrganizationColumn, KeyColumn, FingerprintColumn, StatusColumn, ResponseColumn, ExpiresColumn, CreatedColumn, UpdatedColumn}
+	)
+
+	return idempotencyKeyTable{
+		Table: postgres.NewTable(schemaName, tableName, alias, allColumns...),
+
+		//Columns
+		ID:           IDColumn,
+		Organization: OrganizationColumn,
+		Key:          KeyColumn,
+		Fingerprint:  FingerprintColumn,
+		Status:       StatusColumn,
+		Response:     ResponseColumn,
+		Expires:      ExpiresColumn,
+		Created:      CreatedColumn,
+		Updated:      UpdatedColumn,
+
+		AllColumns:     allColumns,
+		MutableColumns: mutableColumns,
+	}
+}
@@ -23,6 +23,9 @@ type modelTable struct {
 	Created       postgres.ColumnTimestamp
 	Updated       postgres.ColumnTimestamp
 	DefaultSchema postgres.ColumnInteger
+	Description   postgres.ColumnString
+	Labels        postgres.ColumnString
+	Archived      postgres.ColumnBool
 
 	AllColumns     postgres.ColumnList
 	MutableColumns postgres.ColumnList
@@ -69,8 +72,11 @@ func newModelTableImpl(schemaName, tableName, alias string) modelTable {
 		CreatedColumn       = postgres.TimestampColumn("created")
 		UpdatedColumn       = postgres.TimestampColumn("updated")
 		DefaultSchemaColumn = postgres.IntegerColumn("default_schema")
-		allColumns          = postgres.ColumnList{IDColumn, NameColumn, OrganizationColumn, CreatedColumn, UpdatedColumn, DefaultSchemaColumn}
-		mutableColumns      = postgres.ColumnList{NameColumn, OrganizationColumn, CreatedColumn, UpdatedColumn, DefaultSchemaColumn}
+		DescriptionColumn   = postgres.StringColumn("description")
+		LabelsColumn        = postgres.StringColumn("labels")
+		ArchivedColumn      = postgres.BoolColumn("archived")
+		allColumns          = postgres.ColumnList{IDColumn, NameColumn, OrganizationColumn, CreatedColumn, UpdatedColumn, DefaultSchemaColumn, DescriptionColumn, LabelsColumn, ArchivedColumn}
+		mutableColumns      = postgres.ColumnList{NameColumn, OrganizationColumn, CreatedColumn, UpdatedColumn, DefaultSchemaColumn, DescriptionColumn, LabelsColumn, ArchivedColumn}
 	)
 
 	return modelTable{
@@ -83,6 +89,9 @@ func newModelTableImpl(schemaName, tableName, alias string) modelTable {
 		Created:       CreatedColumn,
 		Updated:       UpdatedColumn,
 		DefaultSchema: DefaultSchemaColumn,
+		Description:   DescriptionColumn,
+		Labels:        LabelsColumn,
+		Archived:      ArchivedColumn,
 
 		AllColumns:     allColumns,
 		MutableColumns: mutableColumns,
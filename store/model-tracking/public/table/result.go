@@ -17,16 +17,19 @@ type resultTable struct {
 	postgres.Table
 
 	//Columns
-	ID           postgres.ColumnInteger
-	Organization postgres.ColumnInteger
-	Model        postgres.ColumnInteger
-	Version      postgres.ColumnInteger
-	Input        postgres.ColumnString
-	Output       postgres.ColumnString
-	TrueOutput   postgres.ColumnString
-	Time         postgres.ColumnTimestamp
-	Created      postgres.ColumnTimestamp
-	Updated      postgres.ColumnTimestamp
+	ID            postgres.ColumnInteger
+	Organization  postgres.ColumnInteger
+	Model         postgres.ColumnInteger
+	Version       postgres.ColumnInteger
+	Input         postgres.ColumnString
+	Output        postgres.ColumnString
+	TrueOutput    postgres.ColumnString
+	Time          postgres.ColumnTimestamp
+	Created       postgres.ColumnTimestamp
+	Updated       postgres.ColumnTimestamp
+	Labels        postgres.ColumnString
+	CorrelationID postgres.ColumnString
+	ClientID      postgres.ColumnString
 
 	AllColumns     postgres.ColumnList
 	MutableColumns postgres.ColumnList
@@ -67,34 +70,40 @@ func newResultTable(schemaName, tableName, alias string) *ResultTable {
 
 func newResultTableImpl(schemaName, tableName, alias string) resultTable {
 	var (
-		IDColumn           = postgres.IntegerColumn("id")
-		OrganizationColumn = postgres.IntegerColumn("organization")
-		ModelColumn        = postgres.IntegerColumn("model")
-		VersionColumn      = postgres.IntegerColumn("version")
-		InputColumn        = postgres.StringColumn("input")
-		OutputColumn       = postgres.StringColumn("output")
-		TrueOutputColumn   = postgres.StringColumn("true_output")
-		TimeColumn         = postgres.TimestampColumn("time")
-		CreatedColumn      = postgres.TimestampColumn("created")
-		UpdatedColumn      = postgres.TimestampColumn("updated")
-		allColumns         = postgres.ColumnList{IDColumn, OrganizationColumn, ModelColumn, VersionColumn, InputColumn, OutputColumn, TrueOutputColumn, TimeColumn, CreatedColumn, UpdatedColumn}
-		mutableColumns     = postgres.ColumnList{OrganizationColumn, ModelColumn, VersionColumn, InputColumn, OutputColumn, TrueOutputColumn, TimeColumn, CreatedColumn, UpdatedColumn}
+		IDColumn            = postgres.IntegerColumn("id")
+		OrganizationColumn  = postgres.IntegerColumn("organization")
+		ModelColumn         = postgres.IntegerColumn("model")
+		VersionColumn       = postgres.IntegerColumn("version")
+		InputColumn         = postgres.StringColumn("input")
+		OutputColumn        = postgres.StringColumn("output")
+		TrueOutputColumn    = postgres.StringColumn("true_output")
+		TimeColumn          = postgres.TimestampColumn("time")
+		CreatedColumn       = postgres.TimestampColumn("created")
+		UpdatedColumn       = postgres.TimestampColumn("updated")
+		LabelsColumn        = postgres.StringColumn("labels")
+		CorrelationIDColumn = postgres.StringColumn("correlation_id")
+		ClientIDColumn      = postgres.StringColumn("client_id")
+		allColumns          = postgres.ColumnList{IDColumn, OrganizationColumn, ModelColumn, VersionColumn, InputColumn, OutputColumn, TrueOutputColumn, TimeColumn, CreatedColumn, UpdatedColumn, LabelsColumn, CorrelationIDColumn, ClientIDColumn}
+		mutableColumns      = postgres.ColumnList{OrganizationColumn, ModelColumn, VersionColumn, InputColumn, OutputColumn, TrueOutputColumn, TimeColumn, CreatedColumn, UpdatedColumn, LabelsColumn, CorrelationIDColumn, ClientIDColumn}
 	)
 
 	return resultTable{
 		Table: postgres.NewTable(schemaName, tableName, alias, allColumns...),
 
 		//Columns
-		ID:           IDColumn,
-		Organization: OrganizationColumn,
-		Model:        ModelColumn,
-		Version:      VersionColumn,
-		Input:        InputColumn,
-		Output:       OutputColumn,
-		TrueOutput:   TrueOutputColumn,
-		Time:         TimeColumn,
-		Created:      CreatedColumn,
-		Updated:      UpdatedColumn,
+		ID:            IDColumn,
+		Organization:  OrganizationColumn,
+		Model:         ModelColumn,
+		Version:       VersionColumn,
+		Input:         InputColumn,
+		Output:        OutputColumn,
+		TrueOutput:    TrueOutputColumn,
+		Time:          TimeColumn,
+		Created:       CreatedColumn,
+		Updated:       UpdatedColumn,
+		Labels:        LabelsColumn,
+		CorrelationID: CorrelationIDColumn,
+		ClientID:      ClientIDColumn,
 
 		AllColumns:     allColumns,
 		MutableColumns: mutableColumns,
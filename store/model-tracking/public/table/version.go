@@ -17,13 +17,20 @@ type versionTable struct {
 	postgres.Table
 
 	//Columns
-	ID           postgres.ColumnInteger
-	Name         postgres.ColumnString
-	Organization postgres.ColumnInteger
-	Model        postgres.ColumnInteger
-	Schema       postgres.ColumnInteger
-	Created      postgres.ColumnTimestamp
-	Updated      postgres.ColumnTimestamp
+	ID              postgres.ColumnInteger
+	Name            postgres.ColumnString
+	Organization    postgres.ColumnInteger
+	Model           postgres.ColumnInteger
+	Schema          postgres.ColumnInteger
+	Created         postgres.ColumnTimestamp
+	Updated         postgres.ColumnTimestamp
+	ArtifactURI     postgres.ColumnString
+	Commit          postgres.ColumnString
+	Dataset         postgres.ColumnString
+	Hyperparameters postgres.ColumnString
+	Description     postgres.ColumnString
+	Labels          postgres.ColumnString
+	Archived        postgres.ColumnBool
 
 	AllColumns     postgres.ColumnList
 	MutableColumns postgres.ColumnList
@@ -64,28 +71,42 @@ func newVersionTable(schemaName, tableName, alias string) *VersionTable {
 
 func newVersionTableImpl(schemaName, tableName, alias string) versionTable {
 	var (
-		IDColumn           = postgres.IntegerColumn("id")
-		NameColumn         = postgres.StringColumn("name")
-		OrganizationColumn = postgres.IntegerColumn("organization")
-		ModelColumn        = postgres.IntegerColumn("model")
-		SchemaColumn       = postgres.IntegerColumn("schema")
-		CreatedColumn      = postgres.TimestampColumn("created")
-		UpdatedColumn      = postgres.TimestampColumn("updated")
-		allColumns         = postgres.ColumnList{IDColumn, NameColumn, OrganizationColumn, ModelColumn, SchemaColumn, CreatedColumn, UpdatedColumn}
-		mutableColumns     = postgres.ColumnList{NameColumn, OrganizationColumn, ModelColumn, SchemaColumn, CreatedColumn, UpdatedColumn}
+		IDColumn              = postgres.IntegerColumn("id")
+		NameColumn            = postgres.StringColumn("name")
+		OrganizationColumn    = postgres.IntegerColumn("organization")
+		ModelColumn           = postgres.IntegerColumn("model")
+		SchemaColumn          = postgres.IntegerColumn("schema")
+		CreatedColumn         = postgres.TimestampColumn("created")
+		UpdatedColumn         = postgres.TimestampColumn("updated")
+		ArtifactURIColumn     = postgres.StringColumn("artifact_uri")
+		CommitColumn          = postgres.StringColumn("commit")
+		DatasetColumn         = postgres.StringColumn("dataset")
+		HyperparametersColumn = postgres.StringColumn("hyperparameters")
+		DescriptionColumn     = postgres.StringColumn("description")
+		LabelsColumn          = postgres.StringColumn("labels")
+		ArchivedColumn        = postgres.BoolColumn("archived")
+		allColumns            = postgres.ColumnList{IDColumn, NameColumn, OrganizationColumn, ModelColumn, SchemaColumn, CreatedColumn, UpdatedColumn, ArtifactURIColumn, CommitColumn, DatasetColumn, HyperparametersColumn, DescriptionColumn, LabelsColumn, ArchivedColumn}
+		mutableColumns        = postgres.ColumnList{NameColumn, OrganizationColumn, ModelColumn, SchemaColumn, CreatedColumn, UpdatedColumn, ArtifactURIColumn, CommitColumn, DatasetColumn, HyperparametersColumn, DescriptionColumn, LabelsColumn, ArchivedColumn}
 	)
 
 	return versionTable{
 		Table: postgres.NewTable(schemaName, tableName, alias, allColumns...),
 
 		//Columns
-		ID:           IDColumn,
-		Name:         NameColumn,
-		Organization: OrganizationColumn,
-		Model:        ModelColumn,
-		Schema:       SchemaColumn,
-		Created:      CreatedColumn,
-		Updated:      UpdatedColumn,
+		ID:              IDColumn,
+		Name:            NameColumn,
+		Organization:    OrganizationColumn,
+		Model:           ModelColumn,
+		Schema:          SchemaColumn,
+		Created:         CreatedColumn,
+		Updated:         UpdatedColumn,
+		ArtifactURI:     ArtifactURIColumn,
+		Commit:          CommitColumn,
+		Dataset:         DatasetColumn,
+		Hyperparameters: HyperparametersColumn,
+		Description:     DescriptionColumn,
+		Labels:          LabelsColumn,
+		Archived:        ArchivedColumn,
 
 		AllColumns:     allColumns,
 		MutableColumns: mutableColumns,
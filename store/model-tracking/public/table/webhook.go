@@ -0,0 +1,96 @@
+//
+// Code generated by go-jet DO NOT EDIT.
+//
+// WARNING: Changes to this file may cause incorrect behavior
+// and will be lost if the code is regenerated
+//
+
+package table
+
+import (
+	"github.com/go-jet/jet/v2/postgres"
+)
+
+var Webhook = newWebhookTable("public", "webhook", "")
+
+type webhookTable struct {
+	postgres.Table
+
+	//Columns
+	ID           postgres.ColumnInteger
+	Name         postgres.ColumnString
+	Organization postgres.ColumnInteger
+	URL          postgres.ColumnString
+	Secret       postgres.ColumnString
+	Events       postgres.ColumnString
+	Created      postgres.ColumnTimestamp
+	Updated      postgres.ColumnTimestamp
+
+	AllColumns     postgres.ColumnList
+	MutableColumns postgres.ColumnList
+}
+
+type WebhookTable struct {
+	webhookTable
+
+	EXCLUDED webhookTable
+}
+
+// AS creates new WebhookTable with assigned alias
+func (a WebhookTable) AS(alias string) *WebhookTable {
+	return newWebhookTable(a.SchemaName(), a.TableName(), alias)
+}
+
+// Schema creates new WebhookTable with assigned schema name
+func (a WebhookTable) FromSchema(schemaName string) *WebhookTable {
+	return newWebhookTable(schemaName, a.TableName(), a.Alias())
+}
+
+// WithPrefix creates new WebhookTable with assigned table prefix
+func (a WebhookTable) WithPrefix(prefix string) *WebhookTable {
+	return newWebhookTable(a.SchemaName(), prefix+a.TableName(), a.TableName())
+}
+
+// WithSuffix creates new WebhookTable with assigned table suffix
+func (a WebhookTable) WithSuffix(suffix string) *WebhookTable {
+	return newWebhookTable(a.SchemaName(), a.TableName()+suffix, a.TableName())
+}
+
+func newWebhookTable(schemaName, tableName, alias string) *WebhookTable {
+	return &WebhookTable{
+		webhookTable: newWebhookTableImpl(schemaName, tableName, alias),
+		EXCLUDED:     newWebhookTableImpl("", "excluded", ""),
+	}
+}
+
+func newWebhookTableImpl(schemaName, tableName, alias string) webhookTable {
+	var (
+		IDColumn           = postgres.IntegerColumn("id")
+		NameColumn         = postgres.StringColumn("name")
+		OrganizationColumn = postgres.IntegerColumn("organization")
+		URLColumn          = postgres.StringColumn("url")
+		SecretColumn       = postgres.StringColumn("secret")
+		EventsColumn       = postgres.StringColumn("events")
+		CreatedColumn      = postgres.TimestampColumn("created")
+		UpdatedColumn      = postgres.TimestampColumn("updated")
+		allColumns         = postgres.ColumnList{IDColumn, NameColumn, OrganizationColumn, URLColumn, SecretColumn, EventsColumn, CreatedColumn, UpdatedColumn}
+		mutableColumns     = postgres.ColumnList{NameColumn, OrganizationColumn, URLColumn, SecretColumn, EventsColumn, CreatedColumn, UpdatedColumn}
+	)
+
+	return webhookTable{
+		Table: postgres.NewTable(schemaName, tableName, alias, allColumns...),
+
+		//Columns
+		ID:           IDColumn,
+		Name:         NameColumn,
+		Organization: OrganizationColumn,
+		URL:          URLColumn,
+		Secret:       SecretColumn,
+		Events:       EventsColumn,
+		Created:      CreatedColumn,
+		Updated:      UpdatedColumn,
+
+		AllColumns:     allColumns,
+		MutableColumns: mutableColumns,
+	}
+}
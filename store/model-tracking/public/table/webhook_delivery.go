@@ -0,0 +1,105 @@
+//
+// Code generated by go-jet DO NOT EDIT.
+//
+// WARNING: Changes to this file may cause incorrect behavior
+// and will be lost if the code is regenerated
+//
+
+package table
+
+import (
+	"github.com/go-jet/jet/v2/postgres"
+)
+
+var WebhookDelivery = newWebhookDeliveryTable("public", "webhook_delivery", "")
+
+type webhookDeliveryTable struct {
+	postgres.Table
+
+	//Columns
+	ID             postgres.ColumnInteger
+	Webhook        postgres.ColumnInteger
+	Event          postgres.ColumnString
+	Payload        postgres.ColumnString
+	Status         postgres.ColumnString
+	Attempts       postgres.ColumnInteger
+	NextAttempt    postgres.ColumnTimestamp
+	ResponseStatus postgres.ColumnInteger
+	Error          postgres.ColumnString
+	Created        postgres.ColumnTimestamp
+	Updated        postgres.ColumnTimestamp
+
+	AllColumns     postgres.ColumnList
+	MutableColumns postgres.ColumnList
+}
+
+type WebhookDeliveryTable struct {
+	webhookDeliveryTable
+
+	EXCLUDED webhookDeliveryTable
+}
+
+// AS creates new WebhookDeliveryTable with assigned alias
+func (a WebhookDeliveryTable) AS(alias string) *WebhookDeliveryTable {
+	return newWebhookDeliveryTable(a.SchemaName(), a.TableName(), alias)
+}
+
+// Schema creates new WebhookDeliveryTable with assigned schema name
+func (a WebhookDeliveryTable) FromSchema(schemaName string) *WebhookDeliveryTable {
+	return newWebhookDeliveryTable(schemaName, a.TableName(), a.Alias())
+}
+
+// WithPrefix creates new WebhookDeliveryTable with assigned table prefix
+func (a WebhookDeliveryTable) WithPrefix(prefix string) *WebhookDeliveryTable {
+	return newWebhookDeliveryTable(a.SchemaName(), prefix+a.TableName(), a.TableName())
+}
+
+// WithSuffix creates new WebhookDeliveryTable with assigned table suffix
+func (a WebhookDeliveryTable) WithSuffix(suffix string) *WebhookDeliveryTable {
+	return newWebhookDeliveryTable(a.SchemaName(), a.TableName()+suffix, a.TableName())
+}
+
+func newWebhookDeliveryTable(schemaName, tableName, alias string) *WebhookDeliveryTable {
+	return &WebhookDeliveryTable{
+		webhookDeliveryTable: newWebhookDeliveryTableImpl(schemaName, tableName, alias),
+		EXCLUDED:             newWebhookDeliveryTableImpl("", "excluded", ""),
+	}
+}
+
+func newWebhookDeliveryTableImpl(schemaName, tableName, alias string) webhookDeliveryTable {
+	var (
+		IDColumn             = postgres.IntegerColumn("id")
+		WebhookColumn        = postgres.IntegerColumn("webhook")
+		EventColumn          = postgres.StringColumn("event")
+		PayloadColumn        = postgres.StringColumn("payload")
+		StatusColumn         = postgres.StringColumn("status")
+		AttemptsColumn       = postgres.IntegerColumn("attempts")
+		NextAttemptColumn    = postgres.TimestampColumn("next_attempt")
+		ResponseStatusColumn = postgres.IntegerColumn("response_status")
+		ErrorColumn          = postgres.StringColumn("error")
+		CreatedColumn        = postgres.TimestampColumn("created")
+		UpdatedColumn        = postgres.TimestampColumn("updated")
+		allColumns           = postgres.ColumnList{IDColumn, WebhookColumn, EventColumn, PayloadColumn, StatusColumn, AttemptsColumn, NextAttemptColumn, ResponseStatusColumn, ErrorColumn, CreatedColumn, UpdatedColumn}
+		mutableColumns       = postgres.ColumnList{WebhookColumn, EventColumn, PayloadColumn, StatusColumn, AttemptsColumn, NextAttemptColumn, ResponseStatusColumn, ErrorColumn, CreatedColumn, UpdatedColumn}
+	)
+
+	return webhookDeliveryTable{
+		Table: postgres.NewTable(schemaName, tableName, alias, allColumns...),
+
+		//Columns
+		ID:             IDColumn,
+		Webhook:        WebhookColumn,
+		Event:          EventColumn,
+		Payload:        PayloadColumn,
+		Status:         StatusColumn,
+		Attempts:       AttemptsColumn,
+		NextAttempt:    NextAttemptColumn,
+		ResponseStatus: ResponseStatusColumn,
+		Error:          ErrorColumn,
+		Created:        CreatedColumn,
+		Updated:        UpdatedColumn,
+
+		AllColumns:     allColumns,
+		MutableColumns: mutableColumns,
+	}
+}
@@ -5,11 +5,14 @@ import (
 	"database/sql"
 	"errors"
 	"fmt"
+	"io"
 	"sync/atomic"
+	"time"
 
 	"github.com/go-jet/jet/v2/postgres"
 	"github.com/go-jet/jet/v2/qrm"
 
+	"github.com/connylabs/model-tracking/labels"
 	"github.com/connylabs/model-tracking/store/model-tracking/public/model"
 	"github.com/connylabs/model-tracking/store/model-tracking/public/table"
 )
@@ -65,6 +68,15 @@ func newTxable(db qrm.DB) *txable {
 	return &txable{db, atomic.Bool{}}
 }
 
+// unchanged restricts a condition to rows that were last updated at the given time, if any,
+// so that changes are not applied to rows that were changed concurrently.
+func unchanged(exp postgres.BoolExpression, column postgres.ColumnTimestamp, updated *time.Time) postgres.BoolExpression {
+	if updated == nil {
+		return exp
+	}
+	return exp.AND(column.EQ(postgres.TimestampT(*updated)))
+}
+
 type sqlStore struct {
 	db qrm.DB
 }
@@ -93,6 +105,38 @@ func (ss *sqlStore) Results(organization, model, version string) Results {
 	return NewResultsSQLStore(ss.db, organization, model, version)
 }
 
+func (ss *sqlStore) Stages(organization, model string) Stages {
+	return NewStagesSQLStore(ss.db, organization, model)
+}
+
+func (ss *sqlStore) AlertRules(organization, model string) AlertRules {
+	return NewAlertRulesSQLStore(ss.db, organization, model)
+}
+
+func (ss *sqlStore) Alerts() Alerts {
+	return NewAlertsSQLStore(ss.db)
+}
+
+func (ss *sqlStore) Webhooks(organization string) Webhooks {
+	return NewWebhooksSQLStore(ss.db, organization)
+}
+
+func (ss *sqlStore) Deliveries() Deliveries {
+	return NewDeliveriesSQLStore(ss.db)
+}
+
+func (ss *sqlStore) Statistics() Statistics {
+	return NewStatisticsSQLStore(ss.db)
+}
+
+func (ss *sqlStore) Migrations() Migrations {
+	return NewMigrationsSQLStore(ss.db)
+}
+
+func (ss *sqlStore) IdempotencyKeys(organization string) IdempotencyKeys {
+	return NewIdempotencyKeysSQLStore(ss.db, organization)
+}
+
 type organizationsSQLStore struct {
 	db qrm.DB
 }
@@ -110,7 +154,7 @@ func (oss *organizationsSQLStore) Create(ctx context.Context, o *model.Organizat
 	).RETURNING(
 		table.Organization.AllColumns,
 	).QueryContext(ctx, oss.db, &res); err != nil {
-		return nil, err
+		return nil, sqlError(err, "organization %q", o.Name)
 	}
 
 	return &res, nil
@@ -140,12 +184,15 @@ func (mss *modelsSQLStore) Create(ctx context.Context, m *model.Model) (*model.M
 	).WHERE(
 		table.Organization.Name.EQ(postgres.String(mss.organization)),
 	).QueryContext(ctx, tx, &o); err != nil {
-		return nil, err
+		return nil, sqlError(err, "organization %q", mss.organization)
 	}
 
 	if m.DefaultSchema != nil {
 		if _, err := NewSchemasSQLStore(tx, mss.organization).GetByID(ctx, int(*m.DefaultSchema)); err != nil {
-			return nil, fmt.Errorf("could not find schema: %w", err)
+			if errors.Is(err, ErrNotFound) {
+				return nil, &Error{Kind: ErrInvalid, Message: fmt.Sprintf("the default schema %d does not exist", *m.DefaultSchema), Err: err}
+			}
+			return nil, err
 		}
 	}
 
@@ -154,13 +201,21 @@ func (mss *modelsSQLStore) Create(ctx context.Context, m *model.Model) (*model.M
 		table.Model.Name,
 		table.Model.Organization,
 		table.Model.DefaultSchema,
+		table.Model.Description,
+		table.Model.Labels,
 	).VALUES(
 		m.Name,
 		o.ID,
 		m.DefaultSchema,
+		m.Description,
+		labelsOrEmpty(m.Labels),
 	).RETURNING(
 		table.Model.AllColumns,
 	).QueryContext(ctx, tx, &res); err != nil {
+		return nil, sqlError(err, "model %q", m.Name)
+	}
+
+	if err := emit(ctx, tx, o.ID, Event{Type: EventModelCreated, Organization: mss.organization, Model: res.Name, ID: res.ID}); err != nil {
 		return nil, err
 	}
 
@@ -179,25 +234,42 @@ func (mss *modelsSQLStore) Update(ctx context.Context, m *model.Model) (*model.M
 	defer tx.Rollback() //nolint:errcheck
 
 	// Ensure this model exists in the desired organization.
-	if _, err := NewModelsSQLStore(tx, mss.organization).Get(ctx, m.Name); err != nil {
+	existing, err := NewModelsSQLStore(tx, mss.organization).Get(ctx, m.Name)
+	if err != nil {
 		return nil, err
 	}
 
 	if m.DefaultSchema != nil {
 		if _, err := NewSchemasSQLStore(tx, mss.organization).GetByID(ctx, int(*m.DefaultSchema)); err != nil {
-			return nil, fmt.Errorf("could not find schema: %w", err)
+			if errors.Is(err, ErrNotFound) {
+				return nil, &Error{Kind: ErrInvalid, Message: fmt.Sprintf("the default schema %d does not exist", *m.DefaultSchema), Err: err}
+			}
+			return nil, err
 		}
 	}
 	var res model.Model
 	if err := table.Model.UPDATE(
 		table.Model.DefaultSchema,
+		table.Model.Description,
+		table.Model.Labels,
+		table.Model.Archived,
 	).SET(
 		m.DefaultSchema,
+		m.Description,
+		labelsOrEmpty(m.Labels),
+		m.Archived,
 	).WHERE(
-		table.Model.Name.EQ(postgres.String(m.Name)),
+		unchanged(table.Model.ID.EQ(postgres.Int(int64(existing.ID))), table.Model.Updated, m.Updated),
 	).RETURNING(
 		table.Model.AllColumns,
 	).QueryContext(ctx, tx, &res); err != nil {
+		if m.Updated != nil && errors.Is(err, qrm.ErrNoRows) {
+			return nil, changed("model %q", m.Name)
+		}
+		return nil, sqlError(err, "model %q", m.Name)
+	}
+
+	if err := emit(ctx, tx, res.Organization, Event{Type: EventModelUpdated, Organization: mss.organization, Model: res.Name, ID: res.ID}); err != nil {
 		return nil, err
 	}
 
@@ -220,7 +292,7 @@ func (mss *modelsSQLStore) Get(ctx context.Context, name string) (*model.Model,
 	).WHERE(
 		table.Model.Name.EQ(postgres.String(name)),
 	).QueryContext(ctx, mss.db, &m); err != nil {
-		return nil, err
+		return nil, sqlError(err, "model %q", name)
 	}
 
 	return &m, nil
@@ -266,7 +338,7 @@ func (sss *schemasSQLStore) Create(ctx context.Context, s *model.Schema) (*model
 	).WHERE(
 		table.Organization.Name.EQ(postgres.String(sss.organization)),
 	).QueryContext(ctx, tx, &o); err != nil {
-		return nil, err
+		return nil, sqlError(err, "organization %q", sss.organization)
 	}
 
 	var res model.Schema
@@ -283,6 +355,10 @@ func (sss *schemasSQLStore) Create(ctx context.Context, s *model.Schema) (*model
 	).RETURNING(
 		table.Schema.AllColumns,
 	).QueryContext(ctx, tx, &res); err != nil {
+		return nil, sqlError(err, "schema %q", s.Name)
+	}
+
+	if err := emit(ctx, tx, o.ID, Event{Type: EventSchemaCreated, Organization: sss.organization, Schema: res.Name, ID: res.ID}); err != nil {
 		return nil, err
 	}
 
@@ -305,7 +381,7 @@ func (sss *schemasSQLStore) Get(ctx context.Context, name string) (*model.Schema
 	).WHERE(
 		table.Schema.Name.EQ(postgres.String(name)),
 	).QueryContext(ctx, sss.db, &s); err != nil {
-		return nil, err
+		return nil, sqlError(err, "schema %q", name)
 	}
 
 	return &s, nil
@@ -320,7 +396,7 @@ func (sss *schemasSQLStore) GetByID(ctx context.Context, id int) (*model.Schema,
 	).WHERE(
 		table.Schema.ID.EQ(postgres.Int(int64((id)))),
 	).QueryContext(ctx, sss.db, &s); err != nil {
-		return nil, err
+		return nil, sqlError(err, "schema %d", id)
 	}
 
 	return &s, nil
@@ -370,17 +446,101 @@ func (vss *versionsSQLStore) Create(ctx context.Context, v *model.Version) (*mod
 		table.Version.Model,
 		table.Version.Organization,
 		table.Version.Schema,
+		table.Version.ArtifactURI,
+		table.Version.Commit,
+		table.Version.Dataset,
+		table.Version.Hyperparameters,
+		table.Version.Description,
+		table.Version.Labels,
 	).VALUES(
 		v.Name,
 		m.ID,
 		m.Organization,
 		v.Schema,
+		v.ArtifactURI,
+		v.Commit,
+		v.Dataset,
+		v.Hyperparameters,
+		v.Description,
+		labelsOrEmpty(v.Labels),
+	).RETURNING(
+		table.Version.AllColumns,
+	).QueryContext(ctx, tx, &res); err != nil {
+		return nil, sqlError(err, "version %q", v.Name)
+	}
+
+	if err := emit(ctx, tx, m.Organization, Event{Type: EventVersionCreated, Organization: vss.organization, Model: vss.model, Version: res.Name, ID: res.ID}); err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+
+	return &res, nil
+}
+
+func (vss *versionsSQLStore) Update(ctx context.Context, v *model.Version) (*model.Version, error) {
+	return vss.update(ctx, v, nil)
+}
+
+func (vss *versionsSQLStore) UpdateAndPromote(ctx context.Context, v *model.Version, stage string) (*model.Version, error) {
+	return vss.update(ctx, v, &stage)
+}
+
+// update updates a version and, if a stage is given, points the stage at it.
+func (vss *versionsSQLStore) update(ctx context.Context, v *model.Version, stage *string) (*model.Version, error) {
+	tx, err := newTxable(vss.db).BeginTx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback() //nolint:errcheck
+
+	// Ensure this version exists for the desired model.
+	existing, err := NewVersionsSQLStore(tx, vss.organization, vss.model).Get(ctx, v.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	var res model.Version
+	if err := table.Version.UPDATE(
+		table.Version.ArtifactURI,
+		table.Version.Commit,
+		table.Version.Dataset,
+		table.Version.Hyperparameters,
+		table.Version.Description,
+		table.Version.Labels,
+		table.Version.Archived,
+	).SET(
+		v.ArtifactURI,
+		v.Commit,
+		v.Dataset,
+		v.Hyperparameters,
+		v.Description,
+		labelsOrEmpty(v.Labels),
+		v.Archived,
+	).WHERE(
+		unchanged(table.Version.ID.EQ(postgres.Int(int64(existing.ID))), table.Version.Updated, v.Updated),
 	).RETURNING(
 		table.Version.AllColumns,
 	).QueryContext(ctx, tx, &res); err != nil {
+		if v.Updated != nil && errors.Is(err, qrm.ErrNoRows) {
+			return nil, changed("version %q", v.Name)
+		}
+		return nil, sqlError(err, "version %q", v.Name)
+	}
+
+	if err := emit(ctx, tx, res.Organization, Event{Type: EventVersionUpdated, Organization: vss.organization, Model: vss.model, Version: res.Name, ID: res.ID}); err != nil {
 		return nil, err
 	}
 
+	if stage != nil {
+		stages := &stagesSQLStore{tx, vss.organization, vss.model}
+		if _, err := stages.set(ctx, tx, *stage, &res); err != nil {
+			return nil, err
+		}
+	}
+
 	if err := tx.Commit(); err != nil {
 		return nil, err
 	}
@@ -403,7 +563,28 @@ func (vss *versionsSQLStore) Get(ctx context.Context, name string) (*model.Versi
 	).WHERE(
 		table.Version.Name.EQ(postgres.String(name)),
 	).QueryContext(ctx, vss.db, &v); err != nil {
-		return nil, err
+		return nil, sqlError(err, "version %q", name)
+	}
+
+	return &v, nil
+}
+
+func (vss *versionsSQLStore) GetByID(ctx context.Context, id int) (*model.Version, error) {
+	var v model.Version
+	if err := postgres.SELECT(
+		table.Version.AllColumns,
+	).FROM(
+		table.Version.
+			INNER_JOIN(table.Organization, table.Version.Organization.EQ(table.Organization.ID).
+				AND(table.Organization.Name.EQ(postgres.String(vss.organization))),
+			).
+			INNER_JOIN(table.Model, table.Version.Model.EQ(table.Model.ID).
+				AND(table.Model.Name.EQ(postgres.String(vss.model))),
+			),
+	).WHERE(
+		table.Version.ID.EQ(postgres.Int(int64(id))),
+	).QueryContext(ctx, vss.db, &v); err != nil {
+		return nil, sqlError(err, "version %d", id)
 	}
 
 	return &v, nil
@@ -416,21 +597,27 @@ func (vss *versionsSQLStore) GetOrCreate(ctx context.Context, name string) (*mod
 	}
 	defer tx.Rollback() //nolint:errcheck
 
+	m, err := NewModelsSQLStore(tx, vss.organization).Get(ctx, vss.model)
+	if err != nil {
+		return nil, err
+	}
+	if m.Archived {
+		return nil, &Error{Kind: ErrConflict, Message: fmt.Sprintf("model %q is archived", vss.model)}
+	}
+
 	v, err := NewVersionsSQLStore(tx, vss.organization, vss.model).Get(ctx, name)
 	if err == nil {
+		if v.Archived {
+			return nil, &Error{Kind: ErrConflict, Message: fmt.Sprintf("version %q is archived", name)}
+		}
 		return v, nil
 	}
-	if !errors.Is(err, qrm.ErrNoRows) {
-		return nil, err
-	}
-
-	m, err := NewModelsSQLStore(tx, vss.organization).Get(ctx, vss.model)
-	if err != nil {
+	if !errors.Is(err, ErrNotFound) {
 		return nil, err
 	}
 
 	if m.DefaultSchema == nil {
-		return nil, qrm.ErrNoRows
+		return nil, &Error{Kind: ErrNotFound, Message: fmt.Sprintf("version %q does not exist and model %q has no default schema with which to create it", name, vss.model)}
 	}
 
 	v, err = NewVersionsSQLStore(tx, vss.organization, vss.model).Create(ctx, &model.Version{Name: name, Model: m.ID, Organization: m.Organization, Schema: *m.DefaultSchema})
@@ -445,7 +632,7 @@ func (vss *versionsSQLStore) GetOrCreate(ctx context.Context, name string) (*mod
 	return v, nil
 }
 
-func (vss *versionsSQLStore) List(ctx context.Context) ([]*model.Version, error) {
+func (vss *versionsSQLStore) List(ctx context.Context, selector labels.Selector) ([]*model.Version, error) {
 	var v []*model.Version
 	if err := postgres.SELECT(
 		table.Version.AllColumns,
@@ -457,6 +644,8 @@ func (vss *versionsSQLStore) List(ctx context.Context) ([]*model.Version, error)
 			INNER_JOIN(table.Model, table.Version.Model.EQ(table.Model.ID).
 				AND(table.Model.Name.EQ(postgres.String(vss.model))),
 			),
+	).WHERE(
+		labelsExpression("version.labels", selector),
 	).QueryContext(ctx, vss.db, &v); err != nil {
 		return nil, err
 	}
@@ -496,6 +685,9 @@ func (rss *resultsSQLStore) Create(ctx context.Context, r *model.Result) (*model
 		table.Result.Output,
 		table.Result.TrueOutput,
 		table.Result.Time,
+		table.Result.Labels,
+		table.Result.CorrelationID,
+		table.Result.ClientID,
 	).VALUES(
 		v.Model,
 		v.Organization,
@@ -504,15 +696,136 @@ func (rss *resultsSQLStore) Create(ctx context.Context, r *model.Result) (*model
 		r.Output,
 		r.TrueOutput,
 		r.Time,
-	).RETURNING(
+		labelsOrEmpty(r.Labels),
+		r.CorrelationID,
+		r.ClientID,
+	).ON_CONFLICT(
+		table.Result.Version,
+		table.Result.ClientID,
+	).DO_NOTHING().RETURNING(
 		table.Result.AllColumns,
 	).QueryContext(ctx, tx, &res); err != nil {
+		if errors.Is(err, qrm.ErrNoRows) && r.ClientID != nil {
+			return nil, &Error{Kind: ErrConflict, Message: fmt.Sprintf("result %q already exists", *r.ClientID), Err: err}
+		}
+		return nil, sqlError(err, "version %q", rss.version)
+	}
+
+	if err := emit(ctx, tx, v.Organization, Event{Type: EventResultBatchCreated, Organization: rss.organization, Model: rss.model, Version: rss.version, ID: res.ID, LastID: res.ID, Count: 1}); err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
 		return nil, err
 	}
 
 	return &res, nil
 }
 
+// bulkBatchSize is the number of results inserted per statement by CreateBulk.
+const bulkBatchSize = 500
+
+func (rss *resultsSQLStore) CreateBulk(ctx context.Context, next func() (*model.Result, error)) (int, int, error) {
+	tx, err := newTxable(rss.db).BeginTx(ctx, nil)
+	if err != nil {
+		return 0, 0, err
+	}
+	defer tx.Rollback() //nolint:errcheck
+
+	v, err := NewVersionsSQLStore(tx, rss.organization, rss.model).Get(ctx, rss.version)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	var created, skipped int
+	// first and last are the lowest and highest IDs of the created results.
+	var first, last int32
+	batch := make([]*model.Result, 0, bulkBatchSize)
+	insert := func() error {
+		if len(batch) == 0 {
+			return nil
+		}
+		stmt := table.Result.INSERT(
+			table.Result.Model,
+			table.Result.Organization,
+			table.Result.Version,
+			table.Result.Input,
+			table.Result.Output,
+			table.Result.TrueOutput,
+			table.Result.Time,
+			table.Result.Labels,
+			table.Result.CorrelationID,
+			table.Result.ClientID,
+		)
+		for _, r := range batch {
+			stmt = stmt.VALUES(
+				v.Model,
+				v.Organization,
+				v.ID,
+				r.Input,
+				r.Output,
+				r.TrueOutput,
+				r.Time,
+				labelsOrEmpty(r.Labels),
+				r.CorrelationID,
+				r.ClientID,
+			)
+		}
+		var res []model.Result
+		if err := stmt.ON_CONFLICT(
+			table.Result.Version,
+			table.Result.ClientID,
+		).DO_NOTHING().RETURNING(
+			table.Result.ID,
+		).QueryContext(ctx, tx, &res); err != nil {
+			return sqlError(err, "version %q", rss.version)
+		}
+		for _, r := range res {
+			if created == 0 || r.ID < first {
+				first = r.ID
+			}
+			if r.ID > last {
+				last = r.ID
+			}
+			created++
+		}
+		skipped += len(batch) - len(res)
+		batch = batch[:0]
+		return nil
+	}
+
+	for {
+		r, err := next()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return 0, 0, err
+		}
+		batch = append(batch, r)
+		if len(batch) == bulkBatchSize {
+			if err := insert(); err != nil {
+				return 0, 0, err
+			}
+		}
+	}
+	if err := insert(); err != nil {
+		return 0, 0, err
+	}
+
+	if created != 0 {
+		if err := emit(ctx, tx, v.Organization, Event{Type: EventResultBatchCreated, Organization: rss.organization, Model: rss.model, Version: rss.version, ID: first, LastID: last, Count: created}); err != nil {
+			return 0, 0, err
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return 0, 0, err
+	}
+
+	return created, skipped, nil
+}
+
 func (rss *resultsSQLStore) Get(ctx context.Context, id int) (*model.Result, error) {
 	var r model.Result
 	if err := postgres.SELECT(
@@ -522,14 +835,14 @@ func (rss *resultsSQLStore) Get(ctx context.Context, id int) (*model.Result, err
 	).WHERE(
 		table.Result.ID.EQ(postgres.Int(int64(id))),
 	).QueryContext(ctx, rss.db, &r); err != nil {
-		return nil, err
+		return nil, sqlError(err, "result %d", id)
 	}
 
 	return &r, nil
 }
 
-func (rss *resultsSQLStore) List(ctx context.Context) ([]*model.Result, error) {
-	var r []*model.Result
+func (rss *resultsSQLStore) GetByClientID(ctx context.Context, clientID string) (*model.Result, error) {
+	var r model.Result
 	if err := postgres.SELECT(
 		table.Result.AllColumns,
 	).FROM(
@@ -543,9 +856,1052 @@ func (rss *resultsSQLStore) List(ctx context.Context) ([]*model.Result, error) {
 			INNER_JOIN(table.Version, table.Result.Version.EQ(table.Version.ID).
 				AND(table.Version.Name.EQ(postgres.String(rss.version))),
 			),
+	).WHERE(
+		table.Result.ClientID.EQ(postgres.String(clientID)),
 	).QueryContext(ctx, rss.db, &r); err != nil {
+		return nil, sqlError(err, "result %q", clientID)
+	}
+
+	return &r, nil
+}
+
+func (rss *resultsSQLStore) List(ctx context.Context, selector labels.Selector) ([]*model.Result, error) {
+	return rss.Window(ctx, nil, nil, selector)
+}
+
+func (rss *resultsSQLStore) Window(ctx context.Context, start, end *time.Time, selector labels.Selector) ([]*model.Result, error) {
+	return rss.find(ctx, resultsWindow(start, end, selector), 0)
+}
+
+func (rss *resultsSQLStore) Sample(ctx context.Context, start, end *time.Time, selector labels.Selector, limit int) ([]*model.Result, error) {
+	// Only the IDs of the results in the window are shuffled,
+	// so that the rest of the columns are read only for the sample.
+	sample := postgres.SELECT(
+		table.Result.ID,
+	).FROM(
+		rss.join(),
+	).WHERE(
+		resultsWindow(start, end, selector),
+	).ORDER_BY(
+		postgres.Raw("random()").ASC(),
+	).LIMIT(
+		int64(limit),
+	)
+
+	return rss.find(ctx, table.Result.ID.IN(sample), 0)
+}
+
+// resultsWindow matches the results whose labels match the selector and whose time lies
+// in the half-open interval [start, end). A nil bound is unbounded.
+func resultsWindow(start, end *time.Time, selector labels.Selector) postgres.BoolExpression {
+	exp := labelsExpression("result.labels", selector)
+	if start != nil {
+		exp = exp.AND(table.Result.Time.GT_EQ(postgres.TimestampT(*start)))
+	}
+	if end != nil {
+		exp = exp.AND(table.Result.Time.LT(postgres.TimestampT(*end)))
+	}
+	return exp
+}
+
+func (rss *resultsSQLStore) Range(ctx context.Context, first, last int) ([]*model.Result, error) {
+	return rss.find(ctx, table.Result.ID.GT_EQ(postgres.Int(int64(first))).AND(table.Result.ID.LT_EQ(postgres.Int(int64(last)))), 0)
+}
+
+func (rss *resultsSQLStore) After(ctx context.Context, id, limit int) ([]*model.Result, error) {
+	return rss.find(ctx, table.Result.ID.GT(postgres.Int(int64(id))), int64(limit))
+}
+
+// find gets the results for the version that match the expression, ordered by ID.
+// If limit is greater than zero, at most limit results are returned.
+func (rss *resultsSQLStore) find(ctx context.Context, exp postgres.BoolExpression, limit int64) ([]*model.Result, error) {
+	stmt := postgres.SELECT(
+		table.Result.AllColumns,
+	).FROM(
+		rss.join(),
+	).WHERE(
+		exp,
+	).ORDER_BY(
+		table.Result.ID.ASC(),
+	)
+	if limit > 0 {
+		stmt = stmt.LIMIT(limit)
+	}
+
+	var r []*model.Result
+	if err := stmt.QueryContext(ctx, rss.db, &r); err != nil {
 		return nil, err
 	}
 
 	return r, nil
 }
+
+// join joins the results with the version of the model in the store, so that only its results are selected.
+func (rss *resultsSQLStore) join() postgres.ReadableTable {
+	return table.Result.
+		INNER_JOIN(table.Organization, table.Result.Organization.EQ(table.Organization.ID).
+			AND(table.Organization.Name.EQ(postgres.String(rss.organization))),
+		).
+		INNER_JOIN(table.Model, table.Result.Model.EQ(table.Model.ID).
+			AND(table.Model.Name.EQ(postgres.String(rss.model))),
+		).
+		INNER_JOIN(table.Version, table.Result.Version.EQ(table.Version.ID).
+			AND(table.Version.Name.EQ(postgres.String(rss.version))),
+		)
+}
+
+func (rss *resultsSQLStore) Pair(ctx context.Context, challenger string, selector labels.Selector) ([]*ResultPair, error) {
+	tx, err := newTxable(rss.db).BeginTx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback() //nolint:errcheck
+
+	versions := NewVersionsSQLStore(tx, rss.organization, rss.model)
+	a, err := versions.Get(ctx, rss.version)
+	if err != nil {
+		return nil, err
+	}
+	b, err := versions.Get(ctx, challenger)
+	if err != nil {
+		return nil, err
+	}
+
+	championTable := table.Result.AS("champion")
+	challengerTable := table.Result.AS("challenger")
+	var p []*ResultPair
+	if err := postgres.SELECT(
+		championTable.AllColumns,
+		challengerTable.AllColumns,
+	).FROM(
+		championTable.
+			INNER_JOIN(challengerTable, championTable.CorrelationID.EQ(challengerTable.CorrelationID).
+				AND(challengerTable.Model.EQ(championTable.Model)).
+				AND(challengerTable.Version.EQ(postgres.Int(int64(b.ID)))),
+			),
+	).WHERE(
+		championTable.Version.EQ(postgres.Int(int64(a.ID))).
+			AND(labelsExpression("champion.labels", selector)),
+	).QueryContext(ctx, tx, &p); err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+
+	return p, nil
+}
+
+type stagesSQLStore struct {
+	db           qrm.DB
+	organization string
+	model        string
+}
+
+func NewStagesSQLStore(db qrm.DB, organization, model string) Stages {
+	return &stagesSQLStore{db, organization, model}
+}
+
+func (sss *stagesSQLStore) Promote(ctx context.Context, stage, version string) (*model.Stage, error) {
+	tx, err := newTxable(sss.db).BeginTx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback() //nolint:errcheck
+
+	v, err := NewVersionsSQLStore(tx, sss.organization, sss.model).Get(ctx, version)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := sss.set(ctx, tx, stage, v)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func (sss *stagesSQLStore) Rollback(ctx context.Context, stage string) (*model.Stage, error) {
+	tx, err := newTxable(sss.db).BeginTx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback() //nolint:errcheck
+
+	st, err := sss.lock(ctx, tx, stage)
+	if err != nil {
+		return nil, err
+	}
+
+	var h model.StageHistory
+	if err := postgres.SELECT(
+		table.StageHistory.AllColumns,
+	).FROM(
+		table.StageHistory,
+	).WHERE(
+		table.StageHistory.Stage.EQ(postgres.Int(int64(st.ID))),
+	).ORDER_BY(
+		table.StageHistory.ID.DESC(),
+	).LIMIT(1).QueryContext(ctx, tx, &h); err != nil {
+		return nil, sqlError(err, "the history of stage %q", stage)
+	}
+	if h.PreviousVersion == nil {
+		return nil, ErrNoPreviousVersion
+	}
+
+	v, err := NewVersionsSQLStore(tx, sss.organization, sss.model).GetByID(ctx, int(*h.PreviousVersion))
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := sss.set(ctx, tx, stage, v)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+// lock gets a stage and locks it for the remainder of the transaction.
+func (sss *stagesSQLStore) lock(ctx context.Context, tx qrm.DB, name string) (*model.Stage, error) {
+	var st model.Stage
+	if err := postgres.SELECT(
+		table.Stage.AllColumns,
+	).FROM(
+		table.Stage.
+			INNER_JOIN(table.Organization, table.Stage.Organization.EQ(table.Organization.ID).
+				AND(table.Organization.Name.EQ(postgres.String(sss.organization))),
+			).
+			INNER_JOIN(table.Model, table.Stage.Model.EQ(table.Model.ID).
+				AND(table.Model.Name.EQ(postgres.String(sss.model))),
+			),
+	).WHERE(
+		table.Stage.Name.EQ(postgres.String(name)),
+	).FOR(
+		postgres.UPDATE(),
+	).QueryContext(ctx, tx, &st); err != nil {
+		return nil, sqlError(err, "stage %q", name)
+	}
+
+	return &st, nil
+}
+
+// set points the stage at the given version and records the change.
+func (sss *stagesSQLStore) set(ctx context.Context, tx qrm.DB, name string, v *model.Version) (*model.Stage, error) {
+	var previous *int32
+	st, err := sss.lock(ctx, tx, name)
+	switch {
+	case err == nil:
+		previous = &st.Version
+	case errors.Is(err, ErrNotFound):
+	default:
+		return nil, err
+	}
+
+	var res model.Stage
+	if err := table.Stage.INSERT(
+		table.Stage.Name,
+		table.Stage.Organization,
+		table.Stage.Model,
+		table.Stage.Version,
+	).VALUES(
+		name,
+		v.Organization,
+		v.Model,
+		v.ID,
+	).ON_CONFLICT(
+		table.Stage.Name,
+		table.Stage.Model,
+		table.Stage.Organization,
+	).DO_UPDATE(
+		postgres.SET(
+			table.Stage.Version.SET(table.Stage.EXCLUDED.Version),
+		),
+	).RETURNING(
+		table.Stage.AllColumns,
+	).QueryContext(ctx, tx, &res); err != nil {
+		return nil, sqlError(err, "stage %q", name)
+	}
+
+	if _, err := table.StageHistory.INSERT(
+		table.StageHistory.Organization,
+		table.StageHistory.Model,
+		table.StageHistory.Stage,
+		table.StageHistory.Version,
+		table.StageHistory.PreviousVersion,
+	).VALUES(
+		res.Organization,
+		res.Model,
+		res.ID,
+		res.Version,
+		previous,
+	).ExecContext(ctx, tx); err != nil {
+		return nil, sqlError(err, "stage %q", name)
+	}
+
+	if err := emit(ctx, tx, res.Organization, Event{Type: EventStageUpdated, Organization: sss.organization, Model: sss.model, Stage: res.Name, Version: v.Name, ID: res.ID}); err != nil {
+		return nil, err
+	}
+
+	return &res, nil
+}
+
+func (sss *stagesSQLStore) Get(ctx context.Context, name string) (*model.Stage, error) {
+	var st model.Stage
+	if err := postgres.SELECT(
+		table.Stage.AllColumns,
+	).FROM(
+		table.Stage.
+			INNER_JOIN(table.Organization, table.Stage.Organization.EQ(table.Organization.ID).
+				AND(table.Organization.Name.EQ(postgres.String(sss.organization))),
+			).
+			INNER_JOIN(table.Model, table.Stage.Model.EQ(table.Model.ID).
+				AND(table.Model.Name.EQ(postgres.String(sss.model))),
+			),
+	).WHERE(
+		table.Stage.Name.EQ(postgres.String(name)),
+	).QueryContext(ctx, sss.db, &st); err != nil {
+		return nil, sqlError(err, "stage %q", name)
+	}
+
+	return &st, nil
+}
+
+func (sss *stagesSQLStore) List(ctx context.Context) ([]*model.Stage, error) {
+	var st []*model.Stage
+	if err := postgres.SELECT(
+		table.Stage.AllColumns,
+	).FROM(
+		table.Stage.
+			INNER_JOIN(table.Organization, table.Stage.Organization.EQ(table.Organization.ID).
+				AND(table.Organization.Name.EQ(postgres.String(sss.organization))),
+			).
+			INNER_JOIN(table.Model, table.Stage.Model.EQ(table.Model.ID).
+				AND(table.Model.Name.EQ(postgres.String(sss.model))),
+			),
+	).QueryContext(ctx, sss.db, &st); err != nil {
+		return nil, err
+	}
+
+	return st, nil
+}
+
+func (sss *stagesSQLStore) History(ctx context.Context, name string) ([]*model.StageHistory, error) {
+	st, err := sss.Get(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+
+	var h []*model.StageHistory
+	if err := postgres.SELECT(
+		table.StageHistory.AllColumns,
+	).FROM(
+		table.StageHistory,
+	).WHERE(
+		table.StageHistory.Stage.EQ(postgres.Int(int64(st.ID))),
+	).ORDER_BY(
+		table.StageHistory.ID.ASC(),
+	).QueryContext(ctx, sss.db, &h); err != nil {
+		return nil, err
+	}
+
+	return h, nil
+}
+
+type alertRulesSQLStore struct {
+	db           qrm.DB
+	organization string
+	model        string
+}
+
+func NewAlertRulesSQLStore(db qrm.DB, organization, model string) AlertRules {
+	return &alertRulesSQLStore{db, organization, model}
+}
+
+func (arss *alertRulesSQLStore) Create(ctx context.Context, ar *model.AlertRule) (*model.AlertRule, error) {
+	tx, err := newTxable(arss.db).BeginTx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback() //nolint:errcheck
+
+	m, err := NewModelsSQLStore(tx, arss.organization).Get(ctx, arss.model)
+	if err != nil {
+		return nil, err
+	}
+
+	var res model.AlertRule
+	if err := table.AlertRule.INSERT(
+		table.AlertRule.Name,
+		table.AlertRule.Organization,
+		table.AlertRule.Model,
+		table.AlertRule.Stage,
+		table.AlertRule.Metric,
+		table.AlertRule.Field,
+		table.AlertRule.Operator,
+		table.AlertRule.Threshold,
+		table.AlertRule.WindowSeconds,
+	).VALUES(
+		ar.Name,
+		m.Organization,
+		m.ID,
+		ar.Stage,
+		ar.Metric,
+		ar.Field,
+		ar.Operator,
+		ar.Threshold,
+		ar.WindowSeconds,
+	).RETURNING(
+		table.AlertRule.AllColumns,
+	).QueryContext(ctx, tx, &res); err != nil {
+		return nil, sqlError(err, "alert rule %q", ar.Name)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+
+	return &res, nil
+}
+
+func (arss *alertRulesSQLStore) Get(ctx context.Context, name string) (*model.AlertRule, error) {
+	var ar model.AlertRule
+	if err := postgres.SELECT(
+		table.AlertRule.AllColumns,
+	).FROM(
+		table.AlertRule.
+			INNER_JOIN(table.Organization, table.AlertRule.Organization.EQ(table.Organization.ID).
+				AND(table.Organization.Name.EQ(postgres.String(arss.organization))),
+			).
+			INNER_JOIN(table.Model, table.AlertRule.Model.EQ(table.Model.ID).
+				AND(table.Model.Name.EQ(postgres.String(arss.model))),
+			),
+	).WHERE(
+		table.AlertRule.Name.EQ(postgres.String(name)),
+	).QueryContext(ctx, arss.db, &ar); err != nil {
+		return nil, sqlError(err, "alert rule %q", name)
+	}
+
+	return &ar, nil
+}
+
+func (arss *alertRulesSQLStore) List(ctx context.Context) ([]*model.AlertRule, error) {
+	var ar []*model.AlertRule
+	if err := postgres.SELECT(
+		table.AlertRule.AllColumns,
+	).FROM(
+		table.AlertRule.
+			INNER_JOIN(table.Organization, table.AlertRule.Organization.EQ(table.Organization.ID).
+				AND(table.Organization.Name.EQ(postgres.String(arss.organization))),
+			).
+			INNER_JOIN(table.Model, table.AlertRule.Model.EQ(table.Model.ID).
+				AND(table.Model.Name.EQ(postgres.String(arss.model))),
+			),
+	).QueryContext(ctx, arss.db, &ar); err != nil {
+		return nil, err
+	}
+
+	return ar, nil
+}
+
+func (arss *alertRulesSQLStore) Delete(ctx context.Context, name string, updated *time.Time) error {
+	tx, err := newTxable(arss.db).BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback() //nolint:errcheck
+
+	ar, err := NewAlertRulesSQLStore(tx, arss.organization, arss.model).Get(ctx, name)
+	if err != nil {
+		return err
+	}
+
+	res, err := table.AlertRule.DELETE().WHERE(
+		unchanged(table.AlertRule.ID.EQ(postgres.Int(int64(ar.ID))), table.AlertRule.Updated, updated),
+	).ExecContext(ctx, tx)
+	if err != nil {
+		return err
+	}
+	if updated != nil {
+		n, err := res.RowsAffected()
+		if err != nil {
+			return err
+		}
+		if n == 0 {
+			return changed("alert rule %q", name)
+		}
+	}
+
+	return tx.Commit()
+}
+
+type alertsSQLStore struct {
+	db qrm.DB
+}
+
+func NewAlertsSQLStore(db qrm.DB) Alerts {
+	return &alertsSQLStore{db}
+}
+
+func (ass *alertsSQLStore) List(ctx context.Context) ([]*Alert, error) {
+	var a []*Alert
+	if err := postgres.SELECT(
+		table.AlertRule.AllColumns,
+		table.Organization.AllColumns,
+		table.Model.AllColumns,
+	).FROM(
+		table.AlertRule.
+			INNER_JOIN(table.Organization, table.AlertRule.Organization.EQ(table.Organization.ID)).
+			INNER_JOIN(table.Model, table.AlertRule.Model.EQ(table.Model.ID)),
+	).ORDER_BY(
+		table.AlertRule.ID,
+	).QueryContext(ctx, ass.db, &a); err != nil {
+		return nil, err
+	}
+
+	return a, nil
+}
+
+func (ass *alertsSQLStore) SetState(ctx context.Context, id int, firing bool, activeSince *time.Time, value *float64) error {
+	_, err := table.AlertRule.UPDATE(
+		table.AlertRule.Firing,
+		table.AlertRule.ActiveSince,
+		table.AlertRule.LastValue,
+	).SET(
+		firing,
+		activeSince,
+		value,
+	).WHERE(
+		table.AlertRule.ID.EQ(postgres.Int(int64(id))),
+	).ExecContext(ctx, ass.db)
+	return err
+}
+
+// alertsLockKey is the key of the advisory lock that is held while alert rules are evaluated.
+const alertsLockKey = 7_361_529_014
+
+func (ass *alertsSQLStore) Lock(ctx context.Context) (func() error, bool, error) {
+	// The lock is scoped to a transaction, so that it is released with the connection
+	// that holds it, even if the process dies.
+	tx, err := newTxable(ass.db).BeginTx(ctx, nil)
+	if err != nil {
+		return nil, false, err
+	}
+	var ok bool
+	if err := tx.QueryRowContext(ctx, "SELECT pg_try_advisory_xact_lock($1)", alertsLockKey).Scan(&ok); err != nil {
+		tx.Rollback() //nolint:errcheck
+		return nil, false, err
+	}
+	if !ok {
+		return nil, false, tx.Rollback()
+	}
+	return tx.Rollback, true, nil
+}
+
+type webhooksSQLStore struct {
+	db           qrm.DB
+	organization string
+}
+
+func NewWebhooksSQLStore(db qrm.DB, organization string) Webhooks {
+	return &webhooksSQLStore{db, organization}
+}
+
+func (wss *webhooksSQLStore) Create(ctx context.Context, w *model.Webhook) (*model.Webhook, error) {
+	tx, err := newTxable(wss.db).BeginTx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback() //nolint:errcheck
+
+	var o model.Organization
+	if err := postgres.SELECT(
+		table.Organization.ID,
+	).FROM(
+		table.Organization,
+	).WHERE(
+		table.Organization.Name.EQ(postgres.String(wss.organization)),
+	).QueryContext(ctx, tx, &o); err != nil {
+		return nil, sqlError(err, "organization %q", wss.organization)
+	}
+
+	var res model.Webhook
+	if err := table.Webhook.INSERT(
+		table.Webhook.Name,
+		table.Webhook.Organization,
+		table.Webhook.URL,
+		table.Webhook.Secret,
+		table.Webhook.Events,
+	).VALUES(
+		w.Name,
+		o.ID,
+		w.URL,
+		w.Secret,
+		w.Events,
+	).RETURNING(
+		table.Webhook.AllColumns,
+	).QueryContext(ctx, tx, &res); err != nil {
+		return nil, sqlError(err, "webhook %q", w.Name)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+
+	return &res, nil
+}
+
+func (wss *webhooksSQLStore) Get(ctx context.Context, name string) (*model.Webhook, error) {
+	var w model.Webhook
+	if err := postgres.SELECT(
+		table.Webhook.AllColumns,
+	).FROM(
+		table.Webhook.
+			INNER_JOIN(table.Organization, table.Webhook.Organization.EQ(table.Organization.ID).
+				AND(table.Organization.Name.EQ(postgres.String(wss.organization))),
+			),
+	).WHERE(
+		table.Webhook.Name.EQ(postgres.String(name)),
+	).QueryContext(ctx, wss.db, &w); err != nil {
+		return nil, sqlError(err, "webhook %q", name)
+	}
+
+	return &w, nil
+}
+
+func (wss *webhooksSQLStore) List(ctx context.Context) ([]*model.Webhook, error) {
+	var w []*model.Webhook
+	if err := postgres.SELECT(
+		table.Webhook.AllColumns,
+	).FROM(
+		table.Webhook.
+			INNER_JOIN(table.Organization, table.Webhook.Organization.EQ(table.Organization.ID).
+				AND(table.Organization.Name.EQ(postgres.String(wss.organization))),
+			),
+	).QueryContext(ctx, wss.db, &w); err != nil {
+		return nil, err
+	}
+
+	return w, nil
+}
+
+func (wss *webhooksSQLStore) Delete(ctx context.Context, name string, updated *time.Time) error {
+	tx, err := newTxable(wss.db).BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback() //nolint:errcheck
+
+	w, err := NewWebhooksSQLStore(tx, wss.organization).Get(ctx, name)
+	if err != nil {
+		return err
+	}
+
+	res, err := table.Webhook.DELETE().WHERE(
+		unchanged(table.Webhook.ID.EQ(postgres.Int(int64(w.ID))), table.Webhook.Updated, updated),
+	).ExecContext(ctx, tx)
+	if err != nil {
+		return err
+	}
+	if updated != nil {
+		n, err := res.RowsAffected()
+		if err != nil {
+			return err
+		}
+		if n == 0 {
+			return changed("webhook %q", name)
+		}
+	}
+
+	return tx.Commit()
+}
+
+func (wss *webhooksSQLStore) Deliveries(ctx context.Context, name string, limit int) ([]*model.WebhookDelivery, error) {
+	w, err := wss.Get(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+
+	var d []*model.WebhookDelivery
+	if err := postgres.SELECT(
+		table.WebhookDelivery.AllColumns,
+	).FROM(
+		table.WebhookDelivery,
+	).WHERE(
+		table.WebhookDelivery.Webhook.EQ(postgres.Int(int64(w.ID))),
+	).ORDER_BY(
+		table.WebhookDelivery.ID.DESC(),
+	).LIMIT(
+		int64(limit),
+	).QueryContext(ctx, wss.db, &d); err != nil {
+		return nil, err
+	}
+
+	return d, nil
+}
+
+type deliveriesSQLStore struct {
+	db qrm.DB
+}
+
+func NewDeliveriesSQLStore(db qrm.DB) Deliveries {
+	return &deliveriesSQLStore{db}
+}
+
+func (dss *deliveriesSQLStore) Claim(ctx context.Context, limit int, lease time.Duration) ([]*Delivery, error) {
+	tx, err := newTxable(dss.db).BeginTx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback() //nolint:errcheck
+
+	var d []*Delivery
+	if err := postgres.SELECT(
+		table.WebhookDelivery.AllColumns,
+		table.Webhook.AllColumns,
+	).FROM(
+		table.WebhookDelivery.
+			INNER_JOIN(table.Webhook, table.WebhookDelivery.Webhook.EQ(table.Webhook.ID)),
+	).WHERE(
+		table.WebhookDelivery.Status.EQ(postgres.String(DeliveryPending)).
+			AND(table.WebhookDelivery.NextAttempt.LT_EQ(postgres.LOCALTIMESTAMP())),
+	).ORDER_BY(
+		table.WebhookDelivery.NextAttempt,
+	).LIMIT(
+		int64(limit),
+	).FOR(
+		postgres.UPDATE().SKIP_LOCKED(),
+	).QueryContext(ctx, tx, &d); err != nil {
+		return nil, err
+	}
+	if len(d) == 0 {
+		return nil, nil
+	}
+
+	ids := make([]postgres.Expression, 0, len(d))
+	for i := range d {
+		ids = append(ids, postgres.Int(int64(d[i].Delivery.ID)))
+	}
+	if _, err := table.WebhookDelivery.UPDATE(
+		table.WebhookDelivery.NextAttempt,
+	).SET(
+		postgres.LOCALTIMESTAMP().ADD(postgres.INTERVALd(lease)),
+	).WHERE(
+		table.WebhookDelivery.ID.IN(ids...),
+	).ExecContext(ctx, tx); err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+
+	return d, nil
+}
+
+func (dss *deliveriesSQLStore) Record(ctx context.Context, d *model.WebhookDelivery, delay time.Duration) error {
+	_, err := table.WebhookDelivery.UPDATE(
+		table.WebhookDelivery.Status,
+		table.WebhookDelivery.Attempts,
+		table.WebhookDelivery.ResponseStatus,
+		table.WebhookDelivery.Error,
+		table.WebhookDelivery.NextAttempt,
+	).SET(
+		d.Status,
+		d.Attempts,
+		d.ResponseStatus,
+		d.Error,
+		postgres.LOCALTIMESTAMP().ADD(postgres.INTERVALd(delay)),
+	).WHERE(
+		table.WebhookDelivery.ID.EQ(postgres.Int(int64(d.ID))),
+	).ExecContext(ctx, dss.db)
+	return err
+}
+
+type statisticsSQLStore struct {
+	db qrm.DB
+}
+
+func NewStatisticsSQLStore(db qrm.DB) Statistics {
+	return &statisticsSQLStore{db}
+}
+
+// compactResultStatistics replaces the running statistics of every version
+// that has more than one row with a single row holding their sum.
+// Rows inserted concurrently are not visible to the statement and are kept.
+var compactResultStatistics = postgres.RawStatement(`
+WITH compacted AS (
+	DELETE FROM result_statistics
+	WHERE version IN (SELECT version FROM result_statistics GROUP BY version HAVING COUNT(id) > 1)
+	RETURNING version, results, labelled, correct, last_result
+)
+INSERT INTO result_statistics (version, results, labelled, correct, last_result)
+SELECT version, SUM(results), SUM(labelled), SUM(correct), MAX(last_result)
+FROM compacted
+GROUP BY version
+`)
+
+// List sums the running statistics that are kept when results are inserted,
+// so that its cost does not grow with the number of results.
+func (sss *statisticsSQLStore) List(ctx context.Context) ([]*VersionStatistics, error) {
+	if _, err := compactResultStatistics.ExecContext(ctx, sss.db); err != nil {
+		return nil, err
+	}
+
+	sum := func(c postgres.ColumnInteger) postgres.IntegerExpression {
+		return postgres.CAST(postgres.COALESCE(postgres.SUMi(c), postgres.Int(0))).AS_BIGINT()
+	}
+	var vs []*VersionStatistics
+	if err := postgres.SELECT(
+		table.Organization.Name.AS("version_statistics.organization"),
+		table.Model.Name.AS("version_statistics.model"),
+		table.Version.Name.AS("version_statistics.version"),
+		sum(table.ResultStatistics.Results).AS("version_statistics.results"),
+		sum(table.ResultStatistics.Labelled).AS("version_statistics.labelled"),
+		sum(table.ResultStatistics.Correct).AS("version_statistics.correct"),
+		postgres.MAX(table.ResultStatistics.LastResult).AS("version_statistics.last_result"),
+	).FROM(
+		table.Version.
+			INNER_JOIN(table.Organization, table.Version.Organization.EQ(table.Organization.ID)).
+			INNER_JOIN(table.Model, table.Version.Model.EQ(table.Model.ID)).
+			LEFT_JOIN(table.ResultStatistics, table.ResultStatistics.Version.EQ(table.Version.ID)),
+	).GROUP_BY(
+		table.Organization.Name,
+		table.Model.Name,
+		table.Version.Name,
+	).QueryContext(ctx, sss.db, &vs); err != nil {
+		return nil, err
+	}
+
+	return vs, nil
+}
+
+func (sss *statisticsSQLStore) Get(ctx context.Context, organization, model, version string, start, end *time.Time, selector labels.Selector) (*VersionStatistics, error) {
+	var v VersionStatistics
+	if err := versionStatistics(
+		table.Organization.Name.EQ(postgres.String(organization)).
+			AND(table.Model.Name.EQ(postgres.String(model))).
+			AND(table.Version.Name.EQ(postgres.String(version))),
+		resultsWindow(start, end, selector),
+	).QueryContext(ctx, sss.db, &v); err != nil {
+		return nil, sqlError(err, "version %q", version)
+	}
+
+	return &v, nil
+}
+
+// versionStatistics aggregates the results of the versions matching the expression.
+// Only results matching the results expression are counted.
+// The labelled and correct results are counted like in the trigger
+// that keeps the running statistics of the results.
+func versionStatistics(exp, results postgres.BoolExpression) postgres.SelectStatement {
+	// Outputs are validated against JSON schemas when results are created,
+	// so they can be compared semantically as JSONB.
+	const (
+		labelled = "convert_from(result.true_output, 'UTF8')::jsonb <> 'null'::jsonb"
+		correct  = labelled + " AND convert_from(result.output, 'UTF8')::jsonb = convert_from(result.true_output, 'UTF8')::jsonb"
+	)
+
+	return postgres.SELECT(
+		table.Organization.Name.AS("version_statistics.organization"),
+		table.Model.Name.AS("version_statistics.model"),
+		table.Version.Name.AS("version_statistics.version"),
+		postgres.COUNT(table.Result.ID).AS("version_statistics.results"),
+		postgres.Raw("COUNT(result.id) FILTER (WHERE "+labelled+")").AS("version_statistics.labelled"),
+		postgres.Raw("COUNT(result.id) FILTER (WHERE "+correct+")").AS("version_statistics.correct"),
+		postgres.MAX(table.Result.Time).AS("version_statistics.last_result"),
+	).FROM(
+		table.Version.
+			INNER_JOIN(table.Organization, table.Version.Organization.EQ(table.Organization.ID)).
+			INNER_JOIN(table.Model, table.Version.Model.EQ(table.Model.ID)).
+			// The results expression is part of the join, so that versions without matching results are counted too.
+			LEFT_JOIN(table.Result, table.Result.Version.EQ(table.Version.ID).
+				AND(results),
+			),
+	).WHERE(
+		exp,
+	).GROUP_BY(
+		table.Organization.Name,
+		table.Model.Name,
+		table.Version.Name,
+	)
+}
+
+func (sss *statisticsSQLStore) Count(ctx context.Context, organization string, since time.Time) (int64, error) {
+	var c struct {
+		Count int64
+	}
+	if err := postgres.SELECT(
+		postgres.COUNT(table.Result.ID).AS("count"),
+	).FROM(
+		table.Result.
+			INNER_JOIN(table.Organization, table.Result.Organization.EQ(table.Organization.ID).
+				AND(table.Organization.Name.EQ(postgres.String(organization))),
+			),
+	).WHERE(
+		table.Result.Created.GT_EQ(postgres.TimestampT(since)),
+	).QueryContext(ctx, sss.db, &c); err != nil {
+		return 0, err
+	}
+
+	return c.Count, nil
+}
+
+type migrationsSQLStore struct {
+	db qrm.DB
+}
+
+func NewMigrationsSQLStore(db qrm.DB) Migrations {
+	return &migrationsSQLStore{db}
+}
+
+func (mss *migrationsSQLStore) Version(ctx context.Context) (int64, error) {
+	var vs []*model.GooseDbVersion
+	if err := postgres.SELECT(
+		table.GooseDbVersion.AllColumns,
+	).FROM(
+		table.GooseDbVersion,
+	).ORDER_BY(
+		table.GooseDbVersion.ID.DESC(),
+	).QueryContext(ctx, mss.db, &vs); err != nil {
+		return 0, err
+	}
+
+	// Rolling back a migration inserts a row that is not applied,
+	// so like goose, only consider the latest row of every version.
+	skip := make(map[int64]struct{})
+	for _, v := range vs {
+		if _, ok := skip[v.VersionID]; ok {
+			continue
+		}
+		if v.IsApplied {
+			return v.VersionID, nil
+		}
+		skip[v.VersionID] = struct{}{}
+	}
+	return 0, errors.New("no migrations have been applied")
+}
+
+type idempotencyKeysSQLStore struct {
+	db           qrm.DB
+	organization string
+}
+
+func NewIdempotencyKeysSQLStore(db qrm.DB, organization string) IdempotencyKeys {
+	return &idempotencyKeysSQLStore{db, organization}
+}
+
+func (iks *idempotencyKeysSQLStore) Reserve(ctx context.Context, key string, lease time.Duration) (*model.IdempotencyKey, bool, error) {
+	tx, err := newTxable(iks.db).BeginTx(ctx, nil)
+	if err != nil {
+		return nil, false, err
+	}
+	defer tx.Rollback() //nolint:errcheck
+
+	var o model.Organization
+	if err := postgres.SELECT(
+		table.Organization.ID,
+	).FROM(
+		table.Organization,
+	).WHERE(
+		table.Organization.Name.EQ(postgres.String(iks.organization)),
+	).QueryContext(ctx, tx, &o); err != nil {
+		return nil, false, sqlError(err, "organization %q", iks.organization)
+	}
+
+	// Expired keys are deleted lazily, so they need not be cleaned up in the background.
+	if _, err := table.IdempotencyKey.DELETE().WHERE(
+		table.IdempotencyKey.Organization.EQ(postgres.Int32(o.ID)).
+			AND(table.IdempotencyKey.Expires.LT_EQ(postgres.LOCALTIMESTAMP())),
+	).ExecContext(ctx, tx); err != nil {
+		return nil, false, err
+	}
+
+	var k model.IdempotencyKey
+	created := true
+	err = table.IdempotencyKey.INSERT(
+		table.IdempotencyKey.Organization,
+		table.IdempotencyKey.Key,
+		table.IdempotencyKey.Expires,
+	).VALUES(
+		o.ID,
+		key,
+		postgres.LOCALTIMESTAMP().ADD(postgres.INTERVALd(lease)),
+	).ON_CONFLICT(
+		table.IdempotencyKey.Key,
+		table.IdempotencyKey.Organization,
+	).DO_NOTHING().RETURNING(
+		table.IdempotencyKey.AllColumns,
+	).QueryContext(ctx, tx, &k)
+	switch {
+	case err == nil:
+	case errors.Is(err, qrm.ErrNoRows):
+		// The key is already reserved by another request.
+		created = false
+		if err := postgres.SELECT(
+			table.IdempotencyKey.AllColumns,
+		).FROM(
+			table.IdempotencyKey,
+		).WHERE(
+			table.IdempotencyKey.Organization.EQ(postgres.Int32(o.ID)).
+				AND(table.IdempotencyKey.Key.EQ(postgres.String(key))),
+		).QueryContext(ctx, tx, &k); err != nil {
+			return nil, false, sqlError(err, "idempotency key %q", key)
+		}
+	default:
+		return nil, false, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, false, err
+	}
+
+	return &k, created, nil
+}
+
+func (iks *idempotencyKeysSQLStore) Complete(ctx context.Context, key, fingerprint string, status int, response []byte, ttl time.Duration) error {
+	_, err := table.IdempotencyKey.UPDATE(
+		table.IdempotencyKey.Fingerprint,
+		table.IdempotencyKey.Status,
+		table.IdempotencyKey.Response,
+		table.IdempotencyKey.Expires,
+	).SET(
+		fingerprint,
+		status,
+		response,
+		postgres.LOCALTIMESTAMP().ADD(postgres.INTERVALd(ttl)),
+	).WHERE(
+		table.IdempotencyKey.Key.EQ(postgres.String(key)).
+			AND(table.IdempotencyKey.Organization.IN(iks.organizationID())),
+	).ExecContext(ctx, iks.db)
+	return err
+}
+
+func (iks *idempotencyKeysSQLStore) Release(ctx context.Context, key string) error {
+	_, err := table.IdempotencyKey.DELETE().WHERE(
+		table.IdempotencyKey.Key.EQ(postgres.String(key)).
+			AND(table.IdempotencyKey.Organization.IN(iks.organizationID())),
+	).ExecContext(ctx, iks.db)
+	return err
+}
+
+// organizationID selects the ID of the organization of the store.
+func (iks *idempotencyKeysSQLStore) organizationID() postgres.SelectStatement {
+	return postgres.SELECT(
+		table.Organization.ID,
+	).FROM(
+		table.Organization,
+	).WHERE(
+		table.Organization.Name.EQ(postgres.String(iks.organization)),
+	)
+}
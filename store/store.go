@@ -2,7 +2,9 @@ package store
 
 import (
 	"context"
+	"time"
 
+	"github.com/connylabs/model-tracking/labels"
 	"github.com/connylabs/model-tracking/store/model-tracking/public/model"
 )
 
@@ -18,6 +20,22 @@ type ModelTracking interface {
 	Versions(organization, model string) Versions
 	// Results returns a store for interacting with results.
 	Results(organization, model, version string) Results
+	// Stages returns a store for interacting with deployment stages.
+	Stages(organization, model string) Stages
+	// AlertRules returns a store for interacting with the alert rules of a model.
+	AlertRules(organization, model string) AlertRules
+	// Alerts returns a store for evaluating the alert rules of all models.
+	Alerts() Alerts
+	// Webhooks returns a store for interacting with the webhooks of an organization.
+	Webhooks(organization string) Webhooks
+	// Deliveries returns a store for delivering events to the webhooks of all organizations.
+	Deliveries() Deliveries
+	// Statistics returns a store for aggregating the results of all versions.
+	Statistics() Statistics
+	// Migrations returns a store for inspecting the migrations applied to the store.
+	Migrations() Migrations
+	// IdempotencyKeys returns a store for interacting with the idempotency keys of an organization.
+	IdempotencyKeys(organization string) IdempotencyKeys
 }
 
 // Organizations is a store that allows interacting with organizations.
@@ -30,7 +48,9 @@ type Organizations interface {
 type Models interface {
 	// Create creates a new model for the organization in the store.
 	Create(context.Context, *model.Model) (*model.Model, error)
-	// Update updates a model for the organization in the store.
+	// Update updates the default schema, description, labels and archived state of a model for the organization in the store.
+	// If the Updated field is set, the model is only updated if it was last updated at that time;
+	// otherwise an ErrPreconditionFailed error is returned.
 	Update(context.Context, *model.Model) (*model.Model, error)
 	// Get gets a model for the organization in the store.
 	Get(ctx context.Context, name string) (*model.Model, error)
@@ -54,21 +74,214 @@ type Schemas interface {
 type Versions interface {
 	// Create creates a new versions for the model in the store.
 	Create(context.Context, *model.Version) (*model.Version, error)
+	// Update updates the metadata and archived state of a version for the model in the store.
+	// If the Updated field is set, the version is only updated if it was last updated at that time;
+	// otherwise an ErrPreconditionFailed error is returned.
+	Update(context.Context, *model.Version) (*model.Version, error)
+	// UpdateAndPromote updates a version like Update and points a stage of the model at it
+	// in the same transaction, so that either both or neither of the changes are made.
+	UpdateAndPromote(ctx context.Context, v *model.Version, stage string) (*model.Version, error)
 	// Get gets a version for the model in the store.
 	Get(ctx context.Context, name string) (*model.Version, error)
+	// GetByID gets a version for the model in the store.
+	GetByID(ctx context.Context, id int) (*model.Version, error)
 	// GetOrCreate gets a version for the model in the store and if it does not exist,
 	// it tries to create it using the default schema of the model.
+	// Archived versions and versions of archived models cannot be gotten this way,
+	// since they accept no new results; an ErrConflict error is returned instead.
 	GetOrCreate(ctx context.Context, name string) (*model.Version, error)
-	// List gets all versions for the model in the store.
-	List(context.Context) ([]*model.Version, error)
+	// List gets all versions for the model in the store
+	// whose labels match the given selector.
+	List(context.Context, labels.Selector) ([]*model.Version, error)
 }
 
 // Results is a store that allows interacting with results.
 type Results interface {
 	// Create creates a new result for a version of the model in the store.
+	// If a result with the same client ID already exists for the version,
+	// an error of kind ErrConflict is returned.
 	Create(context.Context, *model.Result) (*model.Result, error)
+	// CreateBulk creates the results returned by next for a version of the model
+	// in the store in a single transaction until next returns io.EOF.
+	// Results are inserted in batches, so they need not all be held in memory at once.
+	// The transaction and its database connection stay open until next returns io.EOF,
+	// so next should not wait for slow sources, e.g. a client sending a request body.
+	// Results whose client ID already exists for the version are skipped.
+	// It returns the number of created and of skipped results.
+	CreateBulk(ctx context.Context, next func() (*model.Result, error)) (created, skipped int, err error)
+	// GetByClientID gets a result for a version of the model in the store by the ID given by its client.
+	GetByClientID(ctx context.Context, clientID string) (*model.Result, error)
 	// Get gets a result for a version of the model in the store.
 	Get(ctx context.Context, id int) (*model.Result, error)
-	// List gets all results for a version the model in the store.
-	List(context.Context) ([]*model.Result, error)
+	// After gets at most limit results for a version of the model in the store
+	// whose IDs are greater than the given ID, ordered by ID.
+	After(ctx context.Context, id, limit int) ([]*model.Result, error)
+	// Range gets the results for a version of the model in the store
+	// whose IDs lie in the closed interval [first, last], ordered by ID.
+	Range(ctx context.Context, first, last int) ([]*model.Result, error)
+	// List gets all results for a version the model in the store
+	// whose labels match the given selector.
+	List(context.Context, labels.Selector) ([]*model.Result, error)
+	// Window gets all results for a version of the model in the store
+	// whose labels match the given selector and whose time lies
+	// in the half-open interval [start, end). A nil bound is unbounded.
+	Window(ctx context.Context, start, end *time.Time, selector labels.Selector) ([]*model.Result, error)
+	// Sample gets at most limit results chosen at random from those
+	// that Window gets for the same arguments, ordered by ID.
+	Sample(ctx context.Context, start, end *time.Time, selector labels.Selector, limit int) ([]*model.Result, error)
+	// Pair gets all results for a version of the model, the champion,
+	// whose labels match the given selector, together with the results
+	// of another version of the model, the challenger, that share their correlation ID.
+	Pair(ctx context.Context, challenger string, selector labels.Selector) ([]*ResultPair, error)
+}
+
+// ResultPair is a pair of results produced by two versions of a model for the same request.
+type ResultPair struct {
+	Champion   model.Result `alias:"champion.*"`
+	Challenger model.Result `alias:"challenger.*"`
+}
+
+// Stages is a store that allows interacting with deployment stages.
+type Stages interface {
+	// Promote points a stage of the model at the given version
+	// and records the change in the stage's history.
+	// The stage is created if it does not exist.
+	Promote(ctx context.Context, stage, version string) (*model.Stage, error)
+	// Rollback points a stage of the model back at the version it pointed to
+	// before its most recent change and records the change in the stage's history.
+	Rollback(ctx context.Context, stage string) (*model.Stage, error)
+	// Get gets a stage for the model in the store.
+	Get(ctx context.Context, name string) (*model.Stage, error)
+	// List gets all stages for the model in the store.
+	List(context.Context) ([]*model.Stage, error)
+	// History gets all changes to a stage of the model, oldest first.
+	History(ctx context.Context, name string) ([]*model.StageHistory, error)
+}
+
+// ErrNoPreviousVersion is returned when rolling back a stage that has never been changed.
+var ErrNoPreviousVersion error = &Error{Kind: ErrConflict, Message: "the stage has no previous version"}
+
+// AlertRules is a store that allows interacting with the alert rules of a model.
+type AlertRules interface {
+	// Create creates a new alert rule for the model in the store.
+	Create(context.Context, *model.AlertRule) (*model.AlertRule, error)
+	// Get gets an alert rule for the model in the store.
+	Get(ctx context.Context, name string) (*model.AlertRule, error)
+	// List gets all alert rules for the model in the store.
+	List(context.Context) ([]*model.AlertRule, error)
+	// Delete deletes an alert rule for the model from the store.
+	// If updated is not nil, the alert rule is only deleted if it was last updated at that time;
+	// otherwise an ErrPreconditionFailed error is returned.
+	Delete(ctx context.Context, name string, updated *time.Time) error
+}
+
+// Alerts is a store that allows evaluating the alert rules of all models.
+type Alerts interface {
+	// List gets the alert rules of all models in the store
+	// together with the organizations and models they belong to.
+	List(context.Context) ([]*Alert, error)
+	// SetState records the outcome of the most recent evaluation of an alert rule.
+	SetState(ctx context.Context, id int, firing bool, activeSince *time.Time, value *float64) error
+	// Lock tries to take a lock that is shared by all replicas, so that alert rules are
+	// evaluated by only one replica at a time. If the lock is held elsewhere, ok is false.
+	// Otherwise, unlock must be called to release the lock.
+	Lock(ctx context.Context) (unlock func() error, ok bool, err error)
+}
+
+// Alert is an alert rule together with the organization and model it belongs to.
+type Alert struct {
+	Rule         model.AlertRule
+	Organization model.Organization
+	Model        model.Model
+}
+
+// Webhooks is a store that allows interacting with the webhooks of an organization.
+type Webhooks interface {
+	// Create creates a new webhook for the organization in the store.
+	Create(context.Context, *model.Webhook) (*model.Webhook, error)
+	// Get gets a webhook for the organization in the store.
+	Get(ctx context.Context, name string) (*model.Webhook, error)
+	// List gets all webhooks for the organization in the store.
+	List(context.Context) ([]*model.Webhook, error)
+	// Delete deletes a webhook and its deliveries for the organization from the store.
+	// If updated is not nil, the webhook is only deleted if it was last updated at that time;
+	// otherwise an ErrPreconditionFailed error is returned.
+	Delete(ctx context.Context, name string, updated *time.Time) error
+	// Deliveries gets the most recent deliveries of a webhook, newest first.
+	Deliveries(ctx context.Context, name string, limit int) ([]*model.WebhookDelivery, error)
+}
+
+// Deliveries is a store that allows delivering events to the webhooks of all organizations.
+type Deliveries interface {
+	// Claim gets up to limit pending deliveries whose next attempt is due
+	// and postpones their next attempt by the given lease,
+	// so that they are not claimed again while they are being attempted.
+	Claim(ctx context.Context, limit int, lease time.Duration) ([]*Delivery, error)
+	// Record records the outcome of an attempt of a delivery.
+	// The next attempt of a pending delivery is scheduled after the given delay.
+	Record(ctx context.Context, d *model.WebhookDelivery, delay time.Duration) error
+}
+
+// Delivery is a delivery together with the webhook it is addressed to.
+type Delivery struct {
+	Delivery model.WebhookDelivery
+	Webhook  model.Webhook
+}
+
+// The statuses of a delivery.
+const (
+	DeliveryPending   = "pending"
+	DeliverySucceeded = "succeeded"
+	DeliveryFailed    = "failed"
+)
+
+// Statistics is a store that allows aggregating the results of all versions.
+type Statistics interface {
+	// List gets the statistics of the results of every version of every model.
+	List(context.Context) ([]*VersionStatistics, error)
+	// Get gets the statistics of the results of a version of a model
+	// whose labels match the given selector and whose time lies
+	// in the half-open interval [start, end). A nil bound is unbounded.
+	Get(ctx context.Context, organization, model, version string, start, end *time.Time, selector labels.Selector) (*VersionStatistics, error)
+	// Count gets the number of results of all models of the organization
+	// that were created since the given time.
+	Count(ctx context.Context, organization string, since time.Time) (int64, error)
+}
+
+// VersionStatistics aggregates the results of a version.
+type VersionStatistics struct {
+	Organization string
+	Model        string
+	Version      string
+	// Results is the number of results.
+	Results int64
+	// Labelled is the number of results whose true output is not null.
+	Labelled int64
+	// Correct is the number of labelled results whose output equals the true output.
+	Correct int64
+	// LastResult is the time of the most recent result, if any.
+	LastResult *time.Time
+}
+
+// Migrations is a store that allows inspecting the migrations applied to the store.
+type Migrations interface {
+	// Version gets the version of the latest applied migration.
+	Version(context.Context) (int64, error)
+}
+
+// IdempotencyKeys is a store that allows recording the responses to requests
+// made with idempotency keys, so that retried requests are answered without being repeated.
+type IdempotencyKeys interface {
+	// Reserve reserves a key for a request in progress for the duration of the lease.
+	// If the key is already reserved and has not expired, the existing key is returned
+	// instead and created is false. Its status is nil while the request is in progress.
+	// Reservations whose request never completes, e.g. because the process crashed,
+	// expire after the lease so that the key can be used again.
+	Reserve(ctx context.Context, key string, lease time.Duration) (k *model.IdempotencyKey, created bool, err error)
+	// Complete records the response to the request with the key for the given time to live,
+	// where the fingerprint identifies the request.
+	Complete(ctx context.Context, key, fingerprint string, status int, response []byte, ttl time.Duration) error
+	// Release deletes the reservation of a key, e.g. when the request failed,
+	// so that it can be retried.
+	Release(ctx context.Context, key string) error
 }
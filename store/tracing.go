@@ -0,0 +1,46 @@
+package store
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/go-jet/jet/v2/postgres"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	semconv "go.opentelemetry.io/otel/semconv/v1.17.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// Trace records a span for every query executed by the SQL stores
+// as a child of the span in the context of the query.
+// Statements are recorded without their arguments so that spans do not leak data.
+// Jet only supports a single, global query logger, so calling Trace
+// affects all stores.
+func Trace(tp trace.TracerProvider) {
+	tracer := tp.Tracer("github.com/connylabs/model-tracking/store")
+	postgres.SetQueryLogger(func(ctx context.Context, info postgres.QueryInfo) {
+		// The logger is called after the query was executed,
+		// so backdate the span to the start of the query.
+		end := time.Now()
+		query, _ := info.Statement.Sql()
+		operation := strings.ToUpper(strings.SplitN(strings.TrimSpace(query), " ", 2)[0])
+		_, _, function := info.Caller()
+		_, span := tracer.Start(ctx, "db."+operation,
+			trace.WithSpanKind(trace.SpanKindClient),
+			trace.WithTimestamp(end.Add(-info.Duration)),
+			trace.WithAttributes(
+				semconv.DBSystemPostgreSQL,
+				semconv.DBOperation(operation),
+				semconv.DBStatement(query),
+				semconv.CodeFunction(function),
+				attribute.Int64("db.rows_processed", info.RowsProcessed),
+			),
+		)
+		if info.Err != nil {
+			span.RecordError(info.Err)
+			span.SetStatus(codes.Error, info.Err.Error())
+		}
+		span.End(trace.WithTimestamp(end))
+	})
+}
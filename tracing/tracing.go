@@ -0,0 +1,144 @@
+// Package tracing sets up the export of OpenTelemetry traces
+// and instruments HTTP servers with spans.
+package tracing
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+
+	"github.com/go-chi/chi/v5"
+	"go.opentelemetry.io/contrib/instrumentation/net/http/otelhttp"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracehttp"
+	"go.opentelemetry.io/otel/exporters/stdout/stdouttrace"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.17.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// InstrumentationName is the name of the tracers used by model-tracking.
+const InstrumentationName = "github.com/connylabs/model-tracking"
+
+// Exporter is a destination for traces.
+type Exporter string
+
+const (
+	// None disables tracing.
+	None Exporter = "none"
+	// OTLP exports traces to an OpenTelemetry collector using OTLP over HTTP.
+	OTLP Exporter = "otlp"
+	// Stdout prints traces to standard output, which is useful for local debugging.
+	Stdout Exporter = "stdout"
+)
+
+// Exporters are all available exporters.
+var Exporters = []Exporter{None, OTLP, Stdout}
+
+// Config configures the export of traces.
+type Config struct {
+	// Exporter is the destination of the traces.
+	Exporter Exporter
+	// Endpoint is the host and port of the OTLP collector.
+	// If it is empty, the endpoint is taken from the standard
+	// OTEL_EXPORTER_OTLP_ENDPOINT environment variable.
+	Endpoint string
+	// Insecure disables TLS for the connection to the OTLP collector.
+	Insecure bool
+	// SampleRatio is the fraction of traces to sample
+	// unless the parent span was sampled.
+	SampleRatio float64
+	// Version is the version of the service reported in the traces.
+	Version string
+	// Writer is the destination of the stdout exporter.
+	// If it is nil, os.Stdout is used.
+	Writer io.Writer
+}
+
+// Setup creates a tracer provider for the given configuration
+// and registers it and the W3C trace context and baggage propagators globally.
+// The returned function flushes pending spans and must be called on shutdown.
+func Setup(ctx context.Context, c Config) (func(context.Context) error, error) {
+	// Always propagate trace context so that traces of callers
+	// continue through model-tracking even when it exports no spans.
+	otel.SetTextMapPropagator(propagation.NewCompositeTextMapPropagator(propagation.TraceContext{}, propagation.Baggage{}))
+
+	var exporter sdktrace.SpanExporter
+	switch c.Exporter {
+	case None, "":
+		otel.SetTracerProvider(trace.NewNoopTracerProvider())
+		return func(context.Context) error { return nil }, nil
+	case OTLP:
+		var opts []otlptracehttp.Option
+		if c.Endpoint != "" {
+			opts = append(opts, otlptracehttp.WithEndpoint(c.Endpoint))
+		}
+		if c.Insecure {
+			opts = append(opts, otlptracehttp.WithInsecure())
+		}
+		e, err := otlptracehttp.New(ctx, opts...)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create OTLP exporter: %w", err)
+		}
+		exporter = e
+	case Stdout:
+		w := c.Writer
+		if w == nil {
+			w = os.Stdout
+		}
+		e, err := stdouttrace.New(stdouttrace.WithWriter(w))
+		if err != nil {
+			return nil, fmt.Errorf("failed to create stdout exporter: %w", err)
+		}
+		exporter = e
+	default:
+		return nil, fmt.Errorf("unknown exporter %q", c.Exporter)
+	}
+
+	r, err := resource.Merge(resource.Default(), resource.NewWithAttributes(
+		semconv.SchemaURL,
+		semconv.ServiceName("model-tracking"),
+		semconv.ServiceVersion(c.Version),
+	))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create resource: %w", err)
+	}
+
+	tp := sdktrace.NewTracerProvider(
+		sdktrace.WithBatcher(exporter),
+		sdktrace.WithResource(r),
+		sdktrace.WithSampler(sdktrace.ParentBased(sdktrace.TraceIDRatioBased(c.SampleRatio))),
+	)
+	otel.SetTracerProvider(tp)
+	return tp.Shutdown, nil
+}
+
+// Middleware returns a middleware for chi routers that extracts the trace context
+// from incoming requests and wraps every request in a server span.
+// The span is named after the matched route pattern rather than the path
+// to keep the cardinality of span names low.
+func Middleware() func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return otelhttp.NewHandler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			next.ServeHTTP(w, r)
+			if rc := chi.RouteContext(r.Context()); rc != nil {
+				if pattern := rc.RoutePattern(); pattern != "" {
+					span := trace.SpanFromContext(r.Context())
+					span.SetName(r.Method + " " + pattern)
+					span.SetAttributes(semconv.HTTPRoute(pattern))
+				}
+			}
+		}), "http", otelhttp.WithSpanNameFormatter(func(_ string, r *http.Request) string {
+			return r.Method
+		}))
+	}
+}
+
+// Tracer returns the tracer of model-tracking from the global tracer provider.
+func Tracer() trace.Tracer {
+	return otel.Tracer(InstrumentationName)
+}
@@ -0,0 +1,57 @@
+package tracing
+
+import (
+	"bytes"
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/efficientgo/core/testutil"
+	"github.com/go-chi/chi/v5"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/propagation"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
+)
+
+func TestMiddleware(t *testing.T) {
+	sr := tracetest.NewSpanRecorder()
+	otel.SetTracerProvider(sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(sr)))
+	otel.SetTextMapPropagator(propagation.TraceContext{})
+
+	var handlerSpan trace.SpanContext
+	r := chi.NewRouter()
+	r.Use(Middleware())
+	r.Get("/models/{model}", func(w http.ResponseWriter, r *http.Request) {
+		handlerSpan = trace.SpanContextFromContext(r.Context())
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	req := httptest.NewRequest(http.MethodGet, "/models/foo", nil)
+	req.Header.Set("traceparent", "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01")
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, req)
+	testutil.Equals(t, http.StatusNoContent, w.Code)
+
+	spans := sr.Ended()
+	testutil.Equals(t, 1, len(spans))
+	testutil.Equals(t, "GET /models/{model}", spans[0].Name())
+	testutil.Equals(t, "4bf92f3577b34da6a3ce929d0e0e4736", spans[0].SpanContext().TraceID().String())
+	testutil.Equals(t, "00f067aa0ba902b7", spans[0].Parent().SpanID().String())
+	testutil.Equals(t, spans[0].SpanContext().SpanID(), handlerSpan.SpanID())
+}
+
+func TestSetup(t *testing.T) {
+	var buf bytes.Buffer
+	shutdown, err := Setup(context.Background(), Config{Exporter: Stdout, SampleRatio: 1, Writer: &buf})
+	testutil.Ok(t, err)
+	_, span := Tracer().Start(context.Background(), "test-span")
+	span.End()
+	testutil.Ok(t, shutdown(context.Background()))
+	testutil.Assert(t, bytes.Contains(buf.Bytes(), []byte(`"Name":"test-span"`)), "expected the span to be exported")
+
+	_, err = Setup(context.Background(), Config{Exporter: "jaeger"})
+	testutil.NotOk(t, err)
+}
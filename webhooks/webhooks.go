@@ -0,0 +1,205 @@
+// Package webhooks delivers the events of organizations to their webhooks.
+package webhooks
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/go-kit/log"
+	"github.com/go-kit/log/level"
+	"github.com/prometheus/client_golang/prometheus"
+
+	"github.com/connylabs/model-tracking/store"
+	"github.com/connylabs/model-tracking/store/model-tracking/public/model"
+)
+
+const (
+	// EventHeader is the header holding the type of the delivered event.
+	EventHeader = "X-Model-Tracking-Event"
+	// DeliveryHeader is the header holding the ID of the delivery.
+	// It is the same for all attempts of a delivery.
+	DeliveryHeader = "X-Model-Tracking-Delivery"
+	// SignatureHeader is the header holding the signature of the payload.
+	SignatureHeader = "X-Model-Tracking-Signature-256"
+)
+
+// maxErrorLength is the maximum length of the error recorded for a failed attempt.
+const maxErrorLength = 1024
+
+// Sign returns the signature of the payload using the given secret,
+// i.e. the hex-encoded HMAC-SHA256 of the payload prefixed with "sha256=".
+func Sign(secret string, payload []byte) string {
+	h := hmac.New(sha256.New, []byte(secret))
+	h.Write(payload)
+	return "sha256=" + hex.EncodeToString(h.Sum(nil))
+}
+
+// Verify returns true if the signature matches the payload and the secret.
+func Verify(secret string, payload []byte, signature string) bool {
+	return hmac.Equal([]byte(Sign(secret, payload)), []byte(signature))
+}
+
+// Dispatcher periodically delivers pending events to webhooks.
+// Failed attempts are retried with exponential backoff.
+type Dispatcher struct {
+	store       store.Deliveries
+	client      *http.Client
+	interval    time.Duration
+	batch       int
+	maxAttempts int
+	backoff     time.Duration
+	maxBackoff  time.Duration
+	logger      log.Logger
+
+	attempts *prometheus.CounterVec
+}
+
+// NewDispatcher creates a new dispatcher that polls the store for pending deliveries
+// at the given interval and gives up on a delivery after maxAttempts attempts.
+// If client is nil, a client with a timeout of 10 seconds is used.
+func NewDispatcher(store store.Deliveries, client *http.Client, interval time.Duration, maxAttempts int, reg prometheus.Registerer, logger log.Logger) *Dispatcher {
+	if client == nil {
+		client = &http.Client{Timeout: 10 * time.Second}
+	}
+	if logger == nil {
+		logger = log.NewNopLogger()
+	}
+	attempts := prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "model_tracking_webhook_delivery_attempts_total",
+		Help: "The number of attempts to deliver events to webhooks.",
+	}, []string{"result"})
+	if reg != nil {
+		reg.MustRegister(attempts)
+	}
+	return &Dispatcher{
+		store:       store,
+		client:      client,
+		interval:    interval,
+		batch:       100,
+		maxAttempts: maxAttempts,
+		backoff:     10 * time.Second,
+		maxBackoff:  time.Hour,
+		logger:      logger,
+		attempts:    attempts,
+	}
+}
+
+// Run delivers events until the context is canceled.
+func (d *Dispatcher) Run(ctx context.Context) error {
+	t := time.NewTicker(d.interval)
+	defer t.Stop()
+	for {
+		for {
+			n, err := d.Dispatch(ctx)
+			if err != nil {
+				level.Error(d.logger).Log("msg", "failed to dispatch webhook deliveries", "err", err.Error())
+			}
+			// Keep going while there may be more pending deliveries.
+			if err != nil || n < d.batch || ctx.Err() != nil {
+				break
+			}
+		}
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-t.C:
+		}
+	}
+}
+
+// Dispatch attempts a batch of pending deliveries once
+// and returns the number of attempted deliveries.
+func (d *Dispatcher) Dispatch(ctx context.Context) (int, error) {
+	// Lease the deliveries long enough for all attempts to time out.
+	ds, err := d.store.Claim(ctx, d.batch, d.client.Timeout*time.Duration(d.batch)+time.Minute)
+	if err != nil {
+		return 0, err
+	}
+	for _, dl := range ds {
+		delivery := dl.Delivery
+		delay := d.attempt(ctx, &delivery, &dl.Webhook)
+		if err := d.store.Record(ctx, &delivery, delay); err != nil {
+			return 0, fmt.Errorf("failed to record delivery %d: %w", delivery.ID, err)
+		}
+	}
+	return len(ds), nil
+}
+
+// attempt sends the delivery to the webhook, updates the delivery
+// with the outcome and returns the delay until the next attempt.
+func (d *Dispatcher) attempt(ctx context.Context, delivery *model.WebhookDelivery, webhook *model.Webhook) time.Duration {
+	delivery.Attempts++
+	delivery.ResponseStatus = nil
+	delivery.Error = nil
+
+	code, err := d.send(ctx, delivery, webhook)
+	if code != 0 {
+		c := int32(code)
+		delivery.ResponseStatus = &c
+	}
+	if err == nil {
+		d.attempts.WithLabelValues("success").Inc()
+		delivery.Status = store.DeliverySucceeded
+		return 0
+	}
+
+	d.attempts.WithLabelValues("error").Inc()
+	msg := err.Error()
+	if len(msg) > maxErrorLength {
+		msg = msg[:maxErrorLength]
+	}
+	delivery.Error = &msg
+	if int(delivery.Attempts) >= d.maxAttempts {
+		level.Warn(d.logger).Log("msg", "giving up on webhook delivery", "webhook", webhook.Name, "delivery", delivery.ID, "attempts", delivery.Attempts, "err", msg)
+		delivery.Status = store.DeliveryFailed
+		return 0
+	}
+	return d.delay(int(delivery.Attempts))
+}
+
+// delay returns the delay before the next attempt after the given number of attempts,
+// doubling with every attempt up to a maximum.
+func (d *Dispatcher) delay(attempts int) time.Duration {
+	delay := d.backoff
+	for i := 1; i < attempts && delay < d.maxBackoff; i++ {
+		delay *= 2
+	}
+	if delay > d.maxBackoff {
+		delay = d.maxBackoff
+	}
+	return delay
+}
+
+// send posts the payload of the delivery to the webhook and returns
+// the status code of the response, if any.
+func (d *Dispatcher) send(ctx context.Context, delivery *model.WebhookDelivery, webhook *model.Webhook) (int, error) {
+	payload := []byte(delivery.Payload)
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, webhook.URL, bytes.NewReader(payload))
+	if err != nil {
+		return 0, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(EventHeader, delivery.Event)
+	req.Header.Set(DeliveryHeader, strconv.Itoa(int(delivery.ID)))
+	req.Header.Set(SignatureHeader, Sign(webhook.Secret, payload))
+
+	res, err := d.client.Do(req)
+	if err != nil {
+		return 0, err
+	}
+	defer res.Body.Close()
+	// Drain the body so that the connection can be reused.
+	io.Copy(io.Discard, io.LimitReader(res.Body, 1<<16)) //nolint:errcheck
+	if res.StatusCode < 200 || res.StatusCode >= 300 {
+		return res.StatusCode, fmt.Errorf("unexpected status code %d", res.StatusCode)
+	}
+	return res.StatusCode, nil
+}
@@ -0,0 +1,118 @@
+package webhooks
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/efficientgo/core/testutil"
+
+	"github.com/connylabs/model-tracking/store"
+	"github.com/connylabs/model-tracking/store/model-tracking/public/model"
+)
+
+// fakeDeliveries is an in-memory store of deliveries that ignores due times.
+type fakeDeliveries struct {
+	deliveries []*store.Delivery
+	delays     []time.Duration
+}
+
+func (fd *fakeDeliveries) Claim(_ context.Context, limit int, _ time.Duration) ([]*store.Delivery, error) {
+	var ds []*store.Delivery
+	for _, d := range fd.deliveries {
+		if d.Delivery.Status == store.DeliveryPending && len(ds) < limit {
+			c := *d
+			ds = append(ds, &c)
+		}
+	}
+	return ds, nil
+}
+
+func (fd *fakeDeliveries) Record(_ context.Context, d *model.WebhookDelivery, delay time.Duration) error {
+	for _, e := range fd.deliveries {
+		if e.Delivery.ID == d.ID {
+			e.Delivery = *d
+		}
+	}
+	fd.delays = append(fd.delays, delay)
+	return nil
+}
+
+func TestSign(t *testing.T) {
+	// The expected signature was computed with:
+	// echo -n '{"type":"model.created"}' | openssl dgst -sha256 -hmac secret
+	payload := []byte(`{"type":"model.created"}`)
+	s := Sign("secret", payload)
+	testutil.Equals(t, "sha256=6ebf583f51cd396ecdf4a663f11789a4986e4ad012366202540296090e5e586d", s)
+	testutil.Assert(t, Verify("secret", payload, s))
+	testutil.Assert(t, !Verify("other", payload, s))
+	testutil.Assert(t, !Verify("secret", []byte(`{}`), s))
+}
+
+func TestDispatcher(t *testing.T) {
+	var calls int
+	var requests []*http.Request
+	var bodies [][]byte
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		body, err := io.ReadAll(r.Body)
+		testutil.Ok(t, err)
+		requests = append(requests, r)
+		bodies = append(bodies, body)
+		// Fail the first attempt.
+		if calls == 1 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	fd := &fakeDeliveries{deliveries: []*store.Delivery{
+		{
+			Delivery: model.WebhookDelivery{ID: 1, Event: "version.created", Payload: `{"type":"version.created"}`, Status: store.DeliveryPending},
+			Webhook:  model.Webhook{Name: "ci", URL: server.URL, Secret: "secret"},
+		},
+		{
+			Delivery: model.WebhookDelivery{ID: 2, Event: "result.batch_created", Payload: `{"type":"result.batch_created"}`, Status: store.DeliveryPending},
+			Webhook:  model.Webhook{Name: "unreachable", URL: "http://127.0.0.1:1", Secret: "secret"},
+		},
+	}}
+	d := NewDispatcher(fd, nil, time.Second, 3, nil, nil)
+
+	n, err := d.Dispatch(context.Background())
+	testutil.Ok(t, err)
+	testutil.Equals(t, 2, n)
+	testutil.Equals(t, store.DeliveryPending, fd.deliveries[0].Delivery.Status)
+	testutil.Equals(t, int32(http.StatusServiceUnavailable), *fd.deliveries[0].Delivery.ResponseStatus)
+	testutil.Equals(t, []time.Duration{10 * time.Second, 10 * time.Second}, fd.delays)
+	testutil.Equals(t, "version.created", requests[0].Header.Get(EventHeader))
+	testutil.Equals(t, "1", requests[0].Header.Get(DeliveryHeader))
+	testutil.Assert(t, Verify("secret", bodies[0], requests[0].Header.Get(SignatureHeader)))
+
+	fd.delays = nil
+	_, err = d.Dispatch(context.Background())
+	testutil.Ok(t, err)
+	testutil.Equals(t, store.DeliverySucceeded, fd.deliveries[0].Delivery.Status)
+	testutil.Equals(t, int32(2), fd.deliveries[0].Delivery.Attempts)
+	testutil.Assert(t, fd.deliveries[0].Delivery.Error == nil)
+	testutil.Equals(t, []time.Duration{0, 20 * time.Second}, fd.delays)
+	testutil.Equals(t, bodies[0], bodies[1])
+
+	_, err = d.Dispatch(context.Background())
+	testutil.Ok(t, err)
+	testutil.Equals(t, store.DeliveryFailed, fd.deliveries[1].Delivery.Status)
+	testutil.Equals(t, int32(3), fd.deliveries[1].Delivery.Attempts)
+	testutil.Assert(t, fd.deliveries[1].Delivery.Error != nil)
+	testutil.Equals(t, 2, calls)
+}
+
+func TestDelay(t *testing.T) {
+	d := NewDispatcher(&fakeDeliveries{}, nil, time.Second, 20, nil, nil)
+	testutil.Equals(t, 10*time.Second, d.delay(1))
+	testutil.Equals(t, 80*time.Second, d.delay(4))
+	testutil.Equals(t, time.Hour, d.delay(15))
+}